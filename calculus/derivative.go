@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package calculus implements numerical differentiation and integration of
+// real functions and the solution of ordinary differential equations.
+package calculus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+const (
+	// MaxOrder is the highest derivative order supported. Rounding errors
+	// grow like ε/hⁿ, so higher orders could not be estimated usefully.
+	MaxOrder = 10
+
+	// Ridders' extrapolation: the step shrinks by shrink at each of up to
+	// tableauSize stages, and stops once the error estimate grows by more
+	// than safeGrowth.
+	shrink      = 1.4
+	tableauSize = 10
+	safeGrowth  = 2.0
+	// epsilon is the float64 machine epsilon.
+	epsilon = 0x1p-52
+	// initialStep is the first step relative to the scale of the point.
+	initialStep = 0.1
+	// maxStepHalvings bounds the search for a first step at which the
+	// function is finite on the whole stencil.
+	maxStepHalvings = 40
+)
+
+// ErrNotFinite is returned when the function is not finite near the point at
+// any step size.
+var ErrNotFinite = errors.New("function is not finite near the point")
+
+// Func is a real function of one variable that may fail to evaluate.
+type Func func(x float64) (float64, error)
+
+// MultiFunc is a real function of several variables that may fail to
+// evaluate.
+type MultiFunc func(x []float64) (float64, error)
+
+// Estimate is a numerical result with an estimate of its absolute error.
+type Estimate struct {
+	Value float64
+	Error float64
+}
+
+// stencil is a finite difference approximation at step h whose error is a
+// series in powers of h, even powers only for central differences. It also
+// returns a bound on the rounding error of the approximation.
+type stencil func(h float64) (value, noise float64, err error)
+
+// extrapolate runs Ridders' method: it evaluates d at steps decreasing from
+// h and extrapolates them to h = 0 by Richardson's method, eliminating the
+// error terms in powers of h^p in turn, and returns the entry of the tableau
+// with the smallest error estimate.
+func extrapolate(d stencil, h, p float64) (Estimate, error) {
+	// Find a step small enough that the stencil avoids nearby singularities
+	var first, noise float64
+	found := false
+	for range maxStepHalvings {
+		v, n, err := d(h)
+		if err != nil {
+			return Estimate{}, err
+		}
+		if isFinite(v) && isFinite(n) {
+			first, noise, found = v, n, true
+			break
+		}
+		h /= 2
+	}
+	if !found {
+		return Estimate{}, ErrNotFinite
+	}
+
+	var table [tableauSize][tableauSize]float64
+	table[0][0] = first
+	best := Estimate{Value: first, Error: math.Inf(1)}
+	for i := 1; i < tableauSize; i++ {
+		h /= shrink
+		v, n, err := d(h)
+		if err != nil {
+			return Estimate{}, err
+		}
+		if !isFinite(v) || !isFinite(n) {
+			break
+		}
+		table[0][i] = v
+		ratio := math.Pow(shrink, p)
+		factor := ratio
+		for j := 1; j <= i; j++ {
+			table[j][i] = (table[j-1][i]*factor - table[j-1][i-1]) / (factor - 1)
+			factor *= ratio
+			// Differences within the tableau can vanish by chance, so the
+			// rounding error of the stencil bounds the estimate below
+			e := math.Max(math.Abs(table[j][i]-table[j-1][i]), math.Abs(table[j][i]-table[j-1][i-1]))
+			e = math.Max(e, n)
+			if e <= best.Error {
+				best = Estimate{Value: table[j][i], Error: e}
+			}
+		}
+		// Rounding errors have taken over once the diagonal moves away
+		if math.Abs(table[i][i]-table[i-1][i-1]) >= safeGrowth*best.Error {
+			break
+		}
+	}
+	if math.IsInf(best.Error, 1) {
+		// A single stage gives no error estimate
+		best.Error = math.Max(math.Abs(best.Value), noise)
+	}
+	return best, nil
+}
+
+// isFinite reports whether v is neither infinite nor NaN.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// scale returns the magnitude that steps around x are relative to.
+func scale(x float64) float64 {
+	return math.Max(math.Abs(x), 1)
+}
+
+// binomial returns n choose k for small n.
+func binomial(n, k int) float64 {
+	c := 1.0
+	for i := range k {
+		c = c * float64(n-i) / float64(i+1)
+	}
+	return c
+}
+
+// Derivative estimates the order-th derivative of f at x by central
+// differences with Richardson extrapolation. A step of 0 chooses the first
+// step automatically from the magnitude of x.
+func Derivative(f Func, x float64, order int, step float64) (Estimate, error) {
+	if order < 1 || order > MaxOrder {
+		return Estimate{}, fmt.Errorf("derivative order must be between 1 and %d", MaxOrder)
+	}
+	if step == 0 {
+		step = initialStep * scale(x)
+	}
+	// The n-th central difference Σ (−1)ᵏ C(n, k) f(x + (n/2 − k)h) / hⁿ,
+	// whose points are offset by half steps when n is odd
+	return extrapolate(func(h float64) (float64, float64, error) {
+		var sum, abs float64
+		for k := 0; k <= order; k++ {
+			v, err := f(x + (float64(order)/2-float64(k))*h)
+			if err != nil {
+				return 0, 0, err
+			}
+			c := binomial(order, k)
+			if k%2 == 1 {
+				c = -c
+			}
+			sum += c * v
+			abs += math.Abs(c * v)
+		}
+		hn := math.Pow(h, float64(order))
+		return sum / hn, epsilon * abs / hn, nil
+	}, step, 2)
+}
+
+// OneSidedDerivative estimates the order-th derivative of f at x from one
+// side, by forward differences if forward is set and backward differences
+// otherwise, with Richardson extrapolation. It uses only points on that
+// side of x, so comparing the two sides detects a kink that the central
+// differences of Derivative average away. A step of 0 chooses the first step
+// automatically from the magnitude of x.
+func OneSidedDerivative(f Func, x float64, order int, step float64, forward bool) (Estimate, error) {
+	if order < 1 || order > MaxOrder {
+		return Estimate{}, fmt.Errorf("derivative order must be between 1 and %d", MaxOrder)
+	}
+	if step == 0 {
+		step = initialStep * scale(x)
+	}
+	sign := 1.0
+	if !forward {
+		sign = -1
+	}
+	// The n-th forward difference Σ (−1)ⁿ⁻ᵏ C(n, k) f(x + kh) / hⁿ, with h
+	// negative for the backward difference
+	return extrapolate(func(h float64) (float64, float64, error) {
+		h *= sign
+		var sum, abs float64
+		for k := 0; k <= order; k++ {
+			v, err := f(x + float64(k)*h)
+			if err != nil {
+				return 0, 0, err
+			}
+			c := binomial(order, k)
+			if (order-k)%2 == 1 {
+				c = -c
+			}
+			sum += c * v
+			abs += math.Abs(c * v)
+		}
+		hn := math.Pow(h, float64(order))
+		return sum / hn, epsilon * abs / math.Abs(hn), nil
+	}, step, 1)
+}
+
+// partial restricts f to the variable i through x.
+func partial(f MultiFunc, x []float64, i int) Func {
+	return func(t float64) (float64, error) {
+		y := slices.Clone(x)
+		y[i] = t
+		return f(y)
+	}
+}
+
+// Gradient estimates the partial derivatives of f at x.
+func Gradient(f MultiFunc, x []float64, step float64) ([]Estimate, error) {
+	grad := make([]Estimate, len(x))
+	for i := range x {
+		var err error
+		grad[i], err = Derivative(partial(f, x, i), x[i], 1, step)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return grad, nil
+}
+
+// Hessian estimates the matrix of second partial derivatives of f at x. The
+// mixed partials use the four-point central difference, extrapolated like
+// the diagonal, and the result is symmetric by construction.
+func Hessian(f MultiFunc, x []float64, step float64) ([][]Estimate, error) {
+	n := len(x)
+	hess := make([][]Estimate, n)
+	for i := range hess {
+		hess[i] = make([]Estimate, n)
+	}
+	for i := range n {
+		var err error
+		hess[i][i], err = Derivative(partial(f, x, i), x[i], 2, step)
+		if err != nil {
+			return nil, err
+		}
+		for j := range i {
+			si, sj := scale(x[i]), scale(x[j])
+			first := step
+			if first == 0 {
+				first = initialStep
+			} else {
+				// The step is relative to the scales below
+				first /= math.Max(si, sj)
+			}
+			mixed, err := extrapolate(func(h float64) (float64, float64, error) {
+				hi, hj := h*si, h*sj
+				var sum, abs float64
+				for _, corner := range [4][3]float64{{1, 1, 1}, {1, -1, -1}, {-1, 1, -1}, {-1, -1, 1}} {
+					y := slices.Clone(x)
+					y[i] += corner[0] * hi
+					y[j] += corner[1] * hj
+					v, err := f(y)
+					if err != nil {
+						return 0, 0, err
+					}
+					sum += corner[2] * v
+					abs += math.Abs(v)
+				}
+				return sum / (4 * hi * hj), epsilon * abs / (4 * hi * hj), nil
+			}, first, 2)
+			if err != nil {
+				return nil, err
+			}
+			hess[i][j], hess[j][i] = mixed, mixed
+		}
+	}
+	return hess, nil
+}
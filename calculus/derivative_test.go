@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exact wraps a function that cannot fail.
+func exact(f func(float64) float64) Func {
+	return func(x float64) (float64, error) { return f(x), nil }
+}
+
+func TestDerivative(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     Func
+		x     float64
+		order int
+		want  float64
+		tol   float64
+	}{
+		{"sin", exact(math.Sin), 1, 1, math.Cos(1), 1e-12},
+		{"exp second", exact(math.Exp), 0.5, 2, math.Exp(0.5), 1e-10},
+		{"exp fourth", exact(math.Exp), 0, 4, 1, 1e-6},
+		{"cubic third", exact(func(x float64) float64 { return x * x * x }), 2, 3, 6, 1e-8},
+		{"log near singularity", exact(math.Log), 0.01, 1, 100, 1e-8},
+		{"large point", exact(func(x float64) float64 { return x * x }), 1e6, 1, 2e6, 1e-4},
+		{"gamma", exact(math.Gamma), 1, 1, -0.5772156649015329, 1e-11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Derivative(tt.f, tt.x, tt.order, 0)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got.Value, tt.tol)
+			// The error estimate is honest, within an order of magnitude
+			assert.LessOrEqual(t, math.Abs(got.Value-tt.want), 10*got.Error+1e-15)
+		})
+	}
+}
+
+func TestOneSidedDerivative(t *testing.T) {
+	// Smooth functions agree from both sides
+	for _, forward := range []bool{true, false} {
+		got, err := OneSidedDerivative(exact(math.Sin), 1, 1, 0, forward)
+		require.NoError(t, err)
+		assert.InDelta(t, math.Cos(1), got.Value, 1e-11)
+
+		got, err = OneSidedDerivative(exact(math.Exp), 0, 2, 0, forward)
+		require.NoError(t, err)
+		assert.InDelta(t, 1, got.Value, 1e-8)
+	}
+
+	// At a kink the sides differ while the central difference sees none
+	right, err := OneSidedDerivative(exact(math.Abs), 0, 1, 0, true)
+	require.NoError(t, err)
+	left, err := OneSidedDerivative(exact(math.Abs), 0, 1, 0, false)
+	require.NoError(t, err)
+	central, err := Derivative(exact(math.Abs), 0, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, right.Value)
+	assert.Equal(t, -1.0, left.Value)
+	assert.Zero(t, central.Value)
+
+	_, err = OneSidedDerivative(exact(math.Sin), 0, 11, 0, true)
+	assert.EqualError(t, err, "derivative order must be between 1 and 10")
+}
+
+func TestDerivativeErrors(t *testing.T) {
+	_, err := Derivative(exact(math.Sin), 0, 0, 0)
+	assert.EqualError(t, err, "derivative order must be between 1 and 10")
+
+	_, err = Derivative(exact(func(float64) float64 { return math.NaN() }), 0, 1, 0)
+	assert.ErrorIs(t, err, ErrNotFinite)
+
+	failing := errors.New("unknown function")
+	_, err = Derivative(func(float64) (float64, error) { return 0, failing }, 0, 1, 0)
+	assert.ErrorIs(t, err, failing)
+}
+
+// rosenbrock is (1 − x)² + 100(y − x²)².
+func rosenbrock(v []float64) (float64, error) {
+	x, y := v[0], v[1]
+	return (1-x)*(1-x) + 100*(y-x*x)*(y-x*x), nil
+}
+
+func TestGradient(t *testing.T) {
+	grad, err := Gradient(rosenbrock, []float64{0.5, 2}, 0)
+	require.NoError(t, err)
+	require.Len(t, grad, 2)
+	// ∂/∂x = −2(1 − x) − 400x(y − x²), ∂/∂y = 200(y − x²)
+	assert.InDelta(t, -1-400*0.5*1.75, grad[0].Value, 1e-9)
+	assert.InDelta(t, 350, grad[1].Value, 1e-9)
+}
+
+func TestHessian(t *testing.T) {
+	hess, err := Hessian(rosenbrock, []float64{1, 1}, 0)
+	require.NoError(t, err)
+	want := [][]float64{{802, -400}, {-400, 200}}
+	for i := range want {
+		for j := range want[i] {
+			assert.InDelta(t, want[i][j], hess[i][j].Value, 1e-7, "H[%d][%d]", i, j)
+		}
+	}
+	assert.Equal(t, hess[0][1], hess[1][0])
+
+	// Mixed partial of sin(x)·exp(2y) with different scales
+	f := func(v []float64) (float64, error) { return math.Sin(v[0]) * math.Exp(2*v[1]), nil }
+	hess, err = Hessian(f, []float64{0.3, 5}, 0)
+	require.NoError(t, err)
+	assert.InEpsilon(t, 2*math.Cos(0.3)*math.Exp(10), hess[0][1].Value, 1e-9)
+	assert.InEpsilon(t, 4*math.Sin(0.3)*math.Exp(10), hess[1][1].Value, 1e-9)
+}
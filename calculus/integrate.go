@@ -0,0 +1,521 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxEvaluations caps the function evaluations of an integration or of
+	// the solution of an ODE.
+	MaxEvaluations = 1_000_000
+	// MaxDimensions is the most variables IntegrateBox accepts; the
+	// Genz–Malik rule needs 2ⁿ points per region.
+	MaxDimensions = 3
+
+	// minWidth is the relative width below which a region is not bisected,
+	// as the nodes of the rule would start to coincide.
+	minWidth = 1000 * epsilon
+)
+
+// ErrNoConvergence is returned with the best estimate found when an
+// integration could not reach the requested accuracy.
+var ErrNoConvergence = errors.New("integration did not reach the requested accuracy")
+
+// SingularityError reports a point at which the integrand is not finite.
+type SingularityError struct {
+	Point []float64
+}
+
+func (e *SingularityError) Error() string {
+	coords := make([]string, len(e.Point))
+	for i, x := range e.Point {
+		coords[i] = strconv.FormatFloat(x, 'g', -1, 64)
+	}
+	return fmt.Sprintf("integrand is not finite at (%s)", strings.Join(coords, ", "))
+}
+
+// Integral is the result of a numerical integration.
+type Integral struct {
+	Estimate
+	// Evaluations counts the calls of the integrand.
+	Evaluations int
+}
+
+// Nodes and weights of the 7-point Gauss and 15-point Kronrod rules on
+// [−1, 1]; the Gauss nodes are the odd-indexed Kronrod nodes, and the last
+// node is the centre.
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329,
+		0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926,
+		0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013,
+		0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245,
+		0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970,
+		0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518,
+		0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550,
+		0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649,
+		0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082,
+		0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975,
+		0.417959183673469387755102040816327,
+	}
+)
+
+// Nodes of the Genz–Malik rule on [−1, 1]ⁿ.
+var (
+	genzMalik2 = math.Sqrt(9.0 / 70)
+	genzMalik4 = math.Sqrt(9.0 / 10)
+	genzMalik5 = math.Sqrt(9.0 / 19)
+)
+
+// region is a box of the unit cube with the estimate of a rule over it.
+type region struct {
+	centre, half []float64
+	value, err   float64
+	// axis is the direction to bisect the region along
+	axis int
+	// settled is set when the error is at the rounding level of the rule,
+	// so that bisecting the region cannot reduce it
+	settled bool
+}
+
+// split bisects r along its axis.
+func (r region) split() (region, region) {
+	left := region{centre: slices.Clone(r.centre), half: slices.Clone(r.half)}
+	left.half[r.axis] /= 2
+	right := region{centre: slices.Clone(left.centre), half: slices.Clone(left.half)}
+	left.centre[r.axis] -= left.half[r.axis]
+	right.centre[r.axis] += left.half[r.axis]
+	return left, right
+}
+
+// narrow reports whether r is too thin along its axis to bisect.
+func (r region) narrow() bool {
+	c, h := r.centre[r.axis], r.half[r.axis]
+	return 2*h <= minWidth*(math.Abs(c)+h)
+}
+
+// onBoundary reports whether r touches a face of the unit cube.
+func (r region) onBoundary() bool {
+	for i, c := range r.centre {
+		if c-r.half[i] <= 0 || c+r.half[i] >= 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// settle sets the error of r to err, or to the rounding error of a sum whose
+// terms add up to abs in magnitude if that is larger, in which case r is
+// settled.
+func (r *region) settle(err, abs float64) {
+	roundoff := 50 * epsilon * abs
+	if err <= roundoff {
+		err, r.settled = roundoff, true
+	}
+	r.err = err
+}
+
+// regionHeap orders regions by decreasing error.
+type regionHeap []region
+
+func (h regionHeap) Len() int           { return len(h) }
+func (h regionHeap) Less(i, j int) bool { return h[i].err > h[j].err }
+func (h regionHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *regionHeap) Push(x any)        { *h = append(*h, x.(region)) }
+func (h *regionHeap) Pop() any {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return r
+}
+
+// change is a change of variable from the unit interval, giving x and dx/du.
+type change func(u float64) (x, dx float64)
+
+// unitChange maps [0, 1] onto [a, b], a < b, either of which may be
+// infinite. An infinite range is first mapped onto a finite one. With smooth
+// set, the finite range is then mapped onto [0, 1] by
+// x = lo + (hi − lo)·(3u² − 2u³), whose derivative vanishes at both ends;
+// this tames endpoint singularities, so that 1/√x needs a few rules rather
+// than dozens of bisections, at the cost of some work on smooth integrands.
+func unitChange(a, b float64, smooth bool) change {
+	finite := func(t float64) (float64, float64) { return t, 1 }
+	lo, hi := a, b
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		finite = func(t float64) (float64, float64) {
+			s := 1 - t*t
+			return t / s, (1 + t*t) / (s * s)
+		}
+		lo, hi = -1, 1
+	case math.IsInf(b, 1):
+		finite = func(t float64) (float64, float64) {
+			s := 1 - t
+			return a + t/s, 1 / (s * s)
+		}
+		lo, hi = 0, 1
+	case math.IsInf(a, -1):
+		finite = func(t float64) (float64, float64) {
+			s := 1 - t
+			return b - t/s, 1 / (s * s)
+		}
+		lo, hi = 0, 1
+	}
+	w := hi - lo
+	if !smooth {
+		return func(u float64) (float64, float64) {
+			x, dx := finite(lo + w*u)
+			return x, dx * w
+		}
+	}
+	return func(u float64) (float64, float64) {
+		// Measure from the nearer end, so that points close to hi keep
+		// their distance from it
+		v := min(u, 1-u)
+		s := w * v * v * (3 - 2*v)
+		t := lo + s
+		if u > 0.5 {
+			t = hi - s
+		}
+		x, dx := finite(t)
+		return x, dx * 6 * w * v * (1 - v)
+	}
+}
+
+// integrator integrates a function over a box mapped onto the unit cube.
+type integrator struct {
+	f              MultiFunc
+	changes        []change
+	absTol, relTol float64
+	evaluations    int
+}
+
+// eval evaluates the integrand at the point u of the unit cube, including
+// the Jacobian of the change of variables.
+func (it *integrator) eval(u []float64) (float64, error) {
+	it.evaluations++
+	x := make([]float64, len(u))
+	jacobian := 1.0
+	for i, c := range it.changes {
+		var dx float64
+		x[i], dx = c(u[i])
+		jacobian *= dx
+	}
+	v, err := it.f(x)
+	if err != nil {
+		return 0, err
+	}
+	// A zero integrand contributes nothing however large the Jacobian
+	if v == 0 {
+		return 0, nil
+	}
+	v *= jacobian
+	if !isFinite(v) {
+		return 0, &SingularityError{Point: x}
+	}
+	return v, nil
+}
+
+// kronrod applies the 15-point Gauss–Kronrod rule to a one-dimensional
+// region. The error estimate is QUADPACK's: the difference from the 7-point
+// Gauss rule, scaled down when it is small relative to the variation of the
+// integrand.
+func (it *integrator) kronrod(r *region) error {
+	centre, half := r.centre[0], r.half[0]
+	var fv [15]float64
+	for i, x := range kronrodNodes {
+		for k, sign := range [2]float64{-1, 1} {
+			if i == 7 && k == 1 {
+				break
+			}
+			v, err := it.eval([]float64{centre + sign*half*x})
+			if err != nil {
+				return err
+			}
+			fv[2*i+k] = v
+		}
+	}
+	var kronrodSum, gaussSum, absSum float64
+	for i, w := range kronrodWeights {
+		pair := fv[2*i]
+		abs := math.Abs(fv[2*i])
+		if i < 7 {
+			pair += fv[2*i+1]
+			abs += math.Abs(fv[2*i+1])
+		}
+		kronrodSum += w * pair
+		absSum += w * abs
+		if i%2 == 1 {
+			gaussSum += gaussWeights[i/2] * pair
+		}
+	}
+	mean := kronrodSum / 2
+	var ascSum float64
+	for i, w := range kronrodWeights {
+		d := math.Abs(fv[2*i] - mean)
+		if i < 7 {
+			d += math.Abs(fv[2*i+1] - mean)
+		}
+		ascSum += w * d
+	}
+
+	r.value = kronrodSum * half
+	err := math.Abs(kronrodSum-gaussSum) * half
+	if asc := ascSum * half; asc != 0 && err != 0 {
+		err = asc * math.Min(1, math.Pow(200*err/asc, 1.5))
+	}
+	r.settle(err, absSum*half)
+	return nil
+}
+
+// genzMalik applies the degree 7 Genz–Malik rule to a region of two or more
+// dimensions, estimating the error by the embedded degree 5 rule. The region
+// is to be bisected along the axis with the largest fourth difference.
+func (it *integrator) genzMalik(r *region) error {
+	n := len(r.centre)
+	nf := float64(n)
+	p := slices.Clone(r.centre)
+	f0, err := it.eval(p)
+	if err != nil {
+		return err
+	}
+	// Sums over the points near and far on the axes, off two axes, and at
+	// the corners
+	var sums, abs [4]float64
+	add := func(k int) error {
+		v, err := it.eval(p)
+		sums[k] += v
+		abs[k] += math.Abs(v)
+		return err
+	}
+
+	var diff float64
+	for i := range n {
+		before := sums
+		for k, x := range [4]float64{genzMalik2, genzMalik2, genzMalik4, genzMalik4} {
+			p[i] = r.centre[i] + float64(2*(k%2)-1)*x*r.half[i]
+			if err := add(k / 2); err != nil {
+				return err
+			}
+		}
+		p[i] = r.centre[i]
+		near, far := sums[0]-before[0]-2*f0, sums[1]-before[1]-2*f0
+		d := math.Abs(near - genzMalik2*genzMalik2/(genzMalik4*genzMalik4)*far)
+		if d > diff || (d == diff && r.half[i] > r.half[r.axis]) {
+			diff, r.axis = d, i
+		}
+	}
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			for _, s := range [4][2]float64{{-1, -1}, {-1, 1}, {1, -1}, {1, 1}} {
+				p[i] = r.centre[i] + s[0]*genzMalik4*r.half[i]
+				p[j] = r.centre[j] + s[1]*genzMalik4*r.half[j]
+				if err := add(2); err != nil {
+					return err
+				}
+			}
+			p[i], p[j] = r.centre[i], r.centre[j]
+		}
+	}
+	for mask := range 1 << n {
+		for i := range n {
+			x := genzMalik5
+			if mask&(1<<i) != 0 {
+				x = -x
+			}
+			p[i] = r.centre[i] + x*r.half[i]
+		}
+		if err := add(3); err != nil {
+			return err
+		}
+	}
+
+	volume := 1.0
+	for _, h := range r.half {
+		volume *= 2 * h
+	}
+	weights := [5]float64{
+		(12824 - 9120*nf + 400*nf*nf) / 19683,
+		980.0 / 6561,
+		(1820 - 400*nf) / 19683,
+		200.0 / 19683,
+		6859.0 / 19683 / math.Exp2(nf),
+	}
+	degree5 := [4]float64{
+		(729 - 950*nf + 50*nf*nf) / 729,
+		245.0 / 486,
+		(265 - 100*nf) / 1458,
+		25.0 / 729,
+	}
+	value := weights[0] * f0
+	lower := degree5[0] * f0
+	absSum := math.Abs(weights[0] * f0)
+	for k := range sums {
+		value += weights[k+1] * sums[k]
+		absSum += math.Abs(weights[k+1]) * abs[k]
+		if k < 3 {
+			lower += degree5[k+1] * sums[k]
+		}
+	}
+	r.value = volume * value
+	r.settle(volume*math.Abs(value-lower), volume*absSum)
+	return nil
+}
+
+// adaptive integrates over the unit cube by bisecting the region with the
+// largest error until the total error is within the tolerance, every region
+// is at its rounding level, or the evaluation budget is spent.
+func (it *integrator) adaptive(rule func(*region) error) (Estimate, error) {
+	n := len(it.changes)
+	first := region{centre: make([]float64, n), half: make([]float64, n)}
+	for i := range n {
+		first.centre[i], first.half[i] = 0.5, 0.5
+	}
+	if err := rule(&first); err != nil {
+		return Estimate{}, err
+	}
+	var (
+		pending = regionHeap{first}
+		done    []region
+		total   = first.err
+		value   = first.value
+		stuck   bool
+	)
+	for len(pending) > 0 && total > math.Max(it.absTol, it.relTol*math.Abs(value)) {
+		if it.evaluations >= MaxEvaluations {
+			stuck = true
+			break
+		}
+		r := heap.Pop(&pending).(region)
+		if r.settled {
+			done = append(done, r)
+			continue
+		}
+		if r.narrow() {
+			// The nodes would no longer be distinct in floating point
+			done = append(done, r)
+			stuck = true
+			continue
+		}
+		left, right := r.split()
+		err := rule(&left)
+		if err == nil {
+			err = rule(&right)
+		}
+		if err == nil {
+			total += left.err + right.err - r.err
+			value += left.value + right.value - r.value
+			heap.Push(&pending, left)
+			heap.Push(&pending, right)
+			continue
+		}
+		// An integrand that overflows next to the boundary has a singularity
+		// there that cannot be resolved further
+		var singular *SingularityError
+		if !errors.As(err, &singular) || !r.onBoundary() {
+			return Estimate{}, err
+		}
+		done = append(done, r)
+		stuck = true
+	}
+
+	// Sum afresh to avoid the drift of the running totals, smallest first
+	all := append(done, pending...)
+	slices.SortFunc(all, func(x, y region) int {
+		return cmp.Compare(math.Abs(x.value), math.Abs(y.value))
+	})
+	var est Estimate
+	for _, r := range all {
+		est.Value += r.value
+		est.Error += r.err
+	}
+	if (stuck && est.Error > math.Max(it.absTol, it.relTol*math.Abs(est.Value))) || !isFinite(est.Value) {
+		return est, ErrNoConvergence
+	}
+	return est, nil
+}
+
+// integrate integrates f over the box with corners lower and upper.
+func integrate(f MultiFunc, lower, upper []float64, absTol, relTol float64) (Integral, error) {
+	if !(absTol >= 0) || math.IsInf(absTol, 0) {
+		return Integral{}, errors.New("absolute tolerance must be a non-negative number")
+	}
+	if !(relTol >= 0) || math.IsInf(relTol, 0) {
+		return Integral{}, errors.New("relative tolerance must be a non-negative number")
+	}
+	it := &integrator{f: f, absTol: absTol, relTol: relTol}
+	sign := 1.0
+	for i, a := range lower {
+		b := upper[i]
+		switch {
+		case math.IsNaN(a) || math.IsNaN(b):
+			return Integral{}, errors.New("integration bounds must not be NaN")
+		case a == b:
+			return Integral{}, nil
+		case a > b:
+			a, b, sign = b, a, -sign
+		}
+		// The substitution raises the degree of the integrand in every
+		// variable, which costs the cubature rule more than it gains
+		it.changes = append(it.changes, unitChange(a, b, len(lower) == 1))
+	}
+	rule := it.genzMalik
+	if len(lower) == 1 {
+		rule = it.kronrod
+	}
+	est, err := it.adaptive(rule)
+	if err != nil && !errors.Is(err, ErrNoConvergence) {
+		return Integral{}, err
+	}
+	est.Value *= sign
+	return Integral{Estimate: est, Evaluations: it.evaluations}, err
+}
+
+// Integrate estimates the integral of f over [a, b] by adaptive 15-point
+// Gauss–Kronrod quadrature, stopping once the estimated error is within
+// absTol or relTol times the magnitude of the integral. Either bound may be
+// infinite, and integrable singularities at the bounds are handled. On
+// ErrNoConvergence the best estimate is still returned.
+func Integrate(f Func, a, b, absTol, relTol float64) (Integral, error) {
+	return integrate(func(x []float64) (float64, error) { return f(x[0]) }, []float64{a}, []float64{b}, absTol, relTol)
+}
+
+// IntegrateBox estimates the integral of f over the box with corners lower
+// and upper, in two or three dimensions, by adaptive Genz–Malik cubature and
+// otherwise like Integrate. The cubature converges more slowly than
+// Integrate, so tolerances around 1e-6 are practical, and singularities on
+// the boundary slow it further.
+func IntegrateBox(f MultiFunc, lower, upper []float64, absTol, relTol float64) (Integral, error) {
+	if len(lower) != len(upper) {
+		return Integral{}, errors.New("lower and upper bounds must have the same length")
+	}
+	if len(lower) < 2 || len(lower) > MaxDimensions {
+		return Integral{}, fmt.Errorf("a box must have between 2 and %d dimensions", MaxDimensions)
+	}
+	return integrate(f, lower, upper, absTol, relTol)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrate(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"polynomial", func(x float64) float64 { return 3 * x * x }, 0, 2, 8},
+		{"sin", math.Sin, 0, math.Pi, 2},
+		{"reversed bounds", math.Sin, math.Pi, 0, -2},
+		{"empty", math.Exp, 1, 1, 0},
+		{"odd over symmetric interval", math.Sin, -1, 1, 0},
+		{"gaussian", func(x float64) float64 { return math.Exp(-x * x) }, math.Inf(-1), inf, math.Sqrt(math.Pi)},
+		{"upper infinite", func(x float64) float64 { return math.Exp(-x) }, 1, inf, math.Exp(-1)},
+		{"lower infinite", func(x float64) float64 { return 1 / (1 + x*x) }, math.Inf(-1), 0, math.Pi / 2},
+		{"inverse square root", func(x float64) float64 { return 1 / math.Sqrt(x) }, 0, 1, 2},
+		{"log", math.Log, 0, 1, -1},
+		{"strong endpoint singularity", func(x float64) float64 { return math.Pow(x, -0.9) }, 0, 1, 10},
+		{"kink", func(x float64) float64 { return math.Abs(x - 0.3) }, 0, 1, 0.29},
+		{"oscillating", func(x float64) float64 { return math.Cos(50 * x) }, 0, 1, math.Sin(50) / 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Integrate(exact(tt.f), tt.a, tt.b, 0, 1e-10)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got.Value, 1e-9*math.Max(1, math.Abs(tt.want)))
+			// The error estimate is honest
+			assert.LessOrEqual(t, math.Abs(got.Value-tt.want), got.Error+1e-15)
+			assert.Less(t, got.Error, 1e-8)
+			if tt.a != tt.b {
+				assert.Positive(t, got.Evaluations)
+			}
+		})
+	}
+}
+
+func TestIntegrateErrors(t *testing.T) {
+	_, err := Integrate(exact(func(x float64) float64 { return 1 / x }), -1, 1, 0, 1e-10)
+	var singular *SingularityError
+	require.ErrorAs(t, err, &singular)
+	assert.Equal(t, []float64{0}, singular.Point)
+	assert.EqualError(t, err, "integrand is not finite at (0)")
+
+	// Not integrable: the estimate is returned with a warning
+	got, err := Integrate(exact(func(x float64) float64 { return 1 / x }), 0, 1, 0, 1e-10)
+	assert.ErrorIs(t, err, ErrNoConvergence)
+	assert.Positive(t, got.Value)
+
+	got, err = Integrate(exact(func(x float64) float64 { return 1 / x }), 1, math.Inf(1), 0, 1e-10)
+	assert.ErrorIs(t, err, ErrNoConvergence)
+	assert.LessOrEqual(t, got.Evaluations, MaxEvaluations+30)
+
+	failing := errors.New("unknown function")
+	_, err = Integrate(func(float64) (float64, error) { return 0, failing }, 0, 1, 0, 1e-10)
+	assert.ErrorIs(t, err, failing)
+
+	_, err = Integrate(exact(math.Sin), 0, 1, -1, 1e-10)
+	assert.EqualError(t, err, "absolute tolerance must be a non-negative number")
+	_, err = Integrate(exact(math.Sin), math.NaN(), 1, 0, 1e-10)
+	assert.EqualError(t, err, "integration bounds must not be NaN")
+}
+
+func TestIntegrateTolerance(t *testing.T) {
+	loose, err := Integrate(exact(math.Sqrt), 0, 1, 1e-3, 0)
+	require.NoError(t, err)
+	tight, err := Integrate(exact(math.Sqrt), 0, 1, 0, 1e-12)
+	require.NoError(t, err)
+	assert.Less(t, loose.Evaluations, tight.Evaluations)
+	assert.InDelta(t, 2.0/3, loose.Value, 1e-3)
+	assert.InDelta(t, 2.0/3, tight.Value, 1e-12)
+}
+
+func TestIntegrateBox(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name         string
+		f            func(x []float64) float64
+		lower, upper []float64
+		want         float64
+	}{
+		{"area", func([]float64) float64 { return 1 }, []float64{0, 0}, []float64{2, 3}, 6},
+		{"product", func(x []float64) float64 { return x[0] * x[1] }, []float64{0, 0}, []float64{1, 2}, 1},
+		{"gaussian plane", func(x []float64) float64 { return math.Exp(-x[0]*x[0] - x[1]*x[1]) }, []float64{-inf, -inf}, []float64{inf, inf}, math.Pi},
+		{"gaussian space", func(x []float64) float64 { return math.Exp(-x[0]*x[0] - x[1]*x[1] - x[2]*x[2]) }, []float64{-inf, -inf, -inf}, []float64{inf, inf, inf}, math.Pow(math.Pi, 1.5)},
+		{"reversed axis", func(x []float64) float64 { return x[0] }, []float64{0, 1}, []float64{2, 0}, -2},
+		{"volume", func(x []float64) float64 { return x[0] + x[1]*x[2] }, []float64{0, 0, 0}, []float64{1, 1, 1}, 0.75},
+		{"singular corner", func(x []float64) float64 { return 1 / math.Sqrt(x[0]*x[1]) }, []float64{0, 0}, []float64{1, 1}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IntegrateBox(func(x []float64) (float64, error) { return tt.f(x), nil }, tt.lower, tt.upper, 0, 1e-6)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got.Value, 1e-6*math.Max(1, math.Abs(tt.want)))
+			assert.LessOrEqual(t, math.Abs(got.Value-tt.want), got.Error+1e-14)
+		})
+	}
+
+	// A discontinuity along a curve is not resolved
+	got, err := IntegrateBox(func(x []float64) (float64, error) { return math.Floor(x[0]*x[0] + x[1]*x[1]), nil }, []float64{-1, -1}, []float64{1, 1}, 0, 1e-10)
+	assert.ErrorIs(t, err, ErrNoConvergence)
+	assert.InDelta(t, 4-math.Pi, got.Value, 1e-3)
+
+	_, err = IntegrateBox(func(x []float64) (float64, error) { return 1 / (x[0] - x[1]), nil }, []float64{-1, -1}, []float64{1, 1}, 0, 1e-10)
+	var singular *SingularityError
+	require.ErrorAs(t, err, &singular)
+	assert.Len(t, singular.Point, 2)
+
+	_, err = IntegrateBox(func([]float64) (float64, error) { return 1, nil }, []float64{0}, []float64{1, 2}, 0, 1e-10)
+	assert.EqualError(t, err, "lower and upper bounds must have the same length")
+	_, err = IntegrateBox(func([]float64) (float64, error) { return 1, nil }, []float64{0}, []float64{1}, 0, 1e-10)
+	assert.EqualError(t, err, "a box must have between 2 and 3 dimensions")
+}
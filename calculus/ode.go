@@ -0,0 +1,557 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/sagacient/math-mcp-server/linalg"
+)
+
+// Method selects how SolveODE advances the solution.
+type Method string
+
+const (
+	// RK4 is the classical fourth-order Runge–Kutta method with a fixed
+	// step.
+	RK4 Method = "rk4"
+	// RK45 is the adaptive fifth-order Dormand–Prince method with an
+	// embedded fourth-order error estimate.
+	RK45 Method = "rk45"
+	// Stiff is an adaptive, linearly implicit second-order Rosenbrock
+	// method with a third-order error estimate, which stays stable at steps
+	// far beyond the fastest time scale of stiff problems.
+	Stiff Method = "stiff"
+)
+
+const (
+	// defaultRK4Steps is the number of RK4 steps over the whole range when
+	// no step is given.
+	defaultRK4Steps = 1000
+	// Step size control: the step changes by at most these factors, aiming
+	// at stepSafety times the size that would just meet the tolerance.
+	minStepFactor = 0.2
+	maxStepFactor = 5.0
+	stepSafety    = 0.9
+)
+
+var (
+	// ErrStepTooSmall is returned when an adaptive method cannot meet the
+	// tolerance at any representable step, as near a blow-up of the
+	// solution, or for a stiff problem solved with RK45 in rare cases.
+	ErrStepTooSmall = errors.New("step size became too small")
+	// ErrSolutionNotFinite is returned when the solution overflows.
+	ErrSolutionNotFinite = errors.New("solution is not finite")
+	// ErrTooManyEvaluations is returned when a solution would take more
+	// than MaxEvaluations evaluations of the system.
+	ErrTooManyEvaluations = fmt.Errorf("solution needs more than %d evaluations", MaxEvaluations)
+)
+
+// ODEError reports the time at which solving an ODE failed.
+type ODEError struct {
+	T   float64
+	Err error
+}
+
+func (e *ODEError) Error() string {
+	return fmt.Sprintf("%v at t = %g", e.Err, e.T)
+}
+
+func (e *ODEError) Unwrap() error { return e.Err }
+
+// System is the right-hand side f of y' = f(t, y): it stores f(t, y) in dy.
+type System func(t float64, y, dy []float64) error
+
+// ODEOptions configures SolveODE.
+type ODEOptions struct {
+	// Method defaults to RK45.
+	Method Method
+	// Step is the step of RK4, by default a thousandth of the range, and
+	// the first step tried by the adaptive methods, by default chosen from
+	// the system.
+	Step float64
+	// RelTol and AbsTol bound the local error of each step of the adaptive
+	// methods, in each component, by AbsTol + RelTol·|y|.
+	RelTol, AbsTol float64
+}
+
+// Trajectory is the solution of an ODE at the requested times.
+type Trajectory struct {
+	Times []float64
+	// States holds the solution at each time.
+	States [][]float64
+	// Steps and Rejected count the accepted and rejected steps.
+	Steps, Rejected int
+	// Evaluations counts the evaluations of the system.
+	Evaluations int
+}
+
+// odeSolver integrates one system, keeping count of the work done.
+type odeSolver struct {
+	f           System
+	n           int
+	opts        ODEOptions
+	evaluations int
+}
+
+// eval stores f(t, y) in dy.
+func (s *odeSolver) eval(t float64, y, dy []float64) error {
+	if s.evaluations >= MaxEvaluations {
+		return ErrTooManyEvaluations
+	}
+	s.evaluations++
+	return s.f(t, y, dy)
+}
+
+// errorNorm returns the root mean square of the error e relative to the
+// tolerance for the states y and next, or +Inf if either is not finite.
+func (s *odeSolver) errorNorm(e, y, next []float64) float64 {
+	var sum float64
+	for i := range e {
+		if !isFinite(next[i]) || !isFinite(e[i]) {
+			return math.Inf(1)
+		}
+		scale := s.opts.AbsTol + s.opts.RelTol*math.Max(math.Abs(y[i]), math.Abs(next[i]))
+		if scale == 0 {
+			if e[i] == 0 {
+				continue
+			}
+			return math.Inf(1)
+		}
+		r := e[i] / scale
+		sum += r * r
+	}
+	return math.Sqrt(sum / float64(len(e)))
+}
+
+// nextStep returns the step to try after a step h with the given error
+// norm, for a method whose error estimate is of the given order.
+func nextStep(h, norm float64, order int) float64 {
+	if norm == 0 {
+		return h * maxStepFactor
+	}
+	factor := stepSafety * math.Pow(norm, -1/float64(order+1))
+	return h * math.Min(maxStepFactor, math.Max(minStepFactor, factor))
+}
+
+// initialStep chooses a first step in the direction dir for a method of
+// the given order, by Hairer, Nørsett and Wanner's heuristic: it makes the
+// first Euler step change y by about 1% relative to the tolerance, and keeps
+// the estimated local error of the method within the tolerance.
+func (s *odeSolver) initialStep(t float64, y, dy []float64, dir, span float64, order int) (float64, error) {
+	rms := func(v []float64) float64 {
+		var sum float64
+		for i, x := range v {
+			r := x / (s.opts.AbsTol + s.opts.RelTol*math.Abs(y[i]))
+			sum += r * r
+		}
+		return math.Sqrt(sum / float64(len(v)))
+	}
+	d0, d1 := rms(y), rms(dy)
+	h0 := 1e-6
+	if d0 >= 1e-5 && d1 >= 1e-5 {
+		h0 = 0.01 * d0 / d1
+	}
+	h0 = math.Min(h0, span)
+	y1 := make([]float64, s.n)
+	for i := range y {
+		y1[i] = y[i] + dir*h0*dy[i]
+	}
+	dy1 := make([]float64, s.n)
+	if err := s.eval(t+dir*h0, y1, dy1); err != nil {
+		return 0, err
+	}
+	for i := range dy1 {
+		dy1[i] = (dy1[i] - dy[i]) / h0
+	}
+	d2 := rms(dy1)
+	h1 := math.Max(1e-6, h0*1e-3)
+	if d := math.Max(d1, d2); d > 1e-15 {
+		h1 = math.Pow(0.01/d, 1/float64(order+1))
+	}
+	h := math.Min(100*h0, h1)
+	if !isFinite(h) || h <= 0 {
+		h = h0
+	}
+	return dir * math.Min(h, span), nil
+}
+
+// tooSmall reports whether a step h at t is lost in the rounding of t.
+func tooSmall(t, h float64) bool {
+	return math.Abs(h) <= 16*epsilon*math.Max(math.Abs(t), math.SmallestNonzeroFloat64)
+}
+
+// SolveODE solves y' = f(t, y) with y(t0) = y0 and returns the solution at
+// times, which must be finite and run monotonically away from t0 in either
+// direction. The adaptive methods choose their steps to keep the local error
+// within the tolerances and land exactly on each requested time. On failure
+// the solution up to the last time reached is returned with an ODEError.
+func SolveODE(f System, t0 float64, y0, times []float64, opts ODEOptions) (Trajectory, error) {
+	if opts.Method == "" {
+		opts.Method = RK45
+	}
+	switch {
+	case len(y0) == 0:
+		return Trajectory{}, errors.New("the system must have at least one equation")
+	case len(times) == 0:
+		return Trajectory{}, errors.New("at least one output time is required")
+	case !isFinite(t0):
+		return Trajectory{}, errors.New("the initial time must be finite")
+	case !(opts.RelTol >= 0) || math.IsInf(opts.RelTol, 0):
+		return Trajectory{}, errors.New("relative tolerance must be a non-negative number")
+	case !(opts.AbsTol >= 0) || math.IsInf(opts.AbsTol, 0):
+		return Trajectory{}, errors.New("absolute tolerance must be a non-negative number")
+	case opts.RelTol == 0 && opts.AbsTol == 0 && opts.Method != RK4:
+		return Trajectory{}, errors.New("the tolerances must not both be zero")
+	case !(opts.Step >= 0) || math.IsInf(opts.Step, 0):
+		return Trajectory{}, errors.New("step must be a positive number")
+	}
+	for _, v := range y0 {
+		if !isFinite(v) {
+			return Trajectory{}, errors.New("initial values must be finite")
+		}
+	}
+	dir := 0.0
+	prev := t0
+	for _, t := range times {
+		if !isFinite(t) {
+			return Trajectory{}, errors.New("output times must be finite")
+		}
+		if d := math.Copysign(1, t-prev); t != prev {
+			if dir != 0 && d != dir {
+				return Trajectory{}, errors.New("output times must run monotonically away from the initial time")
+			}
+			dir = d
+		}
+		prev = t
+	}
+
+	s := &odeSolver{f: f, n: len(y0), opts: opts}
+	var run func(*Trajectory, float64, []float64, []float64) error
+	switch opts.Method {
+	case RK4:
+		span := math.Abs(times[len(times)-1] - t0)
+		if s.opts.Step == 0 {
+			s.opts.Step = span / defaultRK4Steps
+		}
+		if s.opts.Step == 0 {
+			s.opts.Step = 1
+		}
+		if span > 0 && span/s.opts.Step > float64(MaxEvaluations/4) {
+			return Trajectory{}, ErrTooManyEvaluations
+		}
+		run = s.rk4
+	case RK45:
+		run = s.dormandPrince
+	case Stiff:
+		run = s.rosenbrock
+	default:
+		return Trajectory{}, fmt.Errorf("unknown method %q", opts.Method)
+	}
+	traj := Trajectory{}
+	err := run(&traj, t0, slices.Clone(y0), times)
+	traj.Evaluations = s.evaluations
+	return traj, err
+}
+
+// record appends the state y at t to the trajectory.
+func (tr *Trajectory) record(t float64, y []float64) {
+	tr.Times = append(tr.Times, t)
+	tr.States = append(tr.States, slices.Clone(y))
+}
+
+// rk4 integrates with classical Runge–Kutta steps, dividing the interval up
+// to each output time into equal steps no longer than the step option.
+func (s *odeSolver) rk4(traj *Trajectory, t float64, y, times []float64) error {
+	n := s.n
+	k1, k2, k3, k4 := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	tmp := make([]float64, n)
+	for _, target := range times {
+		steps := math.Ceil(math.Abs(target-t) / s.opts.Step)
+		h := (target - t) / math.Max(steps, 1)
+		start := t
+		for i := 0; i < int(steps); i++ {
+			if err := s.eval(t, y, k1); err != nil {
+				return &ODEError{T: t, Err: err}
+			}
+			for j := range y {
+				tmp[j] = y[j] + h/2*k1[j]
+			}
+			if err := s.eval(t+h/2, tmp, k2); err != nil {
+				return &ODEError{T: t, Err: err}
+			}
+			for j := range y {
+				tmp[j] = y[j] + h/2*k2[j]
+			}
+			if err := s.eval(t+h/2, tmp, k3); err != nil {
+				return &ODEError{T: t, Err: err}
+			}
+			for j := range y {
+				tmp[j] = y[j] + h*k3[j]
+			}
+			if err := s.eval(t+h, tmp, k4); err != nil {
+				return &ODEError{T: t, Err: err}
+			}
+			for j := range y {
+				y[j] += h / 6 * (k1[j] + 2*k2[j] + 2*k3[j] + k4[j])
+				if !isFinite(y[j]) {
+					return &ODEError{T: t, Err: ErrSolutionNotFinite}
+				}
+			}
+			// Steps are counted from the start of the interval so that
+			// rounding does not accumulate in t
+			t = start + float64(i+1)*h
+			traj.Steps++
+		}
+		t = target
+		traj.record(t, y)
+	}
+	return nil
+}
+
+// Dormand–Prince coefficients: the nodes, the stages and the difference
+// between the fifth- and fourth-order weights. The fifth-order weights are
+// the last stage, whose derivative is the first stage of the next step.
+var (
+	dpNodes  = [7]float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1, 1}
+	dpStages = [7][6]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+		{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	}
+	dpError = [7]float64{71.0 / 57600, 0, -71.0 / 16695, 71.0 / 1920, -17253.0 / 339200, 22.0 / 525, -1.0 / 40}
+)
+
+// dormandPrince integrates with adaptive Dormand–Prince steps.
+func (s *odeSolver) dormandPrince(traj *Trajectory, t float64, y, times []float64) error {
+	n := s.n
+	var k [7][]float64
+	for i := range k {
+		k[i] = make([]float64, n)
+	}
+	next, errs := make([]float64, n), make([]float64, n)
+	if err := s.eval(t, y, k[0]); err != nil {
+		return &ODEError{T: t, Err: err}
+	}
+	return s.adaptive(traj, t, y, times, k[0], 4, func(t, h float64) (float64, error) {
+		for i := 1; i < 7; i++ {
+			for j := range next {
+				sum := 0.0
+				for m, a := range dpStages[i][:i] {
+					sum += a * k[m][j]
+				}
+				next[j] = y[j] + h*sum
+			}
+			if err := s.eval(t+dpNodes[i]*h, next, k[i]); err != nil {
+				return 0, err
+			}
+		}
+		for j := range errs {
+			sum := 0.0
+			for m, e := range dpError {
+				sum += e * k[m][j]
+			}
+			errs[j] = h * sum
+		}
+		norm := s.errorNorm(errs, y, next)
+		if norm <= 1 {
+			copy(y, next)
+			copy(k[0], k[6])
+		}
+		return norm, nil
+	})
+}
+
+// step attempts a step of size h from t, returning the error norm, and
+// replaces y by the new state when the norm is at most 1.
+type step func(t, h float64) (float64, error)
+
+// adaptive drives an adaptive method of the given error order from the
+// state y at t with derivative dy, recording the solution at each time.
+func (s *odeSolver) adaptive(traj *Trajectory, t float64, y, times, dy []float64, order int, try step) error {
+	last := times[len(times)-1]
+	span := math.Abs(last - t)
+	dir := math.Copysign(1, last-t)
+	h := dir * s.opts.Step
+	if s.opts.Step == 0 && span > 0 {
+		var err error
+		if h, err = s.initialStep(t, y, dy, dir, span, order); err != nil {
+			return &ODEError{T: t, Err: err}
+		}
+	}
+	for _, target := range times {
+		for t != target {
+			// Land exactly on the output time
+			clipped := math.Abs(h) >= math.Abs(target-t)
+			attempt := h
+			if clipped {
+				attempt = target - t
+			}
+			if tooSmall(t, attempt) && !clipped {
+				return &ODEError{T: t, Err: ErrStepTooSmall}
+			}
+			norm, err := try(t, attempt)
+			if err != nil {
+				return &ODEError{T: t, Err: err}
+			}
+			if norm > 1 {
+				traj.Rejected++
+				h = nextStep(attempt, norm, order)
+				if tooSmall(t, h) {
+					return &ODEError{T: t, Err: ErrStepTooSmall}
+				}
+				continue
+			}
+			traj.Steps++
+			if clipped {
+				t = target
+			} else {
+				t += attempt
+			}
+			// A step shortened to reach an output time does not shrink the
+			// next one
+			h = nextStep(attempt, norm, order)
+			if clipped && math.Abs(h) < math.Abs(attempt) {
+				h = attempt
+			}
+		}
+		traj.record(t, y)
+	}
+	return nil
+}
+
+// Rosenbrock coefficients of Shampine and Reichelt's second-order method
+// with a third-order error estimate (MATLAB's ode23s).
+var (
+	rosenbrockD   = 1 / (2 + math.Sqrt2)
+	rosenbrockE32 = 6 + math.Sqrt2
+)
+
+// jacobian estimates ∂f/∂y and ∂f/∂t at (t, y) by forward differences,
+// given f0 = f(t, y).
+func (s *odeSolver) jacobian(t float64, y, f0 []float64) (*linalg.Matrix, []float64, error) {
+	n := s.n
+	jac := linalg.New(n, n)
+	shifted := slices.Clone(y)
+	df := make([]float64, n)
+	for j := range n {
+		d := math.Sqrt(epsilon) * math.Max(math.Abs(y[j]), 1)
+		shifted[j] = y[j] + d
+		d = shifted[j] - y[j]
+		if err := s.eval(t, shifted, df); err != nil {
+			return nil, nil, err
+		}
+		for i := range n {
+			jac.Set(i, j, (df[i]-f0[i])/d)
+		}
+		shifted[j] = y[j]
+	}
+	d := math.Sqrt(epsilon) * math.Max(math.Abs(t), 1)
+	d = (t + d) - t
+	if err := s.eval(t+d, y, df); err != nil {
+		return nil, nil, err
+	}
+	dt := make([]float64, n)
+	for i := range n {
+		dt[i] = (df[i] - f0[i]) / d
+	}
+	return jac, dt, nil
+}
+
+// rosenbrock integrates with adaptive Rosenbrock steps. Each step solves
+// three linear systems with the matrix I − h·d·J instead of the nonlinear
+// equations of a fully implicit method, with the Jacobian J estimated by
+// finite differences once per accepted step.
+func (s *odeSolver) rosenbrock(traj *Trajectory, t float64, y, times []float64) error {
+	n := s.n
+	f0, f1, f2 := make([]float64, n), make([]float64, n), make([]float64, n)
+	mid, next, errs := make([]float64, n), make([]float64, n), make([]float64, n)
+	if err := s.eval(t, y, f0); err != nil {
+		return &ODEError{T: t, Err: err}
+	}
+	var jac *linalg.Matrix
+	var dt []float64
+	// jacobianAt is the time at which jac was estimated
+	jacobianAt := math.NaN()
+
+	solve := func(lu *linalg.LU, b []float64) ([]float64, error) {
+		x, err := lu.Solve(&linalg.Matrix{Rows: n, Cols: 1, Data: b})
+		if err != nil {
+			return nil, err
+		}
+		return x.Data, nil
+	}
+	return s.adaptive(traj, t, y, times, f0, 2, func(t, h float64) (float64, error) {
+		if jacobianAt != t {
+			var err error
+			if jac, dt, err = s.jacobian(t, y, f0); err != nil {
+				return 0, err
+			}
+			jacobianAt = t
+		}
+		hd := h * rosenbrockD
+		w := linalg.Identity(n)
+		for i := range w.Data {
+			w.Data[i] -= hd * jac.Data[i]
+		}
+		lu := linalg.NewLU(w)
+		if lu.IsSingular() {
+			return math.Inf(1), nil
+		}
+
+		b := make([]float64, n)
+		for i := range b {
+			b[i] = f0[i] + hd*dt[i]
+		}
+		k1, err := solve(lu, b)
+		if err != nil {
+			return math.Inf(1), nil
+		}
+		for i := range mid {
+			mid[i] = y[i] + h/2*k1[i]
+		}
+		if err := s.eval(t+h/2, mid, f1); err != nil {
+			return 0, err
+		}
+		for i := range b {
+			b[i] = f1[i] - k1[i]
+		}
+		k2, err := solve(lu, b)
+		if err != nil {
+			return math.Inf(1), nil
+		}
+		for i := range k2 {
+			k2[i] += k1[i]
+			next[i] = y[i] + h*k2[i]
+		}
+		if err := s.eval(t+h, next, f2); err != nil {
+			return 0, err
+		}
+		for i := range b {
+			b[i] = f2[i] - rosenbrockE32*(k2[i]-f1[i]) - 2*(k1[i]-f0[i]) + hd*dt[i]
+		}
+		k3, err := solve(lu, b)
+		if err != nil {
+			return math.Inf(1), nil
+		}
+		for i := range errs {
+			errs[i] = h / 6 * (k1[i] - 2*k2[i] + k3[i])
+		}
+		norm := s.errorNorm(errs, y, next)
+		if norm <= 1 {
+			copy(y, next)
+			copy(f0, f2)
+		}
+		return norm, nil
+	})
+}
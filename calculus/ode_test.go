@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// oscillator is the harmonic oscillator x' = v, v' = −x, with solution
+// (cos t, −sin t) from (1, 0).
+func oscillator(_ float64, y, dy []float64) error {
+	dy[0], dy[1] = y[1], -y[0]
+	return nil
+}
+
+// robertson is Robertson's stiff chemical kinetics problem.
+func robertson(_ float64, y, dy []float64) error {
+	dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+	dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+	dy[2] = 3e7 * y[1] * y[1]
+	return nil
+}
+
+func TestSolveODE(t *testing.T) {
+	times := []float64{1, 2, 5, 10}
+	for _, tt := range []struct {
+		method Method
+		tol    float64
+	}{
+		{RK4, 1e-9},
+		{RK45, 1e-6},
+		{Stiff, 1e-4},
+	} {
+		t.Run(string(tt.method), func(t *testing.T) {
+			traj, err := SolveODE(oscillator, 0, []float64{1, 0}, times, ODEOptions{Method: tt.method, Step: 0.01, RelTol: 1e-8, AbsTol: 1e-10})
+			require.NoError(t, err)
+			assert.Equal(t, times, traj.Times)
+			for i, ts := range times {
+				assert.InDelta(t, math.Cos(ts), traj.States[i][0], tt.tol)
+				assert.InDelta(t, -math.Sin(ts), traj.States[i][1], tt.tol)
+			}
+			assert.Positive(t, traj.Steps)
+			assert.Positive(t, traj.Evaluations)
+		})
+	}
+}
+
+func TestSolveODEBackwards(t *testing.T) {
+	// y' = y integrated from 1 back to 0 and −1
+	growth := func(_ float64, y, dy []float64) error {
+		dy[0] = y[0]
+		return nil
+	}
+	traj, err := SolveODE(growth, 1, []float64{math.E}, []float64{1, 0, -1}, ODEOptions{RelTol: 1e-10, AbsTol: 1e-12})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 0, -1}, traj.Times)
+	assert.InDelta(t, math.E, traj.States[0][0], 0)
+	assert.InDelta(t, 1, traj.States[1][0], 1e-8)
+	assert.InDelta(t, 1/math.E, traj.States[2][0], 1e-8)
+}
+
+func TestSolveODEStiff(t *testing.T) {
+	opts := ODEOptions{Method: Stiff, RelTol: 1e-4, AbsTol: 1e-8}
+	traj, err := SolveODE(robertson, 0, []float64{1, 0, 0}, []float64{40, 1e5}, opts)
+	require.NoError(t, err)
+	// Reference values from a tight-tolerance solution
+	assert.InDelta(t, 0.7158, traj.States[0][0], 2e-3)
+	assert.InDelta(t, 9.185e-6, traj.States[0][1], 1e-7)
+	assert.InDelta(t, 0.2842, traj.States[0][2], 2e-3)
+	assert.InDelta(t, 1, traj.States[1][0]+traj.States[1][1]+traj.States[1][2], 1e-6)
+	// The stiff method needs far fewer steps than the explicit one
+	explicit, err := SolveODE(robertson, 0, []float64{1, 0, 0}, []float64{40}, ODEOptions{RelTol: 1e-4, AbsTol: 1e-8})
+	require.NoError(t, err)
+	assert.Greater(t, explicit.Steps, 10*traj.Steps)
+}
+
+func TestSolveODETolerance(t *testing.T) {
+	loose, err := SolveODE(oscillator, 0, []float64{1, 0}, []float64{10}, ODEOptions{RelTol: 1e-3, AbsTol: 1e-6})
+	require.NoError(t, err)
+	tight, err := SolveODE(oscillator, 0, []float64{1, 0}, []float64{10}, ODEOptions{RelTol: 1e-10, AbsTol: 1e-12})
+	require.NoError(t, err)
+	assert.Greater(t, tight.Steps, loose.Steps)
+	assert.Less(t, math.Abs(tight.States[0][0]-math.Cos(10)), 1e-8)
+}
+
+func TestSolveODEErrors(t *testing.T) {
+	// y' = y² from 1 blows up at t = 1
+	blowUp := func(_ float64, y, dy []float64) error {
+		dy[0] = y[0] * y[0]
+		return nil
+	}
+	traj, err := SolveODE(blowUp, 0, []float64{1}, []float64{0.5, 2}, ODEOptions{RelTol: 1e-6, AbsTol: 1e-9})
+	var odeErr *ODEError
+	require.ErrorAs(t, err, &odeErr)
+	assert.ErrorIs(t, err, ErrStepTooSmall)
+	assert.InDelta(t, 1, odeErr.T, 1e-3)
+	// The solution up to the failure is kept
+	assert.Equal(t, []float64{0.5}, traj.Times)
+	assert.InDelta(t, 2, traj.States[0][0], 1e-5)
+
+	_, err = SolveODE(blowUp, 0, []float64{1}, []float64{2}, ODEOptions{Method: RK4, Step: 0.01})
+	assert.ErrorIs(t, err, ErrSolutionNotFinite)
+
+	failing := errors.New("bad input")
+	_, err = SolveODE(func(float64, []float64, []float64) error { return failing }, 0, []float64{1}, []float64{1}, ODEOptions{RelTol: 1e-6})
+	assert.ErrorIs(t, err, failing)
+
+	_, err = SolveODE(oscillator, 0, []float64{1, 0}, []float64{1e9}, ODEOptions{Method: RK4, Step: 1e-3})
+	assert.ErrorIs(t, err, ErrTooManyEvaluations)
+
+	tests := []struct {
+		name  string
+		y0    []float64
+		times []float64
+		opts  ODEOptions
+		msg   string
+	}{
+		{"no equations", nil, []float64{1}, ODEOptions{RelTol: 1e-6}, "the system must have at least one equation"},
+		{"no times", []float64{1}, nil, ODEOptions{RelTol: 1e-6}, "at least one output time is required"},
+		{"non-monotonic times", []float64{1}, []float64{1, 0.5}, ODEOptions{RelTol: 1e-6}, "output times must run monotonically away from the initial time"},
+		{"infinite time", []float64{1}, []float64{math.Inf(1)}, ODEOptions{RelTol: 1e-6}, "output times must be finite"},
+		{"NaN initial value", []float64{math.NaN()}, []float64{1}, ODEOptions{RelTol: 1e-6}, "initial values must be finite"},
+		{"zero tolerances", []float64{1}, []float64{1}, ODEOptions{}, "the tolerances must not both be zero"},
+		{"negative step", []float64{1}, []float64{1}, ODEOptions{RelTol: 1e-6, Step: -1}, "step must be a positive number"},
+		{"unknown method", []float64{1}, []float64{1}, ODEOptions{Method: "euler", RelTol: 1e-6}, `unknown method "euler"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SolveODE(blowUp, 0, tt.y0, tt.times, tt.opts)
+			assert.EqualError(t, err, tt.msg)
+		})
+	}
+}
@@ -0,0 +1,487 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sagacient/math-mcp-server/linalg"
+)
+
+// maxBacktracks bounds the halvings of a Newton step that leaves the domain
+// of the function or fails to reduce the residual.
+const maxBacktracks = 50
+
+var (
+	// ErrZeroSlope is returned when Newton's or the secant method meets a
+	// point where the function is flat, so that no step can be taken.
+	ErrZeroSlope = errors.New("slope is zero")
+	// ErrNoSignChange is returned by the bracketing methods when they
+	// converge to a point where the function changes sign without
+	// vanishing, such as a pole or a jump.
+	ErrNoSignChange = errors.New("function changes sign without vanishing")
+	// ErrSingularJacobian is returned when the Jacobian of a system is
+	// singular at an iterate.
+	ErrSingularJacobian = errors.New("Jacobian is singular")
+	// ErrStalled is returned when no step along the Newton direction
+	// reduces the residual of a system, as near a local minimum of its norm
+	// that is not a root.
+	ErrStalled = errors.New("no step reduces the residual")
+)
+
+// BracketError reports a bracket at whose ends the function has the same
+// sign.
+type BracketError struct {
+	A, B, FA, FB float64
+}
+
+func (e *BracketError) Error() string {
+	return fmt.Sprintf("f(%g) = %g and f(%g) = %g do not have opposite signs", e.A, e.FA, e.B, e.FB)
+}
+
+// Root is the result of a search for a root of a function of one variable.
+type Root struct {
+	X float64
+	// Residual is the value of the function at X.
+	Residual   float64
+	Iterations int
+	// Converged is false when the iterations ran out before the tolerance
+	// was met.
+	Converged bool
+}
+
+// converged reports whether a step of size dx from x meets the absolute
+// tolerance tol, or the rounding level of x.
+func converged(dx, x, tol float64) bool {
+	return math.Abs(dx) <= tol+4*epsilon*math.Abs(x)
+}
+
+// evalFinite evaluates f at x, reporting ErrNotFinite for a value that is
+// not finite.
+func evalFinite(f Func, x float64) (float64, error) {
+	v, err := f(x)
+	if err != nil {
+		return 0, err
+	}
+	if !isFinite(v) {
+		return 0, ErrNotFinite
+	}
+	return v, nil
+}
+
+// bracket evaluates f at the ends of [a, b] and checks that they differ in
+// sign, returning a root if one of them is.
+func bracket(f Func, a, b float64) (fa, fb float64, root *Root, err error) {
+	if !isFinite(a) || !isFinite(b) {
+		return 0, 0, nil, errors.New("bracket must be finite")
+	}
+	if a == b {
+		return 0, 0, nil, errors.New("bracket must have distinct ends")
+	}
+	if fa, err = evalFinite(f, a); err != nil {
+		return 0, 0, nil, err
+	}
+	if fb, err = evalFinite(f, b); err != nil {
+		return 0, 0, nil, err
+	}
+	switch {
+	case fa == 0:
+		return fa, fb, &Root{X: a, Converged: true}, nil
+	case fb == 0:
+		return fa, fb, &Root{X: b, Converged: true}, nil
+	case math.Signbit(fa) == math.Signbit(fb):
+		return 0, 0, nil, &BracketError{A: a, B: b, FA: fa, FB: fb}
+	}
+	return fa, fb, nil, nil
+}
+
+// checkSignChange returns ErrNoSignChange with a converged root whose
+// residual is comparable to the values at the ends of the bracket: near a
+// true root the residual shrinks with the bracket, but at a pole it grows and
+// at a jump, such as that of sign(x), it stays as large as at the ends.
+func checkSignChange(r Root, fa, fb float64) (Root, error) {
+	if r.Converged && !(math.Abs(r.Residual) < math.Min(math.Abs(fa), math.Abs(fb))/2) {
+		return r, ErrNoSignChange
+	}
+	return r, nil
+}
+
+// Bisect finds a root of f in [a, b], at whose ends f must differ in sign,
+// by halving the bracket until it is within tol, or the rounding level of
+// the root, in at most maxIter iterations.
+func Bisect(f Func, a, b, tol float64, maxIter int) (Root, error) {
+	fa, fb, root, err := bracket(f, a, b)
+	if root != nil || err != nil {
+		return derefRoot(root), err
+	}
+	f0a, f0b := fa, fb
+	r := Root{}
+	for r.Iterations < maxIter {
+		r.Iterations++
+		m := a + (b-a)/2
+		fm, err := f(m)
+		if err != nil {
+			return r, err
+		}
+		r.X, r.Residual = m, fm
+		if fm == 0 || converged((b-a)/2, m, tol) {
+			r.Converged = true
+			break
+		}
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+	return checkSignChange(r, f0a, f0b)
+}
+
+// derefRoot returns *r, or the zero Root if r is nil.
+func derefRoot(r *Root) Root {
+	if r == nil {
+		return Root{}
+	}
+	return *r
+}
+
+// Brent finds a root of f in [a, b], at whose ends f must differ in sign,
+// by Brent's method: inverse quadratic interpolation and secant steps where
+// they make progress, and bisection where they do not, so that it converges
+// superlinearly for smooth functions and never more slowly than Bisect.
+func Brent(f Func, a, b, tol float64, maxIter int) (Root, error) {
+	fa, fb, root, err := bracket(f, a, b)
+	if root != nil || err != nil {
+		return derefRoot(root), err
+	}
+	f0a, f0b := fa, fb
+	// b is the best estimate, a the previous one and c the other end of
+	// the bracket around the root
+	c, fc := a, fa
+	d := b - a
+	e := d
+	r := Root{X: b, Residual: fb}
+	for r.Iterations < maxIter {
+		if math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol1 := 2*epsilon*math.Abs(b) + tol/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol1 || fb == 0 {
+			r.X, r.Residual, r.Converged = b, fb, true
+			break
+		}
+		r.Iterations++
+		if math.Abs(e) >= tol1 && math.Abs(fa) > math.Abs(fb) {
+			// Interpolate: the secant through a and b, or the inverse
+			// quadratic through a, b and c
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				t := fb / fc
+				p = s * (2*m*q*(q-t) - (b-a)*(t-1))
+				q = (q - 1) * (t - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			// Accept the interpolation only if it falls well inside the
+			// bracket and shrinks faster than bisection would
+			if 2*p < math.Min(3*m*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d, e = m, m
+			}
+		} else {
+			d, e = m, m
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, m)
+		}
+		if fb, err = f(b); err != nil {
+			return r, err
+		}
+		r.X, r.Residual = b, fb
+	}
+	return checkSignChange(r, f0a, f0b)
+}
+
+// slope estimates f'(x) by a central difference, given fx = f(x), falling
+// back to one-sided differences where f is not finite on one side.
+func slope(f Func, x, fx float64) (float64, error) {
+	h := math.Cbrt(epsilon) * math.Max(math.Abs(x), 1)
+	h = (x + h) - x
+	fr, err := f(x + h)
+	if err != nil {
+		return 0, err
+	}
+	fl, err := f(x - h)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case isFinite(fr) && isFinite(fl):
+		return (fr - fl) / (2 * h), nil
+	case isFinite(fr):
+		return (fr - fx) / h, nil
+	case isFinite(fl):
+		return (fx - fl) / h, nil
+	}
+	return 0, ErrNotFinite
+}
+
+// newtonStep moves from x along the step dx, halving it while f is not
+// finite there. It returns the new point and the value of f there.
+func newtonStep(f Func, x, dx float64) (float64, float64, error) {
+	for range maxBacktracks {
+		next := x + dx
+		fn, err := f(next)
+		if err != nil {
+			return 0, 0, err
+		}
+		if isFinite(fn) {
+			return next, fn, nil
+		}
+		dx /= 2
+	}
+	return 0, 0, ErrNotFinite
+}
+
+// Newton finds a root of f from the guess x0 by Newton's method, with the
+// derivative estimated by central differences. Steps that leave the domain
+// of f are halved. It stops when a step is within tol or the rounding level
+// of the root, after at most maxIter iterations.
+func Newton(f Func, x0, tol float64, maxIter int) (Root, error) {
+	if !isFinite(x0) {
+		return Root{}, errors.New("guess must be finite")
+	}
+	fx, err := evalFinite(f, x0)
+	if err != nil {
+		return Root{}, err
+	}
+	r := Root{X: x0, Residual: fx, Converged: fx == 0}
+	for !r.Converged && r.Iterations < maxIter {
+		r.Iterations++
+		d, err := slope(f, r.X, r.Residual)
+		if err != nil {
+			return r, err
+		}
+		if d == 0 {
+			return r, ErrZeroSlope
+		}
+		dx := -r.Residual / d
+		if !isFinite(dx) {
+			return r, ErrZeroSlope
+		}
+		x, fx, err := newtonStep(f, r.X, dx)
+		if err != nil {
+			return r, err
+		}
+		r.Converged = fx == 0 || converged(x-r.X, x, tol)
+		r.X, r.Residual = x, fx
+	}
+	return r, nil
+}
+
+// Secant finds a root of f from the guesses x0 and x1 by the secant method,
+// which needs one evaluation of f per iteration instead of Newton's three,
+// and converges almost as fast. Steps that leave the domain of f are halved.
+func Secant(f Func, x0, x1, tol float64, maxIter int) (Root, error) {
+	if !isFinite(x0) || !isFinite(x1) {
+		return Root{}, errors.New("guesses must be finite")
+	}
+	if x0 == x1 {
+		return Root{}, errors.New("guesses must be distinct")
+	}
+	f0, err := evalFinite(f, x0)
+	if err != nil {
+		return Root{}, err
+	}
+	f1, err := evalFinite(f, x1)
+	if err != nil {
+		return Root{}, err
+	}
+	r := Root{X: x1, Residual: f1, Converged: f1 == 0}
+	if f0 == 0 {
+		r = Root{X: x0, Residual: f0, Converged: true}
+	}
+	for !r.Converged && r.Iterations < maxIter {
+		r.Iterations++
+		if f1 == f0 {
+			return r, ErrZeroSlope
+		}
+		dx := -f1 * (x1 - x0) / (f1 - f0)
+		if !isFinite(dx) {
+			return r, ErrZeroSlope
+		}
+		x, fx, err := newtonStep(f, x1, dx)
+		if err != nil {
+			return r, err
+		}
+		x0, f0 = x1, f1
+		x1, f1 = x, fx
+		r.Converged = fx == 0 || converged(x1-x0, x1, tol)
+		r.X, r.Residual = x1, f1
+	}
+	return r, nil
+}
+
+// SystemRoot is the result of a search for a root of a system of equations.
+type SystemRoot struct {
+	X []float64
+	// Residuals are the values of the equations at X.
+	Residuals  []float64
+	Iterations int
+	// Converged is false when the iterations ran out before the tolerance
+	// was met.
+	Converged bool
+}
+
+// evalSystem evaluates the equations fs at x, reporting ErrNotFinite for a
+// value that is not finite.
+func evalSystem(fs []MultiFunc, x []float64) ([]float64, error) {
+	v := make([]float64, len(fs))
+	for i, f := range fs {
+		fx, err := f(x)
+		if err != nil {
+			return nil, fmt.Errorf("equation %d: %w", i+1, err)
+		}
+		if !isFinite(fx) {
+			return nil, ErrNotFinite
+		}
+		v[i] = fx
+	}
+	return v, nil
+}
+
+// norm2 returns the Euclidean norm of v.
+func norm2(v []float64) float64 {
+	var s float64
+	for _, x := range v {
+		s = math.Hypot(s, x)
+	}
+	return s
+}
+
+// NewtonSystem finds a root of the square system fs(x) = 0 from the guess
+// x0 by Newton's method, with the Jacobian estimated by forward differences.
+// Each Newton step is halved until it reduces the Euclidean norm of the
+// residuals, which makes the method converge from further away. It stops
+// when a step is within tol, or the rounding level of x, in every component.
+func NewtonSystem(fs []MultiFunc, x0 []float64, tol float64, maxIter int) (SystemRoot, error) {
+	n := len(x0)
+	if len(fs) != n {
+		return SystemRoot{}, fmt.Errorf("there are %d equations but %d variables", len(fs), n)
+	}
+	if n == 0 {
+		return SystemRoot{}, errors.New("the system must have at least one equation")
+	}
+	for _, x := range x0 {
+		if !isFinite(x) {
+			return SystemRoot{}, errors.New("guess must be finite")
+		}
+	}
+	x := append([]float64(nil), x0...)
+	fx, err := evalSystem(fs, x)
+	if err != nil {
+		return SystemRoot{}, err
+	}
+	r := SystemRoot{X: x, Residuals: fx, Converged: norm2(fx) == 0}
+	for !r.Converged && r.Iterations < maxIter {
+		r.Iterations++
+		jac := linalg.New(n, n)
+		shifted := append([]float64(nil), x...)
+		for j := range n {
+			h := math.Sqrt(epsilon) * math.Max(math.Abs(x[j]), 1)
+			shifted[j] = x[j] + h
+			h = shifted[j] - x[j]
+			for i, f := range fs {
+				v, err := f(shifted)
+				if err != nil {
+					return r, fmt.Errorf("equation %d: %w", i+1, err)
+				}
+				jac.Set(i, j, (v-fx[i])/h)
+			}
+			shifted[j] = x[j]
+		}
+		lu := linalg.NewLU(jac)
+		rhs := linalg.New(n, 1)
+		for i, v := range fx {
+			rhs.Data[i] = -v
+		}
+		step, err := lu.Solve(rhs)
+		if err != nil || !allFinite(step.Data) {
+			return r, ErrSingularJacobian
+		}
+		dx := step.Data
+
+		// A step at the rounding level of x has converged, whether or not
+		// it reduces the residual
+		small := true
+		for i := range dx {
+			small = small && converged(dx[i], x[i], tol)
+		}
+		norm := norm2(fx)
+		next := make([]float64, n)
+		accepted := false
+		for range maxBacktracks {
+			for i := range next {
+				next[i] = x[i] + dx[i]
+			}
+			fn, err := evalSystem(fs, next)
+			if err != nil && !errors.Is(err, ErrNotFinite) {
+				return r, err
+			}
+			if err == nil && (small || norm2(fn) < norm) {
+				x, fx, accepted = next, fn, true
+				break
+			}
+			for i := range dx {
+				dx[i] /= 2
+			}
+		}
+		if !accepted {
+			return r, ErrStalled
+		}
+		r.X, r.Residuals = x, fx
+		r.Converged = small || norm2(fx) == 0
+		if !r.Converged {
+			r.Converged = true
+			for i := range dx {
+				r.Converged = r.Converged && converged(dx[i], x[i], tol)
+			}
+		}
+	}
+	return r, nil
+}
+
+// allFinite reports whether every value is finite.
+func allFinite(values []float64) bool {
+	for _, v := range values {
+		if !isFinite(v) {
+			return false
+		}
+	}
+	return true
+}
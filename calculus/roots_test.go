@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package calculus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// omega is the root of x eˣ = 1, the omega constant.
+const omega = 0.5671432904097838
+
+func TestBracketingRoots(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"cubic", func(x float64) float64 { return x*x*x - 2*x - 5 }, 2, 3, 2.0945514815423265},
+		{"omega", func(x float64) float64 { return x*math.Exp(x) - 1 }, 0, 1, omega},
+		{"reversed bracket", math.Cos, 2, 1, math.Pi / 2},
+		{"root at an end", math.Sin, 0, 1, 0},
+		{"flat near the root", func(x float64) float64 { return math.Pow(x-1, 5) }, 0, 3, 1},
+		{"wide bracket", func(x float64) float64 { return math.Log(x) - 10 }, 1, 1e10, math.Exp(10)},
+	}
+	for _, tt := range tests {
+		for _, method := range []struct {
+			name  string
+			solve func(Func, float64, float64, float64, int) (Root, error)
+		}{{"bisection", Bisect}, {"brent", Brent}} {
+			t.Run(tt.name+"/"+method.name, func(t *testing.T) {
+				r, err := method.solve(exact(tt.f), tt.a, tt.b, 0, 200)
+				require.NoError(t, err)
+				assert.True(t, r.Converged)
+				assert.InDelta(t, tt.want, r.X, 1e-6*math.Max(1, math.Abs(tt.want)))
+				assert.InDelta(t, tt.f(r.X), r.Residual, 0)
+			})
+		}
+	}
+}
+
+func TestBrentIsFaster(t *testing.T) {
+	f := exact(func(x float64) float64 { return x*math.Exp(x) - 1 })
+	bisect, err := Bisect(f, 0, 1, 1e-14, 200)
+	require.NoError(t, err)
+	brent, err := Brent(f, 0, 1, 1e-14, 200)
+	require.NoError(t, err)
+	assert.InDelta(t, omega, brent.X, 1e-14)
+	assert.Less(t, brent.Iterations, bisect.Iterations/3)
+}
+
+func TestBracketErrors(t *testing.T) {
+	_, err := Brent(exact(func(x float64) float64 { return x*x + 1 }), -1, 2, 0, 100)
+	var bracketErr *BracketError
+	require.ErrorAs(t, err, &bracketErr)
+	assert.EqualError(t, err, "f(-1) = 2 and f(2) = 5 do not have opposite signs")
+
+	// 1/x changes sign at its pole
+	r, err := Bisect(exact(func(x float64) float64 { return 1 / x }), -1, 2, 1e-12, 200)
+	assert.ErrorIs(t, err, ErrNoSignChange)
+	assert.InDelta(t, 0, r.X, 1e-12)
+	_, err = Brent(exact(func(x float64) float64 { return 1 / x }), -1, 2, 1e-12, 200)
+	assert.ErrorIs(t, err, ErrNoSignChange)
+
+	// and so does sign(x) at its jump
+	for _, solve := range []func(Func, float64, float64, float64, int) (Root, error){Bisect, Brent} {
+		_, err = solve(exact(func(x float64) float64 { return math.Copysign(1, x) }), -1, 2, 1e-12, 200)
+		assert.ErrorIs(t, err, ErrNoSignChange)
+	}
+
+	_, err = Bisect(exact(math.Log), -1, 2, 0, 100)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = Bisect(exact(math.Sin), 1, 1, 0, 100)
+	assert.EqualError(t, err, "bracket must have distinct ends")
+
+	// Out of iterations
+	r, err = Bisect(exact(math.Cos), 0, 3, 0, 5)
+	require.NoError(t, err)
+	assert.False(t, r.Converged)
+	assert.Equal(t, 5, r.Iterations)
+}
+
+func TestOpenRoots(t *testing.T) {
+	tests := []struct {
+		name   string
+		f      func(float64) float64
+		x0     float64
+		want   float64
+		maxIts int
+	}{
+		{"omega", func(x float64) float64 { return x*math.Exp(x) - 1 }, 1, omega, 10},
+		{"square root", func(x float64) float64 { return x*x - 2 }, 1, math.Sqrt2, 10},
+		{"large scale", func(x float64) float64 { return x - 1e8 }, 1, 1e8, 5},
+		// Newton's first step leaves the domain of the logarithm
+		{"domain", func(x float64) float64 { return math.Log(x) - 1 }, 10, math.E, 20},
+		{"double root", func(x float64) float64 { return (x - 1) * (x - 1) }, 3, 1, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/newton", func(t *testing.T) {
+			r, err := Newton(exact(tt.f), tt.x0, 1e-12, 100)
+			require.NoError(t, err)
+			assert.True(t, r.Converged)
+			assert.InDelta(t, tt.want, r.X, 1e-6*math.Max(1, tt.want))
+			assert.LessOrEqual(t, r.Iterations, tt.maxIts)
+		})
+		t.Run(tt.name+"/secant", func(t *testing.T) {
+			r, err := Secant(exact(tt.f), tt.x0, tt.x0*1.01, 1e-12, 100)
+			require.NoError(t, err)
+			assert.True(t, r.Converged)
+			assert.InDelta(t, tt.want, r.X, 1e-6*math.Max(1, tt.want))
+		})
+	}
+}
+
+func TestOpenRootErrors(t *testing.T) {
+	_, err := Newton(exact(func(x float64) float64 { return x*x + 1 }), 0, 0, 100)
+	assert.ErrorIs(t, err, ErrZeroSlope)
+	_, err = Secant(exact(func(x float64) float64 { return 3 }), 0, 1, 0, 100)
+	assert.ErrorIs(t, err, ErrZeroSlope)
+	_, err = Newton(exact(math.Log), -1, 0, 100)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = Secant(exact(math.Sin), 1, 1, 0, 100)
+	assert.EqualError(t, err, "guesses must be distinct")
+
+	// x² + 1 has no real root, so Newton wanders
+	r, err := Newton(exact(func(x float64) float64 { return x*x + 1 }), 0.5, 0, 20)
+	require.NoError(t, err)
+	assert.False(t, r.Converged)
+	assert.Equal(t, 20, r.Iterations)
+}
+
+func TestNewtonSystem(t *testing.T) {
+	// A circle meeting a line, and meeting an exponential curve
+	circle := []MultiFunc{
+		func(v []float64) (float64, error) { return v[0]*v[0] + v[1]*v[1] - 4, nil },
+		func(v []float64) (float64, error) { return v[0] - v[1], nil },
+	}
+	r, err := NewtonSystem(circle, []float64{1, 0.5}, 1e-12, 50)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDeltaSlice(t, []float64{math.Sqrt2, math.Sqrt2}, r.X, 1e-12)
+	assert.InDeltaSlice(t, []float64{0, 0}, r.Residuals, 1e-12)
+
+	curve := []MultiFunc{
+		func(v []float64) (float64, error) { return v[0]*v[0] + v[1]*v[1] - 4, nil },
+		func(v []float64) (float64, error) { return math.Exp(v[0]) + v[1] - 1, nil },
+	}
+	r, err = NewtonSystem(curve, []float64{1, -1}, 1e-12, 50)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDelta(t, 1.0041687384746620, r.X[0], 1e-9)
+	assert.InDelta(t, 1-math.Exp(r.X[0]), r.X[1], 1e-12)
+
+	// Undamped Newton diverges for atan from 3; halving the steps that do
+	// not reduce the residual saves it
+	arctan := []MultiFunc{func(v []float64) (float64, error) { return math.Atan(v[0]), nil }}
+	r, err = NewtonSystem(arctan, []float64{3}, 1e-12, 50)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDelta(t, 0, r.X[0], 1e-12)
+}
+
+func TestNewtonSystemErrors(t *testing.T) {
+	f := func(v []float64) (float64, error) { return v[0] + v[1], nil }
+	_, err := NewtonSystem([]MultiFunc{f, f}, []float64{1, 2}, 0, 50)
+	assert.ErrorIs(t, err, ErrSingularJacobian)
+
+	_, err = NewtonSystem([]MultiFunc{f}, []float64{1, 2}, 0, 50)
+	assert.EqualError(t, err, "there are 1 equations but 2 variables")
+
+	// x² + 1 = 0 has no real root: the residual cannot be reduced below
+	// its minimum at 0
+	square := func(v []float64) (float64, error) { return v[0]*v[0] + 1, nil }
+	r, err := NewtonSystem([]MultiFunc{square}, []float64{1}, 0, 100)
+	assert.ErrorIs(t, err, ErrStalled)
+	assert.False(t, r.Converged)
+	assert.InDelta(t, 0, r.X[0], 1e-8)
+}
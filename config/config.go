@@ -7,7 +7,10 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -16,21 +19,32 @@ type Category string
 
 // Available categories.
 const (
-	CategoryArithmetic   Category = "arithmetic"
-	CategoryPower        Category = "power"
-	CategoryLogarithm    Category = "logarithm"
-	CategoryTrig         Category = "trig"
-	CategoryHyperbolic   Category = "hyperbolic"
-	CategoryRounding     Category = "rounding"
-	CategoryComparison   Category = "comparison"
-	CategorySpecial      Category = "special"
-	CategoryFloatUtils   Category = "float_utils"
-	CategoryConversion   Category = "conversion"
-	CategoryNumberTheory Category = "number_theory"
-	CategoryStatistics   Category = "statistics"
-	CategoryBitwise      Category = "bitwise"
-	CategoryComplex      Category = "complex"
-	CategoryConstants    Category = "constants"
+	CategoryArithmetic    Category = "arithmetic"
+	CategoryPower         Category = "power"
+	CategoryLogarithm     Category = "logarithm"
+	CategoryTrig          Category = "trig"
+	CategoryHyperbolic    Category = "hyperbolic"
+	CategoryRounding      Category = "rounding"
+	CategoryComparison    Category = "comparison"
+	CategorySpecial       Category = "special"
+	CategoryFloatUtils    Category = "float_utils"
+	CategoryConversion    Category = "conversion"
+	CategoryNumberTheory  Category = "number_theory"
+	CategoryStatistics    Category = "statistics"
+	CategoryBitwise       Category = "bitwise"
+	CategoryComplex       Category = "complex"
+	CategoryInterval      Category = "interval"
+	CategoryLinearAlgebra Category = "linear_algebra"
+	CategoryVector        Category = "vector"
+	CategoryPolynomial    Category = "polynomial"
+	CategoryCalculus      Category = "calculus"
+	CategorySymbolic      Category = "symbolic"
+	CategoryOptimization  Category = "optimization"
+	CategoryInterpolation Category = "interpolation"
+	CategoryConstants     Category = "constants"
+	CategoryVariables     Category = "variables"
+	CategoryFunctions     Category = "functions"
+	CategoryDiscovery     Category = "discovery"
 )
 
 // AllCategories returns a slice of all available categories.
@@ -50,7 +64,62 @@ func AllCategories() []Category {
 		CategoryStatistics,
 		CategoryBitwise,
 		CategoryComplex,
+		CategoryInterval,
+		CategoryLinearAlgebra,
+		CategoryVector,
+		CategoryPolynomial,
+		CategoryCalculus,
+		CategorySymbolic,
+		CategoryOptimization,
+		CategoryInterpolation,
 		CategoryConstants,
+		CategoryVariables,
+		CategoryFunctions,
+		CategoryDiscovery,
+	}
+}
+
+// IEEEPolicy controls how NaN, infinite and subnormal results are reported.
+type IEEEPolicy string
+
+// Available IEEE special-value policies.
+const (
+	// IEEEPermissive returns IEEE 754 results (NaN, ±Inf, subnormals) as-is.
+	IEEEPermissive IEEEPolicy = "permissive"
+	// IEEEStrict turns NaN, infinite, overflowed, underflowed and subnormal
+	// results into errors.
+	IEEEStrict IEEEPolicy = "strict"
+)
+
+// ParseIEEEPolicy parses a policy name, reporting whether it is valid.
+func ParseIEEEPolicy(s string) (IEEEPolicy, bool) {
+	switch p := IEEEPolicy(strings.TrimSpace(strings.ToLower(s))); p {
+	case IEEEPermissive, IEEEStrict:
+		return p, true
+	default:
+		return "", false
+	}
+}
+
+// ToolMode controls which tools the server advertises.
+type ToolMode string
+
+// Available tool modes.
+const (
+	// ToolModeEager advertises every enabled tool.
+	ToolModeEager ToolMode = "eager"
+	// ToolModeLazy advertises only search_tools and invoke_tool; the other
+	// enabled tools are found and called through them.
+	ToolModeLazy ToolMode = "lazy"
+)
+
+// ParseToolMode parses a tool mode name, reporting whether it is valid.
+func ParseToolMode(s string) (ToolMode, bool) {
+	switch m := ToolMode(strings.TrimSpace(strings.ToLower(s))); m {
+	case ToolModeEager, ToolModeLazy:
+		return m, true
+	default:
+		return "", false
 	}
 }
 
@@ -58,27 +127,114 @@ func AllCategories() []Category {
 type Config struct {
 	// Categories maps category names to whether they are enabled.
 	Categories map[Category]bool
+	// Tools is an optional allow list of tool names. When empty, every tool
+	// in an enabled category is served.
+	Tools map[string]bool
 	// Transport specifies the transport type ("stdio" or "http").
 	Transport string
+	// IEEEPolicy is the default special-value policy for tool results.
+	IEEEPolicy IEEEPolicy
+	// ToolMode selects whether all tools or only the discovery tools are
+	// advertised.
+	ToolMode ToolMode
+	// APIKeys lists the keys accepted by the HTTP endpoint. When empty the
+	// endpoint does not require authentication.
+	APIKeys []string
+	// MaxRequestBytes limits the size of HTTP request bodies (0 = no limit).
+	MaxRequestBytes int64
+	// Profiles are additional HTTP endpoints, each with its own configuration.
+	Profiles []Profile
+	// FunctionsFile is an optional JSON file where user-defined functions
+	// are persisted and shared by all sessions served under this
+	// configuration.
+	FunctionsFile string
+	// FunctionsReadOnly stops clients from adding functions to or deleting
+	// them from FunctionsFile.
+	FunctionsReadOnly bool
+}
+
+// Profile is a named configuration served at /mcp/<name> over HTTP.
+type Profile struct {
+	Name   string
+	Config *Config
 }
 
 // LoadConfig loads configuration from environment variables.
 // MATH_CATEGORIES: comma-separated list of categories or "all" (default).
+// MATH_TOOLS: optional comma-separated allow list of tool names.
 // TRANSPORT: "stdio" (default) or "http".
+// MATH_IEEE_POLICY: "permissive" (default) or "strict".
+// MATH_TOOL_MODE: "eager" (default) or "lazy".
+// MATH_API_KEYS: optional comma-separated keys required by the HTTP endpoint.
+// MATH_MAX_REQUEST_BYTES: optional HTTP request body size limit.
+// MATH_FUNCTIONS_FILE: optional file for persisted user-defined functions.
+// MATH_FUNCTIONS_READ_ONLY: "true" to stop clients changing that file.
+// MATH_PROFILES: optional comma-separated profile names. Each profile reads
+// the keys above with a MATH_PROFILE_<NAME>_ prefix in place of MATH_
+// (e.g. MATH_PROFILE_BASIC_CATEGORIES), falling back to the unprefixed key.
 func LoadConfig() *Config {
+	return loadConfig(os.Getenv)
+}
+
+// LoadConfigFile loads configuration from a file of KEY=VALUE lines using the
+// same keys as the environment variables. Keys missing from the file fall
+// back to the environment. Blank lines and lines starting with # are ignored.
+func LoadConfigFile(path string) (*Config, error) {
+	values, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return loadConfig(func(key string) string {
+		if v, ok := values[key]; ok {
+			return v
+		}
+		return os.Getenv(key)
+	}), nil
+}
+
+// loadConfig builds a configuration from the given variable lookup.
+func loadConfig(getenv func(string) string) *Config {
 	cfg := &Config{
 		Categories: make(map[Category]bool),
 		Transport:  "stdio",
+		IEEEPolicy: IEEEPermissive,
+		ToolMode:   ToolModeEager,
 	}
 
 	// Parse transport
-	transport := os.Getenv("TRANSPORT")
+	transport := getenv("TRANSPORT")
 	if transport == "http" {
 		cfg.Transport = "http"
 	}
 
+	// Parse IEEE policy
+	if policy, ok := ParseIEEEPolicy(getenv("MATH_IEEE_POLICY")); ok {
+		cfg.IEEEPolicy = policy
+	}
+
+	// Parse tool mode
+	if mode, ok := ParseToolMode(getenv("MATH_TOOL_MODE")); ok {
+		cfg.ToolMode = mode
+	}
+
+	// Parse tool allow list
+	cfg.Tools = parseTools(getenv("MATH_TOOLS"))
+
+	// Parse HTTP endpoint settings
+	cfg.APIKeys = parseList(getenv("MATH_API_KEYS"))
+	if n, err := strconv.ParseInt(strings.TrimSpace(getenv("MATH_MAX_REQUEST_BYTES")), 10, 64); err == nil && n > 0 {
+		cfg.MaxRequestBytes = n
+	}
+
+	// Parse function library location
+	cfg.FunctionsFile = strings.TrimSpace(getenv("MATH_FUNCTIONS_FILE"))
+	cfg.FunctionsReadOnly, _ = strconv.ParseBool(strings.TrimSpace(getenv("MATH_FUNCTIONS_READ_ONLY")))
+
+	// Parse profiles
+	cfg.Profiles = parseProfiles(getenv)
+
 	// Parse categories
-	categories := os.Getenv("MATH_CATEGORIES")
+	categories := getenv("MATH_CATEGORIES")
 	if categories == "" || strings.ToLower(categories) == "all" {
 		// Enable all categories
 		for _, cat := range AllCategories() {
@@ -92,6 +248,88 @@ func LoadConfig() *Config {
 	return cfg
 }
 
+// readConfigFile parses a file of KEY=VALUE lines.
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, i+1)
+		}
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return values, nil
+}
+
+// parseTools parses a comma-separated list of tool names.
+func parseTools(input string) map[string]bool {
+	result := make(map[string]bool)
+	for _, name := range parseList(input) {
+		result[strings.ToLower(name)] = true
+	}
+	return result
+}
+
+// parseList parses a comma-separated list, dropping empty entries.
+func parseList(input string) []string {
+	var result []string
+	for _, part := range strings.Split(input, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// parseProfiles parses the profiles named in MATH_PROFILES. Invalid and
+// duplicate names are skipped.
+func parseProfiles(getenv func(string) string) []Profile {
+	var profiles []Profile
+	seen := make(map[string]bool)
+	for _, name := range parseList(getenv("MATH_PROFILES")) {
+		name = strings.ToLower(name)
+		if !validProfileName(name) || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		prefix := "MATH_PROFILE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		profileEnv := func(key string) string {
+			if key == "MATH_PROFILES" {
+				return ""
+			}
+			if v := getenv(prefix + strings.TrimPrefix(key, "MATH_")); v != "" {
+				return v
+			}
+			return getenv(key)
+		}
+		profiles = append(profiles, Profile{Name: name, Config: loadConfig(profileEnv)})
+	}
+	return profiles
+}
+
+// validProfileName reports whether name is usable as a URL path segment.
+func validProfileName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
 // parseCategories parses a comma-separated list of category names.
 func parseCategories(input string) map[Category]bool {
 	result := make(map[Category]bool)
@@ -117,6 +355,25 @@ func (c *Config) IsEnabled(cat Category) bool {
 	return c.Categories[cat]
 }
 
+// IsToolAllowed checks if a tool passes the allow list.
+func (c *Config) IsToolAllowed(name string) bool {
+	return len(c.Tools) == 0 || c.Tools[name]
+}
+
+// Validate checks that the configuration and each of its profiles enable
+// at least one category, so that no endpoint is served empty.
+func (c *Config) Validate() error {
+	if len(c.EnabledCategories()) == 0 {
+		return errors.New("no categories enabled")
+	}
+	for _, profile := range c.Profiles {
+		if len(profile.Config.EnabledCategories()) == 0 {
+			return fmt.Errorf("profile %s enables no categories", profile.Name)
+		}
+	}
+	return nil
+}
+
 // EnabledCategories returns a slice of enabled categories.
 func (c *Config) EnabledCategories() []Category {
 	var enabled []Category
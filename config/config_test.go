@@ -7,6 +7,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -108,6 +109,57 @@ func TestLoadConfig_InvalidTransportDefaultsToStdio(t *testing.T) {
 	assert.Equal(t, "stdio", cfg.Transport)
 }
 
+func TestLoadConfig_IEEEPolicyDefaultsToPermissive(t *testing.T) {
+	os.Unsetenv("MATH_IEEE_POLICY")
+
+	cfg := LoadConfig()
+
+	assert.Equal(t, IEEEPermissive, cfg.IEEEPolicy)
+}
+
+func TestLoadConfig_IEEEPolicyStrict(t *testing.T) {
+	os.Setenv("MATH_IEEE_POLICY", "Strict")
+	defer os.Unsetenv("MATH_IEEE_POLICY")
+
+	cfg := LoadConfig()
+
+	assert.Equal(t, IEEEStrict, cfg.IEEEPolicy)
+}
+
+func TestLoadConfig_ToolMode(t *testing.T) {
+	tests := []struct {
+		value string
+		want  ToolMode
+	}{
+		{"", ToolModeEager},
+		{"Lazy", ToolModeLazy},
+		{"eager", ToolModeEager},
+		{"sometimes", ToolModeEager},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			cfg := loadConfig(func(key string) string {
+				if key == "MATH_TOOL_MODE" {
+					return tt.value
+				}
+				return ""
+			})
+
+			assert.Equal(t, tt.want, cfg.ToolMode)
+		})
+	}
+}
+
+func TestLoadConfig_InvalidIEEEPolicyDefaultsToPermissive(t *testing.T) {
+	os.Setenv("MATH_IEEE_POLICY", "lenient")
+	defer os.Unsetenv("MATH_IEEE_POLICY")
+
+	cfg := LoadConfig()
+
+	assert.Equal(t, IEEEPermissive, cfg.IEEEPolicy)
+}
+
 func TestEnabledCategories(t *testing.T) {
 	os.Setenv("MATH_CATEGORIES", "arithmetic,power")
 	defer os.Unsetenv("MATH_CATEGORIES")
@@ -124,7 +176,7 @@ func TestEnabledCategories(t *testing.T) {
 func TestAllCategories(t *testing.T) {
 	categories := AllCategories()
 
-	assert.Len(t, categories, 15)
+	assert.Len(t, categories, 26)
 	assert.Contains(t, categories, CategoryArithmetic)
 	assert.Contains(t, categories, CategoryPower)
 	assert.Contains(t, categories, CategoryLogarithm)
@@ -139,5 +191,109 @@ func TestAllCategories(t *testing.T) {
 	assert.Contains(t, categories, CategoryStatistics)
 	assert.Contains(t, categories, CategoryBitwise)
 	assert.Contains(t, categories, CategoryComplex)
+	assert.Contains(t, categories, CategoryInterval)
+	assert.Contains(t, categories, CategoryLinearAlgebra)
+	assert.Contains(t, categories, CategoryVector)
+	assert.Contains(t, categories, CategoryPolynomial)
+	assert.Contains(t, categories, CategoryCalculus)
+	assert.Contains(t, categories, CategorySymbolic)
+	assert.Contains(t, categories, CategoryOptimization)
+	assert.Contains(t, categories, CategoryInterpolation)
 	assert.Contains(t, categories, CategoryConstants)
+	assert.Contains(t, categories, CategoryVariables)
+	assert.Contains(t, categories, CategoryFunctions)
+	assert.Contains(t, categories, CategoryDiscovery)
+}
+
+func TestLoadConfig_ToolAllowList(t *testing.T) {
+	os.Setenv("MATH_TOOLS", " add , Sqrt ")
+	defer os.Unsetenv("MATH_TOOLS")
+
+	cfg := LoadConfig()
+
+	assert.True(t, cfg.IsToolAllowed("add"))
+	assert.True(t, cfg.IsToolAllowed("sqrt"))
+	assert.False(t, cfg.IsToolAllowed("subtract"))
+}
+
+func TestLoadConfig_EmptyToolAllowListAllowsAll(t *testing.T) {
+	os.Unsetenv("MATH_TOOLS")
+
+	cfg := LoadConfig()
+
+	assert.True(t, cfg.IsToolAllowed("add"))
+	assert.True(t, cfg.IsToolAllowed("complex_pow"))
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	os.Setenv("MATH_IEEE_POLICY", "strict")
+	defer os.Unsetenv("MATH_IEEE_POLICY")
+
+	path := filepath.Join(t.TempDir(), "math.env")
+	content := "# comment\n\nMATH_CATEGORIES=arithmetic, complex\nMATH_TOOLS=\"add,complex_abs\"\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	cfg, err := LoadConfigFile(path)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.IsEnabled(CategoryArithmetic))
+	assert.True(t, cfg.IsEnabled(CategoryComplex))
+	assert.False(t, cfg.IsEnabled(CategoryTrig))
+	assert.True(t, cfg.IsToolAllowed("complex_abs"))
+	assert.False(t, cfg.IsToolAllowed("subtract"))
+	// Keys missing from the file fall back to the environment
+	assert.Equal(t, IEEEStrict, cfg.IEEEPolicy)
+}
+
+func TestLoadConfigFile_Malformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "math.env")
+	require.NoError(t, os.WriteFile(path, []byte("MATH_CATEGORIES\n"), 0o600))
+
+	_, err := LoadConfigFile(path)
+	assert.Error(t, err)
+}
+
+func TestLoadConfigFile_Missing(t *testing.T) {
+	_, err := LoadConfigFile(filepath.Join(t.TempDir(), "missing.env"))
+	assert.Error(t, err)
+}
+
+func TestLoadConfig_Profiles(t *testing.T) {
+	os.Setenv("MATH_CATEGORIES", "all")
+	os.Setenv("MATH_PROFILES", "basic, Science, bad/name, basic")
+	os.Setenv("MATH_PROFILE_BASIC_CATEGORIES", "arithmetic,rounding")
+	os.Setenv("MATH_PROFILE_BASIC_API_KEYS", "k1, k2")
+	os.Setenv("MATH_PROFILE_BASIC_MAX_REQUEST_BYTES", "4096")
+	os.Setenv("MATH_FUNCTIONS_FILE", "shared.json")
+	os.Setenv("MATH_PROFILE_BASIC_FUNCTIONS_FILE", "basic.json")
+	os.Setenv("MATH_PROFILE_BASIC_FUNCTIONS_READ_ONLY", "true")
+	defer func() {
+		for _, key := range []string{"MATH_CATEGORIES", "MATH_PROFILES", "MATH_PROFILE_BASIC_CATEGORIES",
+			"MATH_PROFILE_BASIC_API_KEYS", "MATH_PROFILE_BASIC_MAX_REQUEST_BYTES", "MATH_FUNCTIONS_FILE",
+			"MATH_PROFILE_BASIC_FUNCTIONS_FILE", "MATH_PROFILE_BASIC_FUNCTIONS_READ_ONLY"} {
+			os.Unsetenv(key)
+		}
+	}()
+
+	cfg := LoadConfig()
+
+	require.Len(t, cfg.Profiles, 2)
+	basic, science := cfg.Profiles[0], cfg.Profiles[1]
+
+	assert.Equal(t, "basic", basic.Name)
+	assert.True(t, basic.Config.IsEnabled(CategoryArithmetic))
+	assert.True(t, basic.Config.IsEnabled(CategoryRounding))
+	assert.False(t, basic.Config.IsEnabled(CategoryTrig))
+	assert.Equal(t, []string{"k1", "k2"}, basic.Config.APIKeys)
+	assert.Equal(t, int64(4096), basic.Config.MaxRequestBytes)
+	assert.Equal(t, "basic.json", basic.Config.FunctionsFile)
+	assert.True(t, basic.Config.FunctionsReadOnly)
+	assert.Empty(t, basic.Config.Profiles)
+
+	// Unset profile keys fall back to the top-level configuration
+	assert.Equal(t, "science", science.Name)
+	assert.Len(t, science.Config.EnabledCategories(), len(AllCategories()))
+	assert.Empty(t, science.Config.APIKeys)
+	assert.Equal(t, "shared.json", science.Config.FunctionsFile)
+	assert.False(t, science.Config.FunctionsReadOnly)
 }
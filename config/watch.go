@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// DefaultReloadInterval is how often a watched configuration file is polled.
+const DefaultReloadInterval = 2 * time.Second
+
+// Watcher reloads configuration on SIGHUP or when the configuration file
+// changes, passing each successfully loaded configuration to OnReload.
+type Watcher struct {
+	// Path is the configuration file. When empty nothing can change, since
+	// the environment of a running process is fixed, so reloads are ignored.
+	Path string
+	// Interval is the file polling interval (DefaultReloadInterval if zero).
+	Interval time.Duration
+	// OnReload is called with the new configuration.
+	OnReload func(*Config)
+}
+
+// Run watches for reload triggers until ctx is canceled.
+func (w *Watcher) Run(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastMod := w.modTime()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			if w.Path == "" {
+				log.Printf("Ignoring SIGHUP: reloading needs a config file, set with MATH_CONFIG_FILE")
+				continue
+			}
+			lastMod = w.modTime()
+			w.reload("SIGHUP")
+		case <-ticker.C:
+			if w.Path == "" {
+				continue
+			}
+			if mod := w.modTime(); !mod.Equal(lastMod) {
+				lastMod = mod
+				w.reload("file change")
+			}
+		}
+	}
+}
+
+// reload loads the configuration file and hands it to OnReload. Load errors,
+// and a configuration that enables no categories at the top level or in a
+// profile, keep the current configuration in place.
+func (w *Watcher) reload(reason string) {
+	cfg, err := LoadConfigFile(w.Path)
+	if err != nil {
+		log.Printf("Config reload (%s) failed: %v", reason, err)
+		return
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Printf("Config reload (%s) rejected: %v", reason, err)
+		return
+	}
+	log.Printf("Reloading configuration (%s)", reason)
+	if w.OnReload != nil {
+		w.OnReload(cfg)
+	}
+}
+
+// modTime returns the file modification time, or the zero time if unknown.
+func (w *Watcher) modTime() time.Time {
+	if w.Path == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(w.Path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
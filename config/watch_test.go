@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcherReloadsOnFileChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "math.env")
+	require.NoError(t, os.WriteFile(path, []byte("MATH_CATEGORIES=arithmetic\n"), 0o600))
+
+	reloaded := make(chan *Config, 1)
+	w := &Watcher{
+		Path:     path,
+		Interval: 10 * time.Millisecond,
+		OnReload: func(cfg *Config) { reloaded <- cfg },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+
+	// Ensure the modification time moves even on coarse-grained filesystems
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(path, []byte("MATH_CATEGORIES=arithmetic,complex\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+
+	select {
+	case cfg := <-reloaded:
+		assert.True(t, cfg.IsEnabled(CategoryComplex))
+	case <-time.After(2 * time.Second):
+		t.Fatal("configuration was not reloaded")
+	}
+}
+
+func TestWatcherKeepsConfigOnLoadError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "math.env")
+	require.NoError(t, os.WriteFile(path, []byte("not a valid line\n"), 0o600))
+
+	called := false
+	w := &Watcher{Path: path, OnReload: func(*Config) { called = true }}
+	w.reload("test")
+
+	assert.False(t, called)
+}
+
+func TestWatcherRejectsConfigWithoutCategories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "math.env")
+	require.NoError(t, os.WriteFile(path, []byte("MATH_CATEGORIES=nonexistent\n"), 0o600))
+
+	called := false
+	w := &Watcher{Path: path, OnReload: func(*Config) { called = true }}
+	w.reload("test")
+
+	assert.False(t, called)
+}
+
+func TestWatcherRejectsProfileWithoutCategories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "math.env")
+	content := "MATH_CATEGORIES=arithmetic\nMATH_PROFILES=basic\nMATH_PROFILE_BASIC_CATEGORIES=nonexistent\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	called := false
+	w := &Watcher{Path: path, OnReload: func(*Config) { called = true }}
+	w.reload("test")
+
+	assert.False(t, called)
+}
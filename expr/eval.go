@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package expr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Func is a function callable from expressions.
+type Func struct {
+	// Arity is the number of arguments, or -1 for one or more.
+	Arity int
+	// Fn computes the result. Domain errors yield NaN as in package math.
+	Fn func(args []float64) (float64, error)
+}
+
+func unary(f func(float64) float64) Func {
+	return Func{Arity: 1, Fn: func(a []float64) (float64, error) { return f(a[0]), nil }}
+}
+
+func binary(f func(float64, float64) float64) Func {
+	return Func{Arity: 2, Fn: func(a []float64) (float64, error) { return f(a[0], a[1]), nil }}
+}
+
+func variadic(f func(float64, float64) float64) Func {
+	return Func{Arity: -1, Fn: func(a []float64) (float64, error) {
+		result := a[0]
+		for _, v := range a[1:] {
+			result = f(result, v)
+		}
+		return result, nil
+	}}
+}
+
+var builtins = map[string]Func{
+	// Trigonometric and hyperbolic
+	"sin":   unary(math.Sin),
+	"cos":   unary(math.Cos),
+	"tan":   unary(math.Tan),
+	"asin":  unary(math.Asin),
+	"acos":  unary(math.Acos),
+	"atan":  unary(math.Atan),
+	"atan2": binary(math.Atan2),
+	"sinh":  unary(math.Sinh),
+	"cosh":  unary(math.Cosh),
+	"tanh":  unary(math.Tanh),
+	"asinh": unary(math.Asinh),
+	"acosh": unary(math.Acosh),
+	"atanh": unary(math.Atanh),
+	// Power and logarithm
+	"pow":   binary(math.Pow),
+	"sqrt":  unary(math.Sqrt),
+	"cbrt":  unary(math.Cbrt),
+	"exp":   unary(math.Exp),
+	"exp2":  unary(math.Exp2),
+	"expm1": unary(math.Expm1),
+	"hypot": binary(math.Hypot),
+	"log":   unary(math.Log),
+	"ln":    unary(math.Log),
+	"log10": unary(math.Log10),
+	"log2":  unary(math.Log2),
+	"log1p": unary(math.Log1p),
+	// Rounding, comparison and arithmetic
+	"abs":       unary(math.Abs),
+	"ceil":      unary(math.Ceil),
+	"floor":     unary(math.Floor),
+	"round":     unary(math.Round),
+	"trunc":     unary(math.Trunc),
+	"mod":       binary(math.Mod),
+	"remainder": binary(math.Remainder),
+	"min":       variadic(math.Min),
+	"max":       variadic(math.Max),
+	"dim":       binary(math.Dim),
+	"copysign":  binary(math.Copysign),
+	"sign": unary(func(x float64) float64 {
+		switch {
+		case x > 0:
+			return 1
+		case x < 0:
+			return -1
+		}
+		return x
+	}),
+	// Special functions
+	"gamma":   unary(math.Gamma),
+	"lgamma":  unary(func(x float64) float64 { v, _ := math.Lgamma(x); return v }),
+	"erf":     unary(math.Erf),
+	"erfc":    unary(math.Erfc),
+	"erfinv":  unary(math.Erfinv),
+	"erfcinv": unary(math.Erfcinv),
+	"j0":      unary(math.J0),
+	"j1":      unary(math.J1),
+	"y0":      unary(math.Y0),
+	"y1":      unary(math.Y1),
+}
+
+// constants are the names resolved when no variable of that name is bound.
+var constants = map[string]float64{
+	"pi":  math.Pi,
+	"e":   math.E,
+	"phi": math.Phi,
+	"tau": 2 * math.Pi,
+}
+
+// IsBuiltin reports whether name is a built-in function.
+func IsBuiltin(name string) bool {
+	_, ok := builtins[name]
+	return ok
+}
+
+// IsConstant reports whether name is a built-in constant.
+func IsConstant(name string) bool {
+	_, ok := constants[name]
+	return ok
+}
+
+// Builtins returns the names of the built-in functions, sorted.
+func Builtins() []string {
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Env binds variables and extra functions for evaluation.
+type Env struct {
+	// Vars are variable values. They shadow the built-in constants.
+	Vars map[string]float64
+	// Funcs are extra functions. Built-in functions take precedence.
+	Funcs map[string]Func
+}
+
+// Eval evaluates n in env, which may be nil.
+func Eval(n Node, env *Env) (float64, error) {
+	if env == nil {
+		env = &Env{}
+	}
+	switch n := n.(type) {
+	case *Num:
+		return n.Value, nil
+	case *Var:
+		if v, ok := env.Vars[n.Name]; ok {
+			return v, nil
+		}
+		if v, ok := constants[n.Name]; ok {
+			return v, nil
+		}
+		return 0, fmt.Errorf("unknown variable %q", n.Name)
+	case *Unary:
+		x, err := Eval(n.X, env)
+		if err != nil {
+			return 0, err
+		}
+		return -x, nil
+	case *Binary:
+		l, err := Eval(n.L, env)
+		if err != nil {
+			return 0, err
+		}
+		r, err := Eval(n.R, env)
+		if err != nil {
+			return 0, err
+		}
+		switch n.Op {
+		case '+':
+			return l + r, nil
+		case '-':
+			return l - r, nil
+		case '*':
+			return l * r, nil
+		case '/':
+			return l / r, nil
+		case '^':
+			return math.Pow(l, r), nil
+		}
+		return 0, fmt.Errorf("unknown operator %q", n.Op)
+	case *Call:
+		f, ok := builtins[n.Func]
+		if !ok {
+			f, ok = env.Funcs[n.Func]
+		}
+		if !ok {
+			return 0, fmt.Errorf("unknown function %q", n.Func)
+		}
+		if f.Arity >= 0 && len(n.Args) != f.Arity {
+			return 0, fmt.Errorf("%s expects %d argument(s), got %d", n.Func, f.Arity, len(n.Args))
+		}
+		if f.Arity < 0 && len(n.Args) == 0 {
+			return 0, fmt.Errorf("%s expects at least one argument", n.Func)
+		}
+		args := make([]float64, len(n.Args))
+		for i, a := range n.Args {
+			v, err := Eval(a, env)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = v
+		}
+		return f.Fn(args)
+	}
+	return 0, fmt.Errorf("unsupported expression node %T", n)
+}
+
+// Function is an expression compiled as a function of named parameters.
+type Function struct {
+	Params []string
+	Body   Node
+	env    Env
+}
+
+// Compile parses body as a function of params. Every free variable of body
+// must be a parameter, a built-in constant or bound in env, which may be nil;
+// function calls are checked when evaluated.
+func Compile(body string, params []string, env *Env) (*Function, error) {
+	node, err := Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	f := &Function{Params: params, Body: node}
+	if env != nil {
+		f.env = *env
+	}
+
+	bound := make(map[string]bool, len(params))
+	for _, p := range params {
+		if bound[p] {
+			return nil, fmt.Errorf("duplicate parameter %q", p)
+		}
+		bound[p] = true
+	}
+	for _, name := range FreeVars(node) {
+		if _, ok := f.env.Vars[name]; !bound[name] && !ok && !IsConstant(name) {
+			return nil, fmt.Errorf("unknown variable %q", name)
+		}
+	}
+	return f, nil
+}
+
+// Eval evaluates the function with args bound to its parameters.
+func (f *Function) Eval(args ...float64) (float64, error) {
+	if len(args) != len(f.Params) {
+		return 0, fmt.Errorf("expected %d argument(s), got %d", len(f.Params), len(args))
+	}
+	vars := make(map[string]float64, len(f.env.Vars)+len(args))
+	for k, v := range f.env.Vars {
+		vars[k] = v
+	}
+	for i, p := range f.Params {
+		vars[p] = args[i]
+	}
+	return Eval(f.Body, &Env{Vars: vars, Funcs: f.env.Funcs})
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package expr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		input string
+		vars  map[string]float64
+		want  float64
+	}{
+		{"1 + 2 * 3", nil, 7},
+		{"2^3^2", nil, 512},
+		{"-2^2", nil, -4},
+		{"x^2 + sin(y)", map[string]float64{"x": 3, "y": 0}, 9},
+		{"2pi", nil, 2 * math.Pi},
+		{"e", nil, math.E},
+		{"e", map[string]float64{"e": 1}, 1},
+		{"max(1, 5, 3)", nil, 5},
+		{"gamma(5)", nil, 24},
+		{"erf(0)", nil, 0},
+		{"log(e)", nil, 1},
+		{"hypot(3, 4)", nil, 5},
+		{"sign(-3)", nil, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			require.NoError(t, err)
+			got, err := Eval(n, &Env{Vars: tt.vars})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-12)
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"x + 1", "unknown variable"},
+		{"foo(1)", "unknown function"},
+		{"sin(1, 2)", "expects 1 argument"},
+		{"max()", "at least one"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			require.NoError(t, err)
+			_, err = Eval(n, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestEvalDomainErrorIsNaN(t *testing.T) {
+	n, err := Parse("sqrt(-1)")
+	require.NoError(t, err)
+
+	got, err := Eval(n, nil)
+
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(got))
+}
+
+func TestEvalExtraFuncs(t *testing.T) {
+	n, err := Parse("double(3) + 1")
+	require.NoError(t, err)
+
+	env := &Env{Funcs: map[string]Func{
+		"double": {Arity: 1, Fn: func(a []float64) (float64, error) { return 2 * a[0], nil }},
+	}}
+	got, err := Eval(n, env)
+
+	require.NoError(t, err)
+	assert.Equal(t, 7.0, got)
+}
+
+func TestCompile(t *testing.T) {
+	f, err := Compile("a * x^2 + b", []string{"x"}, &Env{Vars: map[string]float64{"a": 2, "b": 1}})
+	require.NoError(t, err)
+
+	got, err := f.Eval(3)
+	require.NoError(t, err)
+	assert.Equal(t, 19.0, got)
+
+	_, err = f.Eval()
+	assert.Error(t, err)
+}
+
+func TestCompileErrors(t *testing.T) {
+	_, err := Compile("x + y", []string{"x"}, nil)
+	assert.ErrorContains(t, err, "unknown variable \"y\"")
+
+	_, err = Compile("x", []string{"x", "x"}, nil)
+	assert.ErrorContains(t, err, "duplicate parameter")
+
+	_, err = Compile("x +", []string{"x"}, nil)
+	assert.Error(t, err)
+}
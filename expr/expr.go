@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package expr parses and evaluates arithmetic expressions such as
+// "x^2 + sin(y)" using the same function set as the server's tools.
+package expr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Node is a node of an expression tree.
+type Node interface {
+	// String formats the node as an expression that parses back to it.
+	String() string
+}
+
+// Num is a numeric literal.
+type Num struct {
+	Value float64
+}
+
+// Var is a reference to a variable or constant.
+type Var struct {
+	Name string
+}
+
+// Unary is a negation.
+type Unary struct {
+	Op byte // '-'
+	X  Node
+}
+
+// Binary is an arithmetic operation.
+type Binary struct {
+	Op   byte // '+', '-', '*', '/' or '^'
+	L, R Node
+}
+
+// Call is a function application.
+type Call struct {
+	Func string
+	Args []Node
+}
+
+// Operator precedences used when formatting.
+const (
+	precSum = iota + 1
+	precProduct
+	precUnary
+	precPower
+	precAtom
+)
+
+func precedence(n Node) int {
+	switch n := n.(type) {
+	case *Binary:
+		switch n.Op {
+		case '+', '-':
+			return precSum
+		case '*', '/':
+			return precProduct
+		default:
+			return precPower
+		}
+	case *Unary:
+		return precUnary
+	case *Num:
+		if n.Value < 0 {
+			return precUnary
+		}
+	}
+	return precAtom
+}
+
+// wrap formats n, parenthesized when its precedence is below min.
+func wrap(n Node, min int) string {
+	if precedence(n) < min {
+		return "(" + n.String() + ")"
+	}
+	return n.String()
+}
+
+func (n *Num) String() string {
+	return strconv.FormatFloat(n.Value, 'g', -1, 64)
+}
+
+func (n *Var) String() string {
+	return n.Name
+}
+
+func (n *Unary) String() string {
+	return string(n.Op) + wrap(n.X, precUnary+1)
+}
+
+func (n *Binary) String() string {
+	p := precedence(n)
+	switch n.Op {
+	case '^':
+		// Right associative: the base needs parentheses at equal precedence
+		return wrap(n.L, p+1) + "^" + wrap(n.R, precUnary)
+	case '-', '/':
+		return wrap(n.L, p) + " " + string(n.Op) + " " + wrap(n.R, p+1)
+	default:
+		return wrap(n.L, p) + " " + string(n.Op) + " " + wrap(n.R, p)
+	}
+}
+
+func (n *Call) String() string {
+	args := make([]string, len(n.Args))
+	for i, a := range n.Args {
+		args[i] = a.String()
+	}
+	return n.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+// FreeVars returns the distinct variable names referenced by n, in order of
+// first appearance.
+func FreeVars(n Node) []string {
+	var names []string
+	seen := make(map[string]bool)
+	Walk(n, func(n Node) {
+		if v, ok := n.(*Var); ok && !seen[v.Name] {
+			seen[v.Name] = true
+			names = append(names, v.Name)
+		}
+	})
+	return names
+}
+
+// Calls returns the distinct function names called by n.
+func Calls(n Node) []string {
+	var names []string
+	seen := make(map[string]bool)
+	Walk(n, func(n Node) {
+		if c, ok := n.(*Call); ok && !seen[c.Func] {
+			seen[c.Func] = true
+			names = append(names, c.Func)
+		}
+	})
+	return names
+}
+
+// Walk calls fn for n and each of its descendants in depth-first order.
+func Walk(n Node, fn func(Node)) {
+	fn(n)
+	switch n := n.(type) {
+	case *Unary:
+		Walk(n.X, fn)
+	case *Binary:
+		Walk(n.L, fn)
+		Walk(n.R, fn)
+	case *Call:
+		for _, a := range n.Args {
+			Walk(a, fn)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// maxDepth bounds expression nesting to keep parsing and evaluation from
+// exhausting the stack.
+const maxDepth = 200
+
+// ParseError reports a syntax error at a byte offset in the input.
+type ParseError struct {
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error at position %d: %s", e.Pos+1, e.Msg)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNum
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	pos  int
+}
+
+// Parse parses an expression. It supports numbers, variables, the binary
+// operators + - * / and ^ (or **), unary minus, parentheses, function calls
+// and implicit multiplication such as "2x" or "3(x + 1)". A leading "$" on
+// a name is ignored, so "$rate" and "rate" are the same variable.
+func Parse(input string) (Node, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &ParseError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return n, nil
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := rune(input[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(input) && (unicode.IsDigit(rune(input[i])) || input[i] == '.') {
+				i++
+			}
+			// Exponent, only when followed by digits so "2e" stays 2*e
+			if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+				j := i + 1
+				if j < len(input) && (input[j] == '+' || input[j] == '-') {
+					j++
+				}
+				if j < len(input) && unicode.IsDigit(rune(input[j])) {
+					for j < len(input) && unicode.IsDigit(rune(input[j])) {
+						j++
+					}
+					i = j
+				}
+			}
+			text := input[start:i]
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &ParseError{Pos: start, Msg: fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, token{kind: tokNum, text: text, num: v, pos: start})
+		case c == '$' || c == '_' || unicode.IsLetter(c):
+			start := i
+			if c == '$' {
+				i++
+			}
+			nameStart := i
+			for i < len(input) && (input[i] == '_' || unicode.IsLetter(rune(input[i])) || unicode.IsDigit(rune(input[i]))) {
+				i++
+			}
+			if i == nameStart {
+				return nil, &ParseError{Pos: start, Msg: "expected name after $"}
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: input[nameStart:i], pos: start})
+		case c == '*' && i+1 < len(input) && input[i+1] == '*':
+			tokens = append(tokens, token{kind: tokOp, text: "^", pos: i})
+			i += 2
+		case c == '+' || c == '-' || c == '*' || c == '/' || c == '^' || c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, token{kind: tokOp, text: string(c), pos: i})
+			i++
+		default:
+			return nil, &ParseError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(input)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(text string) bool {
+	t := p.peek()
+	return t.kind == tokOp && t.text == text
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		return &ParseError{Pos: p.peek().pos, Msg: "expression nested too deeply"}
+	}
+	return nil
+}
+
+// parseSum parses terms joined by + and -.
+func (p *parser) parseSum() (Node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+") || p.isOp("-") {
+		op := p.next().text[0]
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, L: left, R: right}
+	}
+	return left, nil
+}
+
+// parseProduct parses factors joined by *, / or juxtaposition.
+func (p *parser) parseProduct() (Node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op byte
+		switch t := p.peek(); {
+		case p.isOp("*") || p.isOp("/"):
+			op = p.next().text[0]
+		case t.kind == tokNum || t.kind == tokIdent || p.isOp("("):
+			op = '*'
+		default:
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, L: left, R: right}
+	}
+}
+
+// parseUnary parses an optionally negated power. Negation binds looser than
+// ^, so -x^2 is -(x^2).
+func (p *parser) parseUnary() (Node, error) {
+	if p.isOp("-") || p.isOp("+") {
+		op := p.next().text
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer func() { p.depth-- }()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			return x, nil
+		}
+		return &Unary{Op: '-', X: x}, nil
+	}
+	return p.parsePower()
+}
+
+// parsePower parses a right-associative exponentiation.
+func (p *parser) parsePower() (Node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp("^") {
+		return base, nil
+	}
+	p.next()
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	exp, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{Op: '^', L: base, R: exp}, nil
+}
+
+// parsePrimary parses a number, variable, call or parenthesized expression.
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokNum:
+		return &Num{Value: t.num}, nil
+	case t.kind == tokIdent:
+		if !p.isOp("(") {
+			return &Var{Name: t.text}, nil
+		}
+		p.next()
+		var args []Node
+		if !p.isOp(")") {
+			for {
+				arg, err := p.parseSum()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if !p.isOp(",") {
+					break
+				}
+				p.next()
+			}
+		}
+		if !p.isOp(")") {
+			return nil, &ParseError{Pos: p.peek().pos, Msg: "expected )"}
+		}
+		p.next()
+		return &Call{Func: t.text, Args: args}, nil
+	case t.kind == tokOp && t.text == "(":
+		n, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOp(")") {
+			return nil, &ParseError{Pos: p.peek().pos, Msg: "expected )"}
+		}
+		p.next()
+		return n, nil
+	case t.kind == tokEOF:
+		return nil, &ParseError{Pos: t.pos, Msg: "unexpected end of expression"}
+	default:
+		return nil, &ParseError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package expr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1 + 2 * 3", "1 + 2 * 3"},
+		{"(1 + 2) * 3", "(1 + 2) * 3"},
+		{"x^2 + sin(y)", "x^2 + sin(y)"},
+		{"2^3^2", "2^3^2"},
+		{"(2^3)^2", "(2^3)^2"},
+		{"-x^2", "-x^2"},
+		{"(-x)^2", "(-x)^2"},
+		{"x ** 2", "x^2"},
+		{"2x", "2 * x"},
+		{"3(x + 1)", "3 * (x + 1)"},
+		{"a - (b - c)", "a - (b - c)"},
+		{"a / (b * c)", "a / (b * c)"},
+		{"atan2(y, x)", "atan2(y, x)"},
+		{"$rate * 100", "rate * 100"},
+		{"1.5e-3", "0.0015"},
+		{"2e", "2 * e"},
+		{"--x", "-(-x)"},
+		{"x^-2", "x^-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, n.String())
+
+			// Formatting round-trips
+			again, err := Parse(n.String())
+			require.NoError(t, err)
+			assert.Equal(t, n.String(), again.String())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", "unexpected end"},
+		{"1 +", "unexpected end"},
+		{"(1 + 2", "expected )"},
+		{"sin(1, ", "unexpected end"},
+		{"1 # 2", "unexpected character"},
+		{"1..2", "invalid number"},
+		{"$", "expected name"},
+		{"1 2)", "unexpected \")\""},
+		{strings.Repeat("(", 500) + "1" + strings.Repeat(")", 500), "nested too deeply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestFreeVarsAndCalls(t *testing.T) {
+	n, err := Parse("x^2 + f(y, x) * sin(z)")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"x", "y", "z"}, FreeVars(n))
+	assert.Equal(t, []string{"f", "sin"}, Calls(n))
+}
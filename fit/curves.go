@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package fit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Curve is a two-coefficient curve y = f(a, b, x) that becomes a straight
+// line after taking logarithms.
+type Curve string
+
+const (
+	// Exponential is y = a·e^(bx), fitted as log y = log a + bx.
+	Exponential Curve = "exponential"
+	// Power is y = a·x^b, fitted as log y = log a + b log x.
+	Power Curve = "power"
+	// Logarithmic is y = a + b log x.
+	Logarithmic Curve = "logarithmic"
+)
+
+// Eval returns the curve with coefficients a and b at x.
+func (c Curve) Eval(a, b, x float64) float64 {
+	switch c {
+	case Exponential:
+		return a * math.Exp(b*x)
+	case Power:
+		return a * math.Pow(x, b)
+	}
+	return a + b*math.Log(x)
+}
+
+// FitCurve fits the curve to the points (x, y) by least squares on the
+// linearised form, as spreadsheet trend lines do, and returns the
+// coefficients a and b. RSquared, AdjustedRSquared and ResidualStdError
+// describe the linearised fit, and the standard error and covariance of a
+// are propagated from those of log a to first order; Fitted and Residuals
+// are on the original scale. The logarithms need positive x for Power and
+// Logarithmic and positive y for Exponential and Power.
+func FitCurve(c Curve, x, y []float64) (Regression, error) {
+	if len(x) != len(y) {
+		return Regression{}, fmt.Errorf("there are %d values of x but %d observations", len(x), len(y))
+	}
+	logX := c == Power || c == Logarithmic
+	logY := c == Exponential || c == Power
+	switch c {
+	case Exponential, Power, Logarithmic:
+	default:
+		return Regression{}, fmt.Errorf("unknown curve %q", c)
+	}
+	rows := make([][]float64, len(x))
+	ly := make([]float64, len(y))
+	for i := range x {
+		xi, yi := x[i], y[i]
+		if logX {
+			if !(xi > 0) {
+				return Regression{}, fmt.Errorf("the %s curve needs positive x, but x = %g", c, xi)
+			}
+			xi = math.Log(xi)
+		}
+		if logY {
+			if !(yi > 0) {
+				return Regression{}, fmt.Errorf("the %s curve needs positive y, but y = %g", c, yi)
+			}
+			yi = math.Log(yi)
+		}
+		rows[i], ly[i] = []float64{xi}, yi
+	}
+	r, err := Linear(rows, ly, true)
+	if errors.Is(err, ErrCollinear) {
+		return Regression{}, errors.New("x must take at least two distinct values")
+	}
+	if err != nil {
+		return Regression{}, err
+	}
+	if logY {
+		// a = e^(log a), so da = a·d(log a)
+		a := math.Exp(r.Coefficients[0])
+		r.Coefficients[0] = a
+		r.Covariance[0][0] *= a * a
+		r.Covariance[0][1] *= a
+		r.Covariance[1][0] *= a
+		r.standardErrors()
+	}
+	for i := range x {
+		r.Fitted[i] = c.Eval(r.Coefficients[0], r.Coefficients[1], x[i])
+		r.Residuals[i] = y[i] - r.Fitted[i]
+	}
+	return r, nil
+}
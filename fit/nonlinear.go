@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package fit
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/sagacient/math-mcp-server/linalg"
+)
+
+const (
+	// epsilon is the float64 machine epsilon.
+	epsilon = 0x1p-52
+	// MaxEvaluations caps the model evaluations of a nonlinear fit, counting
+	// one per observation.
+	MaxEvaluations = 10_000_000
+	// maxDamping is the damping beyond which no step reduces the sum of
+	// squares to working precision.
+	maxDamping = 1e16
+)
+
+var (
+	// ErrNotFiniteStart is returned when the model is not finite at the
+	// starting parameters.
+	ErrNotFiniteStart = errors.New("the model is not finite at the starting parameters")
+	// ErrNotIdentifiable is returned when the fitted parameters are not all
+	// determined by the data, as when two of them only enter as a product.
+	ErrNotIdentifiable = errors.New("the parameters are not all determined by the data")
+	// ErrTooManyEvaluations is returned when a fit would take more than
+	// MaxEvaluations evaluations of the model.
+	ErrTooManyEvaluations = errors.New("fit needs more than 10000000 model evaluations")
+)
+
+// Model is a model y = f(p, x) with parameters p, evaluated at the
+// predictors x of one observation.
+type Model func(p, x []float64) (float64, error)
+
+// NonlinearFit is the outcome of Nonlinear. The coefficients of the
+// embedded Regression are the parameters, and its statistics are those of
+// the linearised model at them.
+type NonlinearFit struct {
+	Regression
+	Iterations, Evaluations int
+	// Converged is false when the iterations ran out before the tolerance
+	// was met.
+	Converged bool
+}
+
+// leastSquares evaluates the residuals of a model, counting evaluations.
+type leastSquares struct {
+	f           Model
+	x           [][]float64
+	y           []float64
+	evaluations int
+}
+
+// residuals returns y − f(p, x) and its sum of squares, which is +Inf when
+// the model is not finite.
+func (ls *leastSquares) residuals(p []float64) ([]float64, float64, error) {
+	if ls.evaluations+len(ls.y) > MaxEvaluations {
+		return nil, 0, ErrTooManyEvaluations
+	}
+	ls.evaluations += len(ls.y)
+	r := make([]float64, len(ls.y))
+	var ss float64
+	for i, row := range ls.x {
+		v, err := ls.f(p, row)
+		if err != nil {
+			return nil, 0, err
+		}
+		r[i] = ls.y[i] - v
+		ss += r[i] * r[i]
+	}
+	if math.IsNaN(ss) {
+		ss = math.Inf(1)
+	}
+	return r, ss, nil
+}
+
+// jacobian estimates the Jacobian of the model values, −∂r/∂p, by central
+// differences, or one-sided ones where the model is not finite on one side.
+func (ls *leastSquares) jacobian(p, r []float64) (*linalg.Matrix, error) {
+	j := linalg.New(len(r), len(p))
+	shifted := slices.Clone(p)
+	for k, pk := range p {
+		h := math.Cbrt(epsilon) * math.Abs(pk)
+		if h == 0 {
+			h = math.Cbrt(epsilon)
+		}
+		h = (pk + h) - pk
+		shifted[k] = pk + h
+		up, ssUp, err := ls.residuals(shifted)
+		if err != nil {
+			return nil, err
+		}
+		shifted[k] = pk - h
+		down, ssDown, err := ls.residuals(shifted)
+		if err != nil {
+			return nil, err
+		}
+		shifted[k] = pk
+		for i := range r {
+			switch {
+			case !math.IsInf(ssUp, 1) && !math.IsInf(ssDown, 1):
+				j.Set(i, k, (down[i]-up[i])/(2*h))
+			case !math.IsInf(ssUp, 1):
+				j.Set(i, k, (r[i]-up[i])/h)
+			case !math.IsInf(ssDown, 1):
+				j.Set(i, k, (down[i]-r[i])/h)
+			}
+		}
+	}
+	return j, nil
+}
+
+// Nonlinear fits the model to the observations y at the predictors x by
+// least squares with the Levenberg–Marquardt method, starting from the
+// parameters p0 and using a numerical Jacobian. Each iteration solves the
+// Gauss–Newton equations damped by λ times their diagonal, lowering λ after
+// a step that reduces the sum of squares and raising it otherwise, so that
+// the method moves between Gauss–Newton steps near the solution and short
+// gradient steps far from it. It stops once a step changes the parameters
+// by at most tol relative to their size, or the sum of squares by at most
+// tol relative to its value. Standard errors come from the Jacobian at the
+// solution, and ErrNotIdentifiable is returned when it is rank-deficient.
+func Nonlinear(f Model, x [][]float64, y, p0 []float64, tol float64, maxIter int) (NonlinearFit, error) {
+	if len(p0) == 0 {
+		return NonlinearFit{}, errors.New("there must be at least one parameter")
+	}
+	if !allFinite(p0) {
+		return NonlinearFit{}, errors.New("starting parameters must be finite")
+	}
+	if !(tol >= 0) || math.IsInf(tol, 0) {
+		return NonlinearFit{}, errors.New("tolerance must be a non-negative number")
+	}
+	if err := checkData(x, y, len(p0)); err != nil {
+		return NonlinearFit{}, err
+	}
+	ls := &leastSquares{f: f, x: x, y: y}
+	n := len(p0)
+	p := slices.Clone(p0)
+	r, ss, err := ls.residuals(p)
+	if err != nil {
+		return NonlinearFit{}, err
+	}
+	if math.IsInf(ss, 1) {
+		return NonlinearFit{}, ErrNotFiniteStart
+	}
+	j, err := ls.jacobian(p, r)
+	if err != nil {
+		return NonlinearFit{}, err
+	}
+	fit := NonlinearFit{}
+	var lambda float64
+	for fit.Iterations < maxIter {
+		// Normal equations JᵀJ δ = Jᵀr of the Gauss–Newton step
+		jtj := linalg.New(n, n)
+		g := make([]float64, n)
+		var maxDiag float64
+		for a := range n {
+			for i := range r {
+				g[a] += j.At(i, a) * r[i]
+			}
+			for b := range n {
+				var s float64
+				for i := range r {
+					s += j.At(i, a) * j.At(i, b)
+				}
+				jtj.Set(a, b, s)
+			}
+			maxDiag = math.Max(maxDiag, jtj.At(a, a))
+		}
+		if ss == 0 || normInf(g) == 0 || maxDiag == 0 {
+			fit.Converged = true
+			break
+		}
+		if lambda == 0 {
+			lambda = 1e-3
+		}
+		fit.Iterations++
+
+		accepted := false
+		var step, next, rNext []float64
+		var ssNext float64
+		for lambda <= maxDamping {
+			damped := jtj.Clone()
+			for a := range n {
+				damped.Set(a, a, jtj.At(a, a)+lambda*math.Max(jtj.At(a, a), 1e-12*maxDiag))
+			}
+			rhs := linalg.New(n, 1)
+			copy(rhs.Data, g)
+			if sol, err := linalg.NewLU(damped).Solve(rhs); err == nil {
+				step = sol.Data
+				next = make([]float64, n)
+				for a := range n {
+					next[a] = p[a] + step[a]
+				}
+				if rNext, ssNext, err = ls.residuals(next); err != nil {
+					return fit, err
+				}
+				if ssNext < ss {
+					accepted = true
+					break
+				}
+			}
+			lambda *= 4
+		}
+		if !accepted {
+			// No step reduces the sum of squares: p is a minimum to
+			// working precision
+			fit.Converged = true
+			break
+		}
+		lambda = math.Max(lambda/8, 1e-12)
+		decrease := ss - ssNext
+		p, r, ss = next, rNext, ssNext
+		if j, err = ls.jacobian(p, r); err != nil {
+			return fit, err
+		}
+		if normInf(step) <= tol*(normInf(p)+tol) || decrease <= tol*ss {
+			fit.Converged = true
+			break
+		}
+	}
+	fit.Evaluations = ls.evaluations
+
+	svd, scales, err := scaledSVD(j)
+	if err != nil {
+		return fit, ErrNotIdentifiable
+	}
+	fitted := make([]float64, len(y))
+	for i := range y {
+		fitted[i] = y[i] - r[i]
+	}
+	fit.Coefficients = p
+	fit.summarize(y, fitted, n, true)
+	fit.Covariance = covariance(svd, scales, fit.ResidualStdError*fit.ResidualStdError)
+	fit.standardErrors()
+	return fit, nil
+}
+
+// normInf returns the largest magnitude of the entries of v.
+func normInf(v []float64) float64 {
+	var m float64
+	for _, x := range v {
+		m = math.Max(m, math.Abs(x))
+	}
+	return m
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package fit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exactModel(f func(p, x []float64) float64) Model {
+	return func(p, x []float64) (float64, error) { return f(p, x), nil }
+}
+
+func TestNonlinearMisra1a(t *testing.T) {
+	// NIST StRD Misra1a, from its first starting point
+	x := column(77.6, 114.9, 141.1, 190.8, 239.9, 289.0, 332.8, 378.4, 434.8, 477.3, 536.8, 593.1, 689.1, 760.0)
+	y := []float64{10.07, 14.73, 17.94, 23.93, 29.61, 35.18, 40.02, 44.82, 50.76, 55.05, 61.01, 66.40, 75.47, 81.78}
+	model := exactModel(func(p, x []float64) float64 { return p[0] * (1 - math.Exp(-p[1]*x[0])) })
+	r, err := Nonlinear(model, x, y, []float64{500, 1e-4}, 1e-12, 200)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDelta(t, 2.3894212918e+02, r.Coefficients[0], 1e-5)
+	assert.InDelta(t, 5.5015643181e-04, r.Coefficients[1], 1e-11)
+	assert.InDelta(t, 2.7070075241e+00, r.StandardErrors[0], 1e-5)
+	assert.InDelta(t, 7.2668688436e-06, r.StandardErrors[1], 1e-11)
+	assert.InDelta(t, 1.0187876330e-01, r.ResidualStdError, 1e-8)
+	assert.Equal(t, 12, r.DegreesOfFreedom)
+	assert.Positive(t, r.Evaluations)
+}
+
+func TestNonlinearMatchesLinear(t *testing.T) {
+	x := column(1, 2, 3, 4, 5)
+	y := []float64{2.1, 3.9, 6.2, 7.8, 10.1}
+	linear, err := Linear(x, y, true)
+	require.NoError(t, err)
+	model := exactModel(func(p, x []float64) float64 { return p[0] + p[1]*x[0] })
+	r, err := Nonlinear(model, x, y, []float64{0, 0}, 1e-12, 100)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDeltaSlice(t, linear.Coefficients, r.Coefficients, 1e-9)
+	assert.InDeltaSlice(t, linear.StandardErrors, r.StandardErrors, 1e-9)
+	assert.InDelta(t, linear.RSquared, r.RSquared, 1e-12)
+}
+
+func TestNonlinearSeveralPredictors(t *testing.T) {
+	// z = a·exp(−b·x)·sin(c·y) + d, sampled exactly
+	want := []float64{2, 0.5, 1.5, -1}
+	f := func(p, x []float64) float64 { return p[0]*math.Exp(-p[1]*x[0])*math.Sin(p[2]*x[1]) + p[3] }
+	var x [][]float64
+	var y []float64
+	for i := range 5 {
+		for j := range 5 {
+			point := []float64{float64(i) / 2, float64(j)/2 + 0.25}
+			x = append(x, point)
+			y = append(y, f(want, point))
+		}
+	}
+	r, err := Nonlinear(exactModel(f), x, y, []float64{1, 1, 1.2, 0}, 1e-12, 200)
+	require.NoError(t, err)
+	assert.True(t, r.Converged)
+	assert.InDeltaSlice(t, want, r.Coefficients, 1e-8)
+	assert.InDelta(t, 1, r.RSquared, 1e-12)
+}
+
+func TestNonlinearNotConverged(t *testing.T) {
+	x := column(1, 2, 3, 4, 5)
+	y := []float64{1, 0.5, 0.3, 0.2, 0.1}
+	model := exactModel(func(p, x []float64) float64 { return p[0] * math.Exp(-p[1]*x[0]) })
+	r, err := Nonlinear(model, x, y, []float64{10, 5}, 1e-12, 1)
+	require.NoError(t, err)
+	assert.False(t, r.Converged)
+	assert.Equal(t, 1, r.Iterations)
+}
+
+func TestNonlinearErrors(t *testing.T) {
+	x := column(1, 2, 3, 4)
+	y := []float64{1, 2, 3, 4}
+	product := exactModel(func(p, x []float64) float64 { return p[0] * p[1] * x[0] })
+	_, err := Nonlinear(product, x, y, []float64{1, 1}, 1e-10, 100)
+	assert.ErrorIs(t, err, ErrNotIdentifiable)
+
+	log := exactModel(func(p, x []float64) float64 { return math.Log(p[0] * x[0]) })
+	_, err = Nonlinear(log, x, y, []float64{-1}, 1e-10, 100)
+	assert.ErrorIs(t, err, ErrNotFiniteStart)
+
+	_, err = Nonlinear(log, x, y, nil, 1e-10, 100)
+	assert.EqualError(t, err, "there must be at least one parameter")
+	_, err = Nonlinear(product, x[:2], y[:2], []float64{1, 1}, 1e-10, 100)
+	assert.EqualError(t, err, "fitting 2 coefficients needs more than 2 observations")
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package fit implements least-squares regression and curve fitting: linear
+// and polynomial regression, fits of exponential, power and logarithmic
+// curves, and nonlinear least squares for arbitrary models.
+package fit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sagacient/math-mcp-server/linalg"
+)
+
+// ErrCollinear is returned when the columns of the design matrix are
+// linearly dependent, so that the coefficients are not unique.
+var ErrCollinear = errors.New("the predictors are linearly dependent, so the coefficients are not unique")
+
+// Regression is the outcome of a least-squares fit.
+type Regression struct {
+	// Coefficients are the fitted coefficients, with the intercept first if
+	// there is one, and StandardErrors their standard errors, the square
+	// roots of the diagonal of Covariance.
+	Coefficients, StandardErrors []float64
+	Covariance                   [][]float64
+	// Fitted are the fitted values and Residuals the observed values minus
+	// them.
+	Fitted, Residuals []float64
+	// RSquared is the fraction of the variation of the observations about
+	// their mean explained by the fit, or about zero for fits without an
+	// intercept, and AdjustedRSquared its correction for the number of
+	// coefficients.
+	RSquared, AdjustedRSquared float64
+	// ResidualStdError estimates the standard deviation of the errors with
+	// DegreesOfFreedom degrees of freedom.
+	ResidualStdError float64
+	DegreesOfFreedom int
+}
+
+// scaledSVD factorises a with its columns scaled to unit norm, so that the
+// rank decision does not depend on the units of the columns, and returns
+// the scales. It returns ErrCollinear if a has deficient column rank.
+func scaledSVD(a *linalg.Matrix) (*linalg.SVD, []float64, error) {
+	scaled := a.Clone()
+	scales := make([]float64, a.Cols)
+	for j := range a.Cols {
+		var norm float64
+		for i := range a.Rows {
+			norm = math.Hypot(norm, a.At(i, j))
+		}
+		if norm == 0 {
+			return nil, nil, ErrCollinear
+		}
+		scales[j] = norm
+		for i := range a.Rows {
+			scaled.Set(i, j, a.At(i, j)/norm)
+		}
+	}
+	svd := linalg.NewSVD(scaled)
+	if svd.Rank(-1) < a.Cols {
+		return nil, nil, ErrCollinear
+	}
+	return svd, scales, nil
+}
+
+// covariance returns sigma2·(AᵀA)⁻¹ from the SVD of A with scaled columns.
+func covariance(svd *linalg.SVD, scales []float64, sigma2 float64) [][]float64 {
+	p := len(scales)
+	cov := make([][]float64, p)
+	for i := range cov {
+		cov[i] = make([]float64, p)
+		for j := range cov[i] {
+			var c float64
+			for k, s := range svd.S {
+				c += svd.V.At(i, k) * svd.V.At(j, k) / (s * s)
+			}
+			cov[i][j] = sigma2 * c / (scales[i] * scales[j])
+		}
+	}
+	return cov
+}
+
+// summarize fills in the residuals and the statistics of a fit of y with p
+// coefficients, given the fitted values.
+func (r *Regression) summarize(y, fitted []float64, p int, intercept bool) {
+	n := len(y)
+	r.Fitted = fitted
+	r.Residuals = make([]float64, n)
+	var mean float64
+	if intercept {
+		for _, v := range y {
+			mean += v / float64(n)
+		}
+	}
+	var rss, tss float64
+	for i, v := range y {
+		r.Residuals[i] = v - fitted[i]
+		rss += r.Residuals[i] * r.Residuals[i]
+		tss += (v - mean) * (v - mean)
+	}
+	r.DegreesOfFreedom = n - p
+	r.ResidualStdError = math.Sqrt(rss / float64(r.DegreesOfFreedom))
+	r.RSquared = 1
+	if tss > 0 {
+		r.RSquared = 1 - rss/tss
+	}
+	total := float64(n)
+	if intercept {
+		total--
+	}
+	r.AdjustedRSquared = 1 - (1-r.RSquared)*total/float64(r.DegreesOfFreedom)
+}
+
+// standardErrors sets the standard errors from the covariance.
+func (r *Regression) standardErrors() {
+	r.StandardErrors = make([]float64, len(r.Covariance))
+	for i, row := range r.Covariance {
+		r.StandardErrors[i] = math.Sqrt(math.Max(row[i], 0))
+	}
+}
+
+// checkData checks that x has one row of predictors per observation of y,
+// all finite, and that there are more observations than the p coefficients.
+func checkData(x [][]float64, y []float64, p int) error {
+	if len(x) != len(y) {
+		return fmt.Errorf("there are %d rows of predictors but %d observations", len(x), len(y))
+	}
+	if len(y) <= p {
+		return fmt.Errorf("fitting %d coefficients needs more than %d observations", p, p)
+	}
+	for i, row := range x {
+		if len(row) != len(x[0]) {
+			return fmt.Errorf("row %d has %d predictors, expected %d", i+1, len(row), len(x[0]))
+		}
+		if !allFinite(row) {
+			return errors.New("predictors must be finite")
+		}
+	}
+	if !allFinite(y) {
+		return errors.New("observations must be finite")
+	}
+	return nil
+}
+
+// Linear fits y ≈ b₀ + b₁x₁ + … + bₖxₖ by least squares, where each row of
+// x holds the k predictors of one observation, or without b₀ if intercept
+// is false. The least-squares problem is solved by a singular value
+// decomposition, which stays accurate when the predictors are nearly
+// collinear; exactly collinear predictors give ErrCollinear.
+func Linear(x [][]float64, y []float64, intercept bool) (Regression, error) {
+	if len(x) == 0 || len(x[0]) == 0 {
+		return Regression{}, errors.New("there must be at least one predictor")
+	}
+	p := len(x[0])
+	if intercept {
+		p++
+	}
+	if err := checkData(x, y, p); err != nil {
+		return Regression{}, err
+	}
+	a := linalg.New(len(y), p)
+	for i, row := range x {
+		j := 0
+		if intercept {
+			a.Set(i, 0, 1)
+			j = 1
+		}
+		for k, v := range row {
+			a.Set(i, j+k, v)
+		}
+	}
+	svd, scales, err := scaledSVD(a)
+	if err != nil {
+		return Regression{}, err
+	}
+	coef, _ := svd.LeastSquares(y, -1)
+	for j := range coef {
+		coef[j] /= scales[j]
+	}
+	fitted := make([]float64, len(y))
+	for i := range fitted {
+		for j, c := range coef {
+			fitted[i] += a.At(i, j) * c
+		}
+	}
+
+	r := Regression{Coefficients: coef}
+	r.summarize(y, fitted, p, intercept)
+	r.Covariance = covariance(svd, scales, r.ResidualStdError*r.ResidualStdError)
+	r.standardErrors()
+	return r, nil
+}
+
+// MaxPolynomialDegree caps the degree of Polynomial.
+const MaxPolynomialDegree = 20
+
+// Polynomial fits y ≈ b₀ + b₁x + … + b_d x^d by least squares, returning
+// the coefficients in increasing order of degree. The fit is computed in x
+// shifted and scaled onto [−1, 1], which keeps the powers of x from growing
+// nearly collinear, and then expanded back in powers of x.
+func Polynomial(x, y []float64, degree int) (Regression, error) {
+	if degree < 1 || degree > MaxPolynomialDegree {
+		return Regression{}, fmt.Errorf("degree must be between 1 and %d", MaxPolynomialDegree)
+	}
+	if len(x) != len(y) {
+		return Regression{}, fmt.Errorf("there are %d values of x but %d observations", len(x), len(y))
+	}
+	if !allFinite(x) {
+		return Regression{}, errors.New("predictors must be finite")
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range x {
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	mid, half := (lo+hi)/2, (hi-lo)/2
+	if half == 0 {
+		half = 1
+	}
+	rows := make([][]float64, len(x))
+	for i, v := range x {
+		t := (v - mid) / half
+		rows[i] = make([]float64, degree)
+		power := 1.0
+		for k := range degree {
+			power *= t
+			rows[i][k] = power
+		}
+	}
+	r, err := Linear(rows, y, true)
+	if err != nil {
+		if errors.Is(err, ErrCollinear) {
+			return Regression{}, fmt.Errorf("a polynomial of degree %d needs at least %d distinct values of x", degree, degree+1)
+		}
+		return Regression{}, err
+	}
+
+	// Σₖ cₖ((x − m)/h)ᵏ = Σⱼ xʲ Σₖ cₖ C(k, j)(−m)ᵏ⁻ʲ/hᵏ, a linear map T of the
+	// coefficients, which maps the covariance to T·Cov·Tᵀ
+	p := degree + 1
+	t := make([][]float64, p)
+	for j := range t {
+		t[j] = make([]float64, p)
+		for k := j; k < p; k++ {
+			t[j][k] = binomial(k, j) * math.Pow(-mid, float64(k-j)) / math.Pow(half, float64(k))
+		}
+	}
+	coef := make([]float64, p)
+	cov := make([][]float64, p)
+	for i := range p {
+		for k := range p {
+			coef[i] += t[i][k] * r.Coefficients[k]
+		}
+		cov[i] = make([]float64, p)
+		for j := range p {
+			for k := range p {
+				for l := range p {
+					cov[i][j] += t[i][k] * r.Covariance[k][l] * t[j][l]
+				}
+			}
+		}
+	}
+	r.Coefficients, r.Covariance = coef, cov
+	r.standardErrors()
+	return r, nil
+}
+
+// binomial returns the binomial coefficient C(n, k).
+func binomial(n, k int) float64 {
+	c := 1.0
+	for i := range k {
+		c = c * float64(n-i) / float64(i+1)
+	}
+	return c
+}
+
+// allFinite reports whether every value is finite.
+func allFinite(values []float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
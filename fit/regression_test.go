@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package fit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// column turns values into rows of one predictor.
+func column(values ...float64) [][]float64 {
+	rows := make([][]float64, len(values))
+	for i, v := range values {
+		rows[i] = []float64{v}
+	}
+	return rows
+}
+
+func TestLinearSimple(t *testing.T) {
+	// Reference values from the normal equations in exact arithmetic
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2.1, 3.9, 6.2, 7.8, 10.1}
+	r, err := Linear(column(x...), y, true)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.05, 1.99}, r.Coefficients, 1e-12)
+	assert.InDeltaSlice(t, []float64{0.1980741, 0.0597216}, r.StandardErrors, 1e-7)
+	assert.InDelta(t, 0.9973053, r.RSquared, 1e-7)
+	assert.InDelta(t, 0.9964071, r.AdjustedRSquared, 1e-7)
+	assert.InDelta(t, 0.1888562, r.ResidualStdError, 1e-7)
+	assert.Equal(t, 3, r.DegreesOfFreedom)
+	assert.InDeltaSlice(t, []float64{0.06, -0.13, 0.18, -0.21, 0.1}, r.Residuals, 1e-12)
+	for i := range y {
+		assert.InDelta(t, y[i], r.Fitted[i]+r.Residuals[i], 1e-15)
+	}
+}
+
+func TestLinearMultiple(t *testing.T) {
+	// y = 1 + 2a − 3b exactly, then with noise
+	x := [][]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {2, 1}, {1, 3}}
+	y := make([]float64, len(x))
+	for i, row := range x {
+		y[i] = 1 + 2*row[0] - 3*row[1]
+	}
+	r, err := Linear(x, y, true)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 2, -3}, r.Coefficients, 1e-12)
+	assert.InDelta(t, 1, r.RSquared, 1e-12)
+	assert.InDeltaSlice(t, []float64{0, 0, 0}, r.StandardErrors, 1e-7)
+
+	noise := []float64{0.1, -0.2, 0.05, 0.1, -0.1, 0.05}
+	for i := range y {
+		y[i] += noise[i]
+	}
+	r, err = Linear(x, y, true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, r.DegreesOfFreedom)
+	assert.InDeltaSlice(t, []float64{1, 2, -3}, r.Coefficients, 0.3)
+	// The residuals are orthogonal to every column
+	var sum, dotA, dotB float64
+	for i, row := range x {
+		sum += r.Residuals[i]
+		dotA += r.Residuals[i] * row[0]
+		dotB += r.Residuals[i] * row[1]
+	}
+	assert.InDelta(t, 0, sum, 1e-12)
+	assert.InDelta(t, 0, dotA, 1e-12)
+	assert.InDelta(t, 0, dotB, 1e-12)
+	assert.Len(t, r.Covariance, 3)
+	assert.InDelta(t, r.Covariance[0][1], r.Covariance[1][0], 1e-15)
+}
+
+func TestLinearNoIntercept(t *testing.T) {
+	// Σxy/Σx² = 14.1/7
+	r, err := Linear(column(1, 2, 3), []float64{2.1, 3.9, 6.1}, false)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{14.1 / 7}, r.Coefficients, 1e-7)
+	assert.Equal(t, 2, r.DegreesOfFreedom)
+	assert.Greater(t, r.RSquared, 0.999)
+}
+
+func TestLinearErrors(t *testing.T) {
+	_, err := Linear(column(1, 2, 3), []float64{1, 2}, true)
+	assert.EqualError(t, err, "there are 3 rows of predictors but 2 observations")
+	_, err = Linear(column(1, 2), []float64{1, 2}, true)
+	assert.EqualError(t, err, "fitting 2 coefficients needs more than 2 observations")
+	_, err = Linear([][]float64{{1, 2}, {2, 4}, {3, 6}, {4, 8}}, []float64{1, 2, 3, 4}, true)
+	assert.ErrorIs(t, err, ErrCollinear)
+	_, err = Linear(column(1, 1, 1), []float64{1, 2, 3}, true)
+	assert.ErrorIs(t, err, ErrCollinear)
+	_, err = Linear(column(1, math.NaN(), 3), []float64{1, 2, 3}, true)
+	assert.EqualError(t, err, "predictors must be finite")
+	_, err = Linear([][]float64{{1}, {2, 3}, {3}}, []float64{1, 2, 3}, true)
+	assert.EqualError(t, err, "row 2 has 2 predictors, expected 1")
+}
+
+func TestPolynomial(t *testing.T) {
+	// An exact cubic at large x, where plain powers are nearly collinear
+	p := func(x float64) float64 { return 5 - 0.5*(x-2000) + 0.25*math.Pow(x-2000, 2) - 0.01*math.Pow(x-2000, 3) }
+	var x, y []float64
+	for v := 1990.0; v <= 2020; v += 2 {
+		x = append(x, v)
+		y = append(y, p(v))
+	}
+	r, err := Polynomial(x, y, 3)
+	require.NoError(t, err)
+	require.Len(t, r.Coefficients, 4)
+	assert.InDelta(t, 1, r.RSquared, 1e-12)
+	for _, v := range []float64{1990, 2005, 2020} {
+		var got float64
+		for k, c := range r.Coefficients {
+			got += c * math.Pow(v, float64(k))
+		}
+		assert.InDelta(t, p(v), got, 1e-3)
+	}
+
+	// A small noisy quadratic, checked against the normal equations in
+	// exact arithmetic
+	r, err = Polynomial([]float64{0, 1, 2, 3, 4, 5}, []float64{1.1, 1.9, 5.2, 9.8, 17.1, 26.2}, 2)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1.0892857, -0.1139286, 1.0267857}, r.Coefficients, 1e-7)
+	assert.InDeltaSlice(t, []float64{0.1664735, 0.1565898, 0.0300616}, r.StandardErrors, 1e-7)
+}
+
+func TestPolynomialErrors(t *testing.T) {
+	_, err := Polynomial([]float64{1, 2, 3}, []float64{1, 2, 3}, 0)
+	assert.EqualError(t, err, "degree must be between 1 and 20")
+	_, err = Polynomial([]float64{1, 1, 2, 2}, []float64{1, 2, 3, 4}, 2)
+	assert.EqualError(t, err, "a polynomial of degree 2 needs at least 3 distinct values of x")
+	_, err = Polynomial([]float64{1, 2, 3}, []float64{1, 2, 3}, 2)
+	assert.EqualError(t, err, "fitting 3 coefficients needs more than 3 observations")
+}
+
+func TestFitCurve(t *testing.T) {
+	tests := []struct {
+		curve Curve
+		a, b  float64
+	}{
+		{Exponential, 2.5, -0.3},
+		{Power, 1.5, 2.2},
+		{Logarithmic, 4, -1.5},
+	}
+	x := []float64{0.5, 1, 2, 3, 5, 8}
+	for _, tt := range tests {
+		t.Run(string(tt.curve), func(t *testing.T) {
+			y := make([]float64, len(x))
+			for i, v := range x {
+				y[i] = tt.curve.Eval(tt.a, tt.b, v)
+			}
+			r, err := FitCurve(tt.curve, x, y)
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, []float64{tt.a, tt.b}, r.Coefficients, 1e-12)
+			assert.InDelta(t, 1, r.RSquared, 1e-12)
+			assert.InDeltaSlice(t, make([]float64, len(x)), r.Residuals, 1e-12)
+		})
+	}
+
+	// An exponential trend line through (1, 3), (2, 7), (3, 11), (4, 25),
+	// fitted to log y
+	r, err := FitCurve(Exponential, []float64{1, 2, 3, 4}, []float64{3, 7, 11, 25})
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5874508, r.Coefficients[0], 1e-7)
+	assert.InDelta(t, 0.6812776, r.Coefficients[1], 1e-7)
+	assert.InDelta(t, 0.9874976, r.RSquared, 1e-7)
+	assert.InDelta(t, 25-r.Fitted[3], r.Residuals[3], 0)
+
+	// The standard error of a is a times that of log a
+	logFit, err := Linear(column(1, 2, 3, 4), []float64{math.Log(3), math.Log(7), math.Log(11), math.Log(25)}, true)
+	require.NoError(t, err)
+	assert.InDelta(t, r.Coefficients[0]*logFit.StandardErrors[0], r.StandardErrors[0], 1e-12)
+	assert.InDelta(t, logFit.StandardErrors[1], r.StandardErrors[1], 1e-15)
+}
+
+func TestFitCurveErrors(t *testing.T) {
+	_, err := FitCurve(Power, []float64{0, 1, 2}, []float64{1, 2, 3})
+	assert.EqualError(t, err, "the power curve needs positive x, but x = 0")
+	_, err = FitCurve(Exponential, []float64{0, 1, 2}, []float64{1, -2, 3})
+	assert.EqualError(t, err, "the exponential curve needs positive y, but y = -2")
+	_, err = FitCurve(Logarithmic, []float64{2, 2, 2}, []float64{1, 2, 3})
+	assert.EqualError(t, err, "x must take at least two distinct values")
+	_, err = FitCurve("sigmoid", []float64{1, 2, 3}, []float64{1, 2, 3})
+	assert.EqualError(t, err, `unknown curve "sigmoid"`)
+}
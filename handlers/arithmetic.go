@@ -7,7 +7,6 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -106,7 +105,7 @@ func addHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a + b
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, a, b), nil
 }
 
 func subtractHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -119,7 +118,7 @@ func subtractHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToo
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a - b
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, a, b), nil
 }
 
 func multiplyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -132,7 +131,10 @@ func multiplyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToo
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a * b
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, a != 0 && b != 0); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, a, b), nil
 }
 
 func divideHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -148,7 +150,10 @@ func divideHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError("division by zero"), nil
 	}
 	result := a / b
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, a != 0 && !math.IsInf(b, 0)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, a, b), nil
 }
 
 func modHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -164,7 +169,7 @@ func modHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError("modulo by zero"), nil
 	}
 	result := math.Mod(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func remainderHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -180,7 +185,7 @@ func remainderHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTo
 		return mcp.NewToolResultError("remainder by zero"), nil
 	}
 	result := math.Remainder(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func absHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -189,5 +194,5 @@ func absHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Abs(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
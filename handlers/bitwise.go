@@ -94,6 +94,7 @@ func bitAndHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a & b
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d (binary: %b)", result, result)), nil
 }
 
@@ -107,6 +108,7 @@ func bitOrHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a | b
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d (binary: %b)", result, result)), nil
 }
 
@@ -120,6 +122,7 @@ func bitXorHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := a ^ b
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d (binary: %b)", result, result)), nil
 }
 
@@ -129,6 +132,7 @@ func bitNotHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := ^a
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d", result)), nil
 }
 
@@ -145,6 +149,7 @@ func bitLeftShiftHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 		return mcp.NewToolResultError("shift count must be non-negative"), nil
 	}
 	result := a << uint(n)
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d (binary: %b)", result, result)), nil
 }
 
@@ -161,5 +166,6 @@ func bitRightShiftHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Ca
 		return mcp.NewToolResultError("shift count must be non-negative"), nil
 	}
 	result := a >> uint(n)
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d (binary: %b)", result, result)), nil
 }
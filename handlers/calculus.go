@@ -0,0 +1,593 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/calculus"
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/expr"
+	"github.com/sagacient/math-mcp-server/linalg"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxCalculusVariables caps the variables of gradient, jacobian and hessian
+// and the expressions of jacobian; the Hessian needs work quadratic in it.
+const maxCalculusVariables = 50
+
+// registerCalculus registers calculus tools.
+func (r *Registry) registerCalculus() {
+	cat := config.CategoryCalculus
+
+	// Derivative
+	r.addTool(
+		mcp.NewTool("derivative",
+			mcp.WithDescription("Numerical derivative of an expression at a point, of order 1 to 10, by central differences with Richardson extrapolation, with an error estimate. "+
+				"Expressions may use built-in functions such as sin, gamma and erf, constants, session variables and defined functions"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to differentiate, e.g. \"sin(x) * exp(x)\"")),
+			mcp.WithString("variable", mcp.Description("Variable to differentiate with respect to (default x)")),
+			mcp.WithNumber("at", mcp.Required(), mcp.Description("Point to differentiate at")),
+			mcp.WithNumber("order", mcp.Description("Order of the derivative, 1 to 10 (default 1)")),
+			mcp.WithNumber("step", mcp.Description("First finite difference step (default 0.1·max(|at|, 1)); reduce it for functions that vary on a finer scale")),
+		),
+		r.sessions.derivativeHandler,
+		cat,
+	)
+
+	// Gradient
+	r.addTool(
+		mcp.NewTool("gradient",
+			mcp.WithDescription("Numerical gradient of an expression in several variables at a point, with error estimates"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression, e.g. \"x^2 * y + sin(z)\"")),
+			variablesParam(),
+			atParam(),
+		),
+		r.sessions.gradientHandler,
+		cat,
+	)
+
+	// Jacobian
+	r.addTool(
+		mcp.NewTool("jacobian",
+			mcp.WithDescription("Numerical Jacobian matrix of several expressions in several variables at a point: row i holds the partial derivatives of expression i"),
+			mcp.WithArray("expressions", mcp.Required(), mcp.Description("Expressions, one per component of the function"), mcp.WithStringItems()),
+			variablesParam(),
+			atParam(),
+		),
+		r.sessions.jacobianHandler,
+		cat,
+	)
+
+	// Hessian
+	r.addTool(
+		mcp.NewTool("hessian",
+			mcp.WithDescription("Numerical Hessian matrix of second partial derivatives of an expression in several variables at a point"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression, e.g. \"x^2 * y + sin(z)\"")),
+			variablesParam(),
+			atParam(),
+		),
+		r.sessions.hessianHandler,
+		cat,
+	)
+
+	// Integrate
+	r.addTool(
+		mcp.NewTool("integrate",
+			mcp.WithDescription("Definite integral of an expression over [lower, upper] by adaptive Gauss–Kronrod quadrature, with an error estimate and the number of evaluations. "+
+				"Bounds may be infinite, and integrable singularities at the bounds, such as 1/sqrt(x) at 0, are handled"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to integrate, e.g. \"exp(-x^2)\"")),
+			mcp.WithString("variable", mcp.Description("Variable of integration (default x)")),
+			boundParam("lower", "Lower bound"),
+			boundParam("upper", "Upper bound"),
+			mcp.WithNumber("tolerance", mcp.Description("Relative accuracy to aim for (default 1e-10)")),
+			mcp.WithNumber("absolute_tolerance", mcp.Description("Absolute accuracy to aim for, for integrals that may be zero (default 0)")),
+		),
+		r.sessions.integrateHandler,
+		cat,
+	)
+
+	// Integrate region
+	r.addTool(
+		mcp.NewTool("integrate_region",
+			mcp.WithDescription("Integral of an expression in 2 or 3 variables over a rectangular region, by adaptive Genz–Malik cubature, with an error estimate and the number of evaluations. "+
+				"Bounds may be infinite"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to integrate, e.g. \"x * y^2\"")),
+			mcp.WithArray("variables", mcp.Required(), mcp.Description("Variable names, e.g. [\"x\", \"y\"]"), mcp.WithStringItems()),
+			boundsParam("lower", "Lower bounds"),
+			boundsParam("upper", "Upper bounds"),
+			mcp.WithNumber("tolerance", mcp.Description("Relative accuracy to aim for (default 1e-6)")),
+			mcp.WithNumber("absolute_tolerance", mcp.Description("Absolute accuracy to aim for, for integrals that may be zero (default 0)")),
+		),
+		r.sessions.integrateRegionHandler,
+		cat,
+	)
+
+	// Solve ODE
+	r.addTool(
+		mcp.NewTool("solve_ode",
+			mcp.WithDescription("Solve an initial value problem for a system of first-order ordinary differential equations y' = f(t, y) and return the solution at the requested times. "+
+				"Methods: rk45 (default), adaptive Dormand–Prince; rk4, classical Runge–Kutta with a fixed step; stiff, an adaptive linearly implicit Rosenbrock method for stiff problems such as chemical kinetics. "+
+				"Higher-order equations are written as systems, e.g. x'' = -x as x' = v, v' = -x"),
+			mcp.WithArray("equations", mcp.Required(), mcp.Description("Right-hand sides, one per variable, in terms of the time and the variables, e.g. [\"-beta*S*I\", \"beta*S*I - gamma*I\", \"gamma*I\"]"), mcp.WithStringItems()),
+			mcp.WithArray("variables", mcp.Required(), mcp.Description("Names of the unknowns, in the same order as the equations, e.g. [\"S\", \"I\", \"R\"]"), mcp.WithStringItems()),
+			mcp.WithArray("initial", mcp.Required(), mcp.Description("Values of the variables at the start time, in the same order"), mcp.WithNumberItems()),
+			mcp.WithArray("times", mcp.Required(), mcp.Description("Times to report the solution at, moving away from the start time in one direction, e.g. [1, 2, 5, 10]"), mcp.WithNumberItems()),
+			mcp.WithNumber("start", mcp.Description("Time of the initial values (default 0)")),
+			mcp.WithString("time_variable", mcp.Description("Name of the time variable (default t)")),
+			mcp.WithString("method", mcp.Enum(string(calculus.RK45), string(calculus.RK4), string(calculus.Stiff)), mcp.Description("Integration method (default rk45)")),
+			mcp.WithNumber("step", mcp.Description("Step of rk4 (default a thousandth of the time range), or the first step of the adaptive methods (default chosen automatically)")),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Relative error allowed per step by the adaptive methods (default %g)", defaultODETolerance))),
+			mcp.WithNumber("absolute_tolerance", mcp.Description(fmt.Sprintf("Absolute error allowed per step by the adaptive methods, for variables near zero (default %g)", defaultODEAbsoluteTolerance))),
+		),
+		r.sessions.solveODEHandler,
+		cat,
+	)
+
+	// Find root
+	r.addTool(
+		mcp.NewTool("find_root",
+			mcp.WithDescription("Find a root of an expression, or a solution of an equation such as x * exp(x) = 5, in one variable. "+
+				"Methods: brent (default with a bracket), Brent's method, and bisection, on a bracket [lower, upper] where the expression changes sign; "+
+				"newton (default with a guess), Newton's method with a numerical derivative, and secant, from a starting guess. "+
+				"Reports the root, the residual, the iterations and whether the tolerance was met"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to find a zero of, or an equation, e.g. \"x * exp(x) = 5\"")),
+			mcp.WithString("variable", mcp.Description("Variable to solve for (default x)")),
+			mcp.WithString("method", mcp.Enum("brent", "bisection", "newton", "secant"), mcp.Description("Method (default brent if lower and upper are given, otherwise newton)")),
+			pointParam("lower", "Lower end of the bracket for brent and bisection"),
+			pointParam("upper", "Upper end of the bracket for brent and bisection"),
+			pointParam("guess", "Starting guess for newton and secant"),
+			pointParam("second_guess", "Second starting guess for secant (default slightly above guess)"),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Absolute accuracy of the root (default %g); the rounding level of the root is always accepted", defaultRootTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit, 1 to %d (default %d)", maxRootIterations, defaultRootIterations))),
+		),
+		r.sessions.findRootHandler,
+		cat,
+	)
+
+	// Solve system
+	r.addTool(
+		mcp.NewTool("solve_system",
+			mcp.WithDescription("Solve a system of nonlinear equations, as many as variables, by Newton's method from a starting guess, with a numerical Jacobian and steps halved until they reduce the residual. "+
+				"Reports the solution, the residuals, the iterations and whether the tolerance was met"),
+			mcp.WithArray("equations", mcp.Required(), mcp.Description("Expressions equal to zero, or equations, e.g. [\"x^2 + y^2 = 4\", \"y = exp(x)\"]"), mcp.WithStringItems()),
+			mcp.WithArray("variables", mcp.Required(), mcp.Description("Variables to solve for, e.g. [\"x\", \"y\"]"), mcp.WithStringItems()),
+			mcp.WithArray("guess", mcp.Required(), mcp.Description("Starting values of the variables, in the same order"), mcp.WithNumberItems()),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Absolute accuracy of each variable (default %g); the rounding level is always accepted", defaultRootTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit, 1 to %d (default %d)", maxRootIterations, defaultRootIterations))),
+		),
+		r.sessions.solveSystemHandler,
+		cat,
+	)
+}
+
+// variablesParam declares the variables of a multivariate expression.
+func variablesParam() mcp.ToolOption {
+	return mcp.WithArray("variables", mcp.Required(), mcp.Description("Variable names, e.g. [\"x\", \"y\"]"), mcp.WithStringItems())
+}
+
+// atParam declares the point of a multivariate expression.
+func atParam() mcp.ToolOption {
+	return mcp.WithArray("at", mcp.Required(), mcp.Description("Values of the variables, in the same order"), mcp.WithNumberItems())
+}
+
+// pointArgs reads the variables and the point given by the argument name.
+func pointArgs(req mcp.CallToolRequest, name string) ([]string, []float64, error) {
+	variables, err := req.RequireStringSlice("variables")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(variables) == 0 || len(variables) > maxCalculusVariables {
+		return nil, nil, fmt.Errorf("variables must list between 1 and %d names", maxCalculusVariables)
+	}
+	at, err := req.RequireFloatSlice(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(at) != len(variables) {
+		return nil, nil, fmt.Errorf("%s has %d values but there are %d variables", name, len(at), len(variables))
+	}
+	if !allFinite(at) {
+		return nil, nil, fmt.Errorf("%s must be finite", name)
+	}
+	return variables, at, nil
+}
+
+// compileFunction compiles expression as a function of variables in the
+// session's environment.
+func (s *sessionStore) compileFunction(ctx context.Context, expression string, variables []string) (calculus.MultiFunc, error) {
+	f, err := expr.Compile(expression, variables, s.expressionEnv(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return func(x []float64) (float64, error) { return f.Eval(x...) }, nil
+}
+
+// boundSchema accepts a number or a string for an integration bound.
+var boundSchema = map[string]any{
+	"anyOf": []any{
+		map[string]any{"type": "number"},
+		map[string]any{"type": "string"},
+	},
+}
+
+// boundParam declares an integration bound.
+func boundParam(name, description string) mcp.ToolOption {
+	return mcp.WithAny(name,
+		mcp.Required(),
+		mcp.Description(description+`: a number, "inf" or "-inf", or an expression such as "pi/2"`),
+		func(schema map[string]any) { maps.Copy(schema, boundSchema) },
+	)
+}
+
+// boundsParam declares the bounds of a region, one per variable.
+func boundsParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name,
+		mcp.Required(),
+		mcp.Description(description+`, one per variable in the same order: numbers, "inf" or "-inf", or expressions such as "pi/2"`),
+		mcp.Items(boundSchema),
+	)
+}
+
+// boundValue reads an integration bound given as a number, an infinity or
+// an expression in the session's environment.
+func (s *sessionStore) boundValue(ctx context.Context, name string, raw any) (float64, error) {
+	switch v := raw.(type) {
+	case float64:
+		if math.IsNaN(v) {
+			return 0, fmt.Errorf("%s must not be NaN", name)
+		}
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "inf", "+inf", "infinity", "+infinity", "∞", "+∞":
+			return math.Inf(1), nil
+		case "-inf", "-infinity", "-∞":
+			return math.Inf(-1), nil
+		}
+		f, err := expr.Compile(v, nil, s.expressionEnv(ctx))
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+		b, err := f.Eval()
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+		if math.IsNaN(b) {
+			return 0, fmt.Errorf("%s must not be NaN", name)
+		}
+		return b, nil
+	}
+	return 0, fmt.Errorf("%s must be a number or a string", name)
+}
+
+// toleranceArgs reads the relative and absolute tolerances of an
+// integration, with the given defaults.
+func toleranceArgs(req mcp.CallToolRequest, relative, absolute float64) (float64, float64, error) {
+	rel := req.GetFloat("tolerance", relative)
+	if !(rel >= 0) || math.IsInf(rel, 0) {
+		return 0, 0, errors.New("tolerance must be a non-negative number")
+	}
+	abs := req.GetFloat("absolute_tolerance", absolute)
+	if !(abs >= 0) || math.IsInf(abs, 0) {
+		return 0, 0, errors.New("absolute_tolerance must be a non-negative number")
+	}
+	return rel, abs, nil
+}
+
+// integrationError converts an error from an integration into a message,
+// naming the variables at a singular point.
+func integrationError(err error, variables []string) string {
+	var singular *calculus.SingularityError
+	if !errors.As(err, &singular) {
+		return err.Error()
+	}
+	coords := make([]string, len(singular.Point))
+	for i, x := range singular.Point {
+		coords[i] = fmt.Sprintf("%s = %g", variables[i], x)
+	}
+	return fmt.Sprintf("the integrand is not finite at %s; split the integral there", strings.Join(coords, ", "))
+}
+
+// integralResult formats an integral with its error estimate and evaluation
+// count, warning when the requested accuracy was not reached.
+func integralResult(ctx context.Context, integral calculus.Integral, err error, inputs ...float64) *mcp.CallToolResult {
+	if err := checkIEEE(ctx, integral.Value, inputs...); err != nil {
+		return ieeeErrorResult(err)
+	}
+	recordResult(ctx, integral.Value)
+	text := fmt.Sprintf("%g\nestimated error: %.2g\nevaluations: %d", integral.Value, integral.Error, integral.Evaluations)
+	converged := !errors.Is(err, calculus.ErrNoConvergence)
+	if !converged {
+		text += "\nwarning: the requested accuracy was not reached, so the value may be inaccurate; the integrand may be singular, discontinuous or strongly oscillating"
+	}
+	result := mcp.NewToolResultText(text)
+	if allFinite([]float64{integral.Value, integral.Error}) {
+		result.StructuredContent = map[string]any{
+			"value":          integral.Value,
+			"error_estimate": integral.Error,
+			"evaluations":    integral.Evaluations,
+			"converged":      converged,
+		}
+	}
+	return result
+}
+
+// calculusError converts an error from the calculus package into a message.
+func calculusError(err error) string {
+	if errors.Is(err, calculus.ErrNotFinite) {
+		return "the expression is not finite near the point at any step size"
+	}
+	return err.Error()
+}
+
+// maxError returns the largest error estimate of a matrix of estimates.
+func maxError(estimates [][]calculus.Estimate) float64 {
+	var e float64
+	for _, row := range estimates {
+		for _, est := range row {
+			e = math.Max(e, est.Error)
+		}
+	}
+	return e
+}
+
+// estimatesResult formats a matrix of estimates under key, with the
+// largest error estimate in the text and all of them as structured content.
+func estimatesResult(ctx context.Context, key string, estimates [][]calculus.Estimate, inputs ...float64) *mcp.CallToolResult {
+	m := linalg.New(len(estimates), len(estimates[0]))
+	errs := linalg.New(m.Rows, m.Cols)
+	for i, row := range estimates {
+		for j, est := range row {
+			if err := checkIEEE(ctx, est.Value, inputs...); err != nil {
+				return ieeeErrorResult(err)
+			}
+			m.Set(i, j, est.Value)
+			errs.Set(i, j, est.Error)
+		}
+	}
+	result := mcp.NewToolResultText(fmt.Sprintf("%s\nestimated error: %.2g", formatMatrix(m), maxError(estimates)))
+	if allFinite(m.Data) && allFinite(errs.Data) {
+		result.StructuredContent = map[string]any{key: m.ToRows(), "error_estimates": errs.ToRows()}
+	}
+	return result
+}
+
+func (s *sessionStore) derivativeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variable := req.GetString("variable", "x")
+	at, err := req.RequireFloat("at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite([]float64{at}) {
+		return mcp.NewToolResultError("at must be finite"), nil
+	}
+	order := req.GetInt("order", 1)
+	if order < 1 || order > calculus.MaxOrder {
+		return mcp.NewToolResultError(fmt.Sprintf("order must be between 1 and %d", calculus.MaxOrder)), nil
+	}
+	step := req.GetFloat("step", 0)
+	if !(step >= 0) || math.IsInf(step, 0) {
+		return mcp.NewToolResultError("step must be a positive number"), nil
+	}
+
+	f, err := s.compileFunction(ctx, expression, []string{variable})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// The differences only sample around the point, so check the point itself
+	fx, err := f([]float64{at})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite([]float64{fx}) {
+		return mcp.NewToolResultError(fmt.Sprintf("the expression is not finite at %s = %g, so it has no derivative there", variable, at)), nil
+	}
+	g := func(x float64) (float64, error) { return f([]float64{x}) }
+	d, err := calculus.Derivative(g, at, order, step)
+	if err != nil {
+		return mcp.NewToolResultError(calculusError(err)), nil
+	}
+	if err := checkIEEE(ctx, d.Value, at); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	recordResult(ctx, d.Value)
+	text := fmt.Sprintf("%g\nestimated error: %.2g", d.Value, d.Error)
+	// An error estimate of a tenth of the value means the differences did
+	// not settle. Below √ε·|f| it is rounding noise, as for a zero derivative.
+	noise := math.Sqrt(epsilon) * max(1, math.Abs(fx))
+	if d.Error > 0.1*math.Abs(d.Value) && d.Error > noise {
+		text += "\nwarning: the error estimate is comparable to the value, so the derivative may be inaccurate; the expression may be oscillating rapidly or not smooth at the point"
+	}
+	// Central differences average a kink away, as for abs(x) at 0, so the
+	// one-sided derivatives must agree to within ten times the estimated
+	// errors. Points on one side may be outside the domain, and then there
+	// is nothing to compare.
+	forward, errF := calculus.OneSidedDerivative(g, at, order, step, true)
+	backward, errB := calculus.OneSidedDerivative(g, at, order, step, false)
+	if errF == nil && errB == nil {
+		gap := math.Abs(forward.Value - backward.Value)
+		if gap > 10*(forward.Error+backward.Error+d.Error) && gap > noise {
+			text += fmt.Sprintf("\nwarning: the derivatives from the right (%g) and from the left (%g) differ, so the expression is not differentiable at the point, or not smooth near it on the scale of the step", forward.Value, backward.Value)
+		}
+	}
+	result := mcp.NewToolResultText(text)
+	if allFinite([]float64{d.Value, d.Error}) {
+		result.StructuredContent = map[string]any{"value": d.Value, "error_estimate": d.Error}
+	}
+	return result, nil
+}
+
+func (s *sessionStore) gradientHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, at, err := pointArgs(req, "at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	f, err := s.compileFunction(ctx, expression, variables)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	grad, err := calculus.Gradient(f, at, 0)
+	if err != nil {
+		return mcp.NewToolResultError(calculusError(err)), nil
+	}
+
+	values := make([]float64, len(grad))
+	errs := make([]float64, len(grad))
+	for i, est := range grad {
+		if err := checkIEEE(ctx, est.Value, at...); err != nil {
+			return ieeeErrorResult(err), nil
+		}
+		values[i], errs[i] = est.Value, est.Error
+	}
+	result := mcp.NewToolResultText(fmt.Sprintf("%s\nestimated error: %.2g", formatFloats(values), maxError([][]calculus.Estimate{grad})))
+	if allFinite(values) && allFinite(errs) {
+		result.StructuredContent = map[string]any{"gradient": values, "error_estimates": errs}
+	}
+	return result, nil
+}
+
+func (s *sessionStore) jacobianHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expressions, err := req.RequireStringSlice("expressions")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(expressions) == 0 || len(expressions) > maxCalculusVariables {
+		return mcp.NewToolResultError(fmt.Sprintf("expressions must list between 1 and %d expressions", maxCalculusVariables)), nil
+	}
+	variables, at, err := pointArgs(req, "at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	jac := make([][]calculus.Estimate, len(expressions))
+	for i, expression := range expressions {
+		f, err := s.compileFunction(ctx, expression, variables)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("expression %d: %v", i+1, err)), nil
+		}
+		jac[i], err = calculus.Gradient(f, at, 0)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("expression %d: %s", i+1, calculusError(err))), nil
+		}
+	}
+	return estimatesResult(ctx, "jacobian", jac, at...), nil
+}
+
+func (s *sessionStore) hessianHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, at, err := pointArgs(req, "at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	f, err := s.compileFunction(ctx, expression, variables)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	hess, err := calculus.Hessian(f, at, 0)
+	if err != nil {
+		return mcp.NewToolResultError(calculusError(err)), nil
+	}
+	return estimatesResult(ctx, "hessian", hess, at...), nil
+}
+
+func (s *sessionStore) integrateHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variable := req.GetString("variable", "x")
+	args := req.GetArguments()
+	bounds := make([]float64, 2)
+	for i, name := range []string{"lower", "upper"} {
+		raw, ok := args[name]
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("required argument %q not found", name)), nil
+		}
+		if bounds[i], err = s.boundValue(ctx, name, raw); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+	rel, abs, err := toleranceArgs(req, 1e-10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	f, err := s.compileFunction(ctx, expression, []string{variable})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	integral, err := calculus.Integrate(func(x float64) (float64, error) { return f([]float64{x}) }, bounds[0], bounds[1], abs, rel)
+	if err != nil && !errors.Is(err, calculus.ErrNoConvergence) {
+		return mcp.NewToolResultError(integrationError(err, []string{variable})), nil
+	}
+	return integralResult(ctx, integral, err, bounds...), nil
+}
+
+func (s *sessionStore) integrateRegionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, err := req.RequireStringSlice("variables")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(variables) < 2 || len(variables) > calculus.MaxDimensions {
+		return mcp.NewToolResultError(fmt.Sprintf("variables must list 2 to %d names; use integrate for one variable", calculus.MaxDimensions)), nil
+	}
+	args := req.GetArguments()
+	var lower, upper []float64
+	for _, bound := range []struct {
+		name string
+		dst  *[]float64
+	}{{"lower", &lower}, {"upper", &upper}} {
+		raw, ok := args[bound.name].([]any)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("%s must be an array of bounds", bound.name)), nil
+		}
+		if len(raw) != len(variables) {
+			return mcp.NewToolResultError(fmt.Sprintf("%s has %d values but there are %d variables", bound.name, len(raw), len(variables))), nil
+		}
+		for i, item := range raw {
+			b, err := s.boundValue(ctx, fmt.Sprintf("%s[%d]", bound.name, i), item)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			*bound.dst = append(*bound.dst, b)
+		}
+	}
+	rel, abs, err := toleranceArgs(req, 1e-6, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	f, err := s.compileFunction(ctx, expression, variables)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	integral, err := calculus.IntegrateBox(f, lower, upper, abs, rel)
+	if err != nil && !errors.Is(err, calculus.ErrNoConvergence) {
+		return mcp.NewToolResultError(integrationError(err, variables)), nil
+	}
+	return integralResult(ctx, integral, err, concatFloats(lower, upper)...), nil
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivativeTool(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		want float64
+		tol  float64
+	}{
+		{"default variable", map[string]any{"expression": "sin(x)", "at": 1.0}, math.Cos(1), 1e-12},
+		{"named variable", map[string]any{"expression": "t^3", "variable": "t", "at": 2.0}, 12, 1e-10},
+		{"second order", map[string]any{"expression": "exp(2x)", "at": 0.0, "order": 2.0}, 4, 1e-9},
+		{"special function", map[string]any{"expression": "erf(x)", "at": 0.0}, 2 / math.Sqrt(math.Pi), 1e-12},
+		{"gamma", map[string]any{"expression": "gamma(x)", "at": 1.0}, -0.5772156649015329, 1e-10},
+		{"step", map[string]any{"expression": "sin(100 x)", "at": 0.0, "step": 0.001}, 100, 1e-8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "derivative", tt.args)
+			require.False(t, result.IsError, resultText(result))
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.want, content["value"], tt.tol)
+			assert.Less(t, content["error_estimate"], 1e-6)
+			assert.Contains(t, resultText(result), "\nestimated error: ")
+			assert.NotContains(t, resultText(result), "warning")
+		})
+	}
+}
+
+func TestDerivativeWarnings(t *testing.T) {
+	r := NewRegistry()
+
+	// Too fast an oscillation for the default step
+	result := callTool(t, r, sessionContext("s1"), "derivative", map[string]any{"expression": "sin(1000x)", "at": 0.0})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "\nwarning: the error estimate is comparable to the value")
+
+	// A zero derivative has a tiny error estimate that is not warned about
+	result = callTool(t, r, sessionContext("s1"), "derivative", map[string]any{"expression": "x^2", "at": 0.0})
+	require.False(t, result.IsError, resultText(result))
+	assert.NotContains(t, resultText(result), "warning")
+
+	// A kink has a confident central difference of 0 but different one-sided
+	// derivatives
+	result = callTool(t, r, sessionContext("s1"), "derivative", map[string]any{"expression": "abs(x)", "at": 0.0})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "\nwarning: the derivatives from the right (1) and from the left (-1) differ")
+
+	result = callTool(t, r, sessionContext("s1"), "derivative", map[string]any{"expression": "x abs(x)", "at": 0.0, "order": 2.0})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "\nwarning: the derivatives from the right (2) and from the left (-2) differ")
+}
+
+func TestDerivativeSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "k", "value": 3.0})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"u"}, "body": "u^2"})
+	result := callTool(t, r, ctx, "derivative", map[string]any{"expression": "k * f(x)", "at": "$k"})
+	require.False(t, result.IsError, resultText(result))
+	assert.InDelta(t, 18, result.StructuredContent.(map[string]any)["value"], 1e-9)
+
+	// The derivative becomes the latest answer
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	require.False(t, result.IsError, resultText(result))
+	ans, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 18, ans, 1e-9)
+}
+
+func TestGradientTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "gradient", map[string]any{
+		"expression": "x^2 * y + sin(z)",
+		"variables":  []any{"x", "y", "z"},
+		"at":         []any{1.0, 2.0, 0.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+
+	content := result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{4, 1, 1}, content["gradient"], 1e-10)
+	assert.Len(t, content["error_estimates"], 3)
+}
+
+func TestJacobianTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "jacobian", map[string]any{
+		"expressions": []any{"x * y", "x + 3y", "exp(x)"},
+		"variables":   []any{"x", "y"},
+		"at":          []any{0.0, 2.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+
+	rows := result.StructuredContent.(map[string]any)["jacobian"].([][]float64)
+	want := [][]float64{{2, 0}, {1, 3}, {1, 0}}
+	require.Len(t, rows, len(want))
+	for i := range want {
+		assert.InDeltaSlice(t, want[i], rows[i], 1e-10, "row %d", i)
+	}
+}
+
+func TestHessianTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "hessian", map[string]any{
+		"expression": "(1 - x)^2 + 100 (y - x^2)^2",
+		"variables":  []any{"x", "y"},
+		"at":         []any{1.0, 1.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+
+	rows := result.StructuredContent.(map[string]any)["hessian"].([][]float64)
+	assert.InDeltaSlice(t, []float64{802, -400}, rows[0], 1e-7)
+	assert.InDeltaSlice(t, []float64{-400, 200}, rows[1], 1e-7)
+	assert.True(t, strings.HasPrefix(resultText(result), "[[802"), resultText(result))
+}
+
+func TestIntegrateTool(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		want float64
+	}{
+		{"polynomial", map[string]any{"expression": "3x^2", "lower": 0.0, "upper": 2.0}, 8},
+		{"expression bound", map[string]any{"expression": "sin(x)", "lower": 0.0, "upper": "pi"}, 2},
+		{"infinite", map[string]any{"expression": "exp(-x^2)", "lower": "-inf", "upper": "inf"}, math.Sqrt(math.Pi)},
+		{"probability", map[string]any{"expression": "exp(-t^2 / 2) / sqrt(2 pi)", "variable": "t", "lower": "-infinity", "upper": 1.0}, 0.8413447460685429},
+		{"endpoint singularity", map[string]any{"expression": "1 / sqrt(x)", "lower": 0.0, "upper": 4.0}, 4},
+		{"reversed", map[string]any{"expression": "x", "lower": 1.0, "upper": 0.0}, -0.5},
+		{"zero", map[string]any{"expression": "sin(x)", "lower": -1.0, "upper": 1.0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "integrate", tt.args)
+			require.False(t, result.IsError, resultText(result))
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.want, content["value"], 1e-9)
+			assert.Less(t, content["error_estimate"], 1e-8)
+			assert.Positive(t, content["evaluations"])
+			assert.Equal(t, true, content["converged"])
+			assert.Contains(t, resultText(result), "\nestimated error: ")
+			assert.NotContains(t, resultText(result), "warning")
+		})
+	}
+}
+
+func TestIntegrateSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "b", "value": 2.0})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"u"}, "body": "u^3"})
+	result := callTool(t, r, ctx, "integrate", map[string]any{"expression": "f(x)", "lower": 0.0, "upper": "$b"})
+	require.False(t, result.IsError, resultText(result))
+	assert.InDelta(t, 4, result.StructuredContent.(map[string]any)["value"], 1e-12)
+
+	result = callTool(t, r, ctx, "integrate", map[string]any{"expression": "x", "lower": "b - 1", "upper": "2b"})
+	require.False(t, result.IsError, resultText(result))
+	assert.InDelta(t, 7.5, result.StructuredContent.(map[string]any)["value"], 1e-12)
+
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	require.False(t, result.IsError, resultText(result))
+	ans, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 7.5, ans, 1e-12)
+}
+
+func TestIntegrateNotConverged(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "integrate", map[string]any{"expression": "1/x", "lower": 0.0, "upper": 1.0})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "warning: the requested accuracy was not reached")
+	assert.Equal(t, false, result.StructuredContent.(map[string]any)["converged"])
+}
+
+func TestIntegrateRegionTool(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		want float64
+	}{
+		{"rectangle", map[string]any{"expression": "x * y^2", "variables": []any{"x", "y"}, "lower": []any{0.0, 0.0}, "upper": []any{2.0, 3.0}}, 18},
+		{"plane", map[string]any{"expression": "exp(-x^2 - y^2)", "variables": []any{"x", "y"}, "lower": []any{"-inf", "-inf"}, "upper": []any{"inf", "inf"}}, math.Pi},
+		{"box", map[string]any{"expression": "x + y z", "variables": []any{"x", "y", "z"}, "lower": []any{0.0, 0.0, 0.0}, "upper": []any{1.0, 1.0, "pi/pi"}}, 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "integrate_region", tt.args)
+			require.False(t, result.IsError, resultText(result))
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.want, content["value"], 1e-5*tt.want)
+			assert.Equal(t, true, content["converged"])
+		})
+	}
+}
+
+func TestCalculusErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"unknown variable", "derivative", map[string]any{"expression": "x + y", "at": 1.0}, `unknown variable "y"`},
+		{"unknown function", "derivative", map[string]any{"expression": "foo(x)", "at": 1.0}, `unknown function "foo"`},
+		{"parse error", "derivative", map[string]any{"expression": "x +", "at": 1.0}, "unexpected end of expression"},
+		{"order", "derivative", map[string]any{"expression": "x", "at": 1.0, "order": 11.0}, "order must be between 1 and 10"},
+		{"step", "derivative", map[string]any{"expression": "x", "at": 1.0, "step": -1.0}, "step must be a positive number"},
+		{"not finite", "derivative", map[string]any{"expression": "sqrt(-x^2)", "at": 0.0}, "the expression is not finite near the point at any step size"},
+		{"not finite at the point", "derivative", map[string]any{"expression": "1/x", "at": 0.0}, "the expression is not finite at x = 0, so it has no derivative there"},
+		{"point length", "gradient", map[string]any{"expression": "x * y", "variables": []any{"x", "y"}, "at": []any{1.0}}, "at has 1 values but there are 2 variables"},
+		{"no variables", "hessian", map[string]any{"expression": "1", "variables": []any{}, "at": []any{}}, "variables must list between 1 and 50 names"},
+		{"duplicate variable", "gradient", map[string]any{"expression": "x", "variables": []any{"x", "x"}, "at": []any{1.0, 2.0}}, `duplicate parameter "x"`},
+		{"interior singularity", "integrate", map[string]any{"expression": "1/x", "lower": -1.0, "upper": 1.0}, "the integrand is not finite at x = 0; split the integral there"},
+		{"bound expression", "integrate", map[string]any{"expression": "x", "lower": "q", "upper": 1.0}, `lower: unknown variable "q"`},
+		{"missing bound", "integrate", map[string]any{"expression": "x", "lower": 0.0}, `required argument "upper" not found`},
+		{"tolerance", "integrate", map[string]any{"expression": "x", "lower": 0.0, "upper": 1.0, "tolerance": -1.0}, "tolerance must be a non-negative number"},
+		{"region variables", "integrate_region", map[string]any{"expression": "x", "variables": []any{"x"}, "lower": []any{0.0}, "upper": []any{1.0}}, "variables must list 2 to 3 names; use integrate for one variable"},
+		{"region bounds", "integrate_region", map[string]any{"expression": "x", "variables": []any{"x", "y"}, "lower": []any{0.0}, "upper": []any{1.0, 1.0}}, "lower has 1 values but there are 2 variables"},
+		{"region singularity", "integrate_region", map[string]any{"expression": "1/(x - y)", "variables": []any{"x", "y"}, "lower": []any{-1.0, -1.0}, "upper": []any{1.0, 1.0}}, "the integrand is not finite at x = 0, y = 0"},
+		{"jacobian expression", "jacobian", map[string]any{"expressions": []any{"x", "x + z"}, "variables": []any{"x"}, "at": []any{1.0}}, `expression 2: unknown variable "z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.want)
+		})
+	}
+}
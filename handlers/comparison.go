@@ -7,7 +7,6 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -74,7 +73,7 @@ func maxHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Max(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func minHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -87,7 +86,7 @@ func minHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Min(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func dimHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -100,7 +99,7 @@ func dimHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Dim(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func copysignHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -113,5 +112,5 @@ func copysignHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToo
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Copysign(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
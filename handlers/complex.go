@@ -8,6 +8,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/cmplx"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -93,6 +94,7 @@ func (r *Registry) registerComplex() {
 			mcp.WithNumber("x_imag", mcp.Required(), mcp.Description("Base imaginary part")),
 			mcp.WithNumber("y_real", mcp.Required(), mcp.Description("Exponent real part")),
 			mcp.WithNumber("y_imag", mcp.Required(), mcp.Description("Exponent imaginary part")),
+			explainOption(),
 		),
 		complexPowHandler,
 		cat,
@@ -180,7 +182,7 @@ func complexAbsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Abs(z)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, real(z), imag(z)), nil
 }
 
 func complexPhaseHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -189,7 +191,7 @@ func complexPhaseHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Phase(z)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, real(z), imag(z)), nil
 }
 
 func complexConjHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -198,7 +200,7 @@ func complexConjHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Call
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Conj(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexExpHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -207,7 +209,7 @@ func complexExpHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Exp(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexLogHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -216,7 +218,10 @@ func complexLogHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Log(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	if err := checkPole(ctx, real(result), z == 0); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return complexResult(ctx, result, z), nil
 }
 
 func complexSqrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -225,7 +230,7 @@ func complexSqrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Call
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Sqrt(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexPowHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -238,7 +243,37 @@ func complexPowHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Pow(x, y)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	if err := checkPole(ctx, real(result), x == 0 && real(y) < 0); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	if wantExplain(req) {
+		return explained(complexResult(ctx, result, x, y), complexPowSteps(x, y, result)), nil
+	}
+	return complexResult(ctx, result, x, y), nil
+}
+
+// complexPowSteps explains x^y through the polar form of x: x^y = e^(y ln x).
+func complexPowSteps(x, y, result complex128) []explainStep {
+	if x == 0 {
+		switch {
+		case y == 0:
+			return []explainStep{stepf("0^0 = 1 by convention")}
+		case real(y) > 0:
+			return []explainStep{stepf("Re(y) = %g > 0, so 0^y = 0", real(y))}
+		default:
+			return []explainStep{stepf("Re(y) = %g ≤ 0, so 0^y is not finite: %s", real(y), formatComplex(result))}
+		}
+	}
+	r, theta := cmplx.Polar(x)
+	logX := complex(math.Log(r), theta)
+	w := y * logX
+	return []explainStep{
+		stepf("Write the base in polar form x = r·e^(iθ): r = |x| = %g, θ = arg(x) = %g", r, theta),
+		stepf("Take the logarithm: ln x = ln r + iθ = %s", formatComplex(logX)),
+		stepf("Multiply by the exponent: y·ln x = (%s)(%s) = %s", formatComplex(y), formatComplex(logX), formatComplex(w)),
+		stepf("Exponentiate: x^y = e^(%g)·(cos(%g) + i·sin(%g)) = %g × (%s) = %s",
+			real(w), imag(w), imag(w), math.Exp(real(w)), formatComplex(cmplx.Rect(1, imag(w))), formatComplex(result)),
+	}
 }
 
 func complexSinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -247,7 +282,7 @@ func complexSinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Sin(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexCosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -256,7 +291,7 @@ func complexCosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Cos(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexTanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -265,7 +300,7 @@ func complexTanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallT
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Tan(z)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, z), nil
 }
 
 func complexPolarHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -274,6 +309,12 @@ func complexPolarHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	r, theta := cmplx.Polar(z)
+	if err := firstError(
+		checkIEEE(ctx, r, real(z), imag(z)),
+		checkIEEE(ctx, theta, real(z), imag(z)),
+	); err != nil {
+		return ieeeErrorResult(err), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("r: %g, theta: %g", r, theta)), nil
 }
 
@@ -287,5 +328,5 @@ func complexRectHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Call
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := cmplx.Rect(r, theta)
-	return mcp.NewToolResultText(formatComplex(result)), nil
+	return complexResult(ctx, result, complex(r, theta)), nil
 }
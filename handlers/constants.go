@@ -22,10 +22,13 @@ const (
 	SqrtPhi = 1.2720196495140689643
 )
 
+// constantsURI is the URI of the constants resource.
+const constantsURI = "math://constants"
+
 // registerConstantsResource registers the mathematical constants resource.
 func registerConstantsResource(s *server.MCPServer) {
 	resource := mcp.NewResource(
-		"math://constants",
+		constantsURI,
 		"Mathematical Constants",
 		mcp.WithResourceDescription("Common mathematical constants"),
 		mcp.WithMIMEType("application/json"),
@@ -67,7 +70,7 @@ func constantsHandler(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.R
 
 	return []mcp.ResourceContents{
 		mcp.TextResourceContents{
-			URI:      "math://constants",
+			URI:      constantsURI,
 			MIMEType: "application/json",
 			Text:     constants,
 		},
@@ -7,7 +7,6 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -46,7 +45,7 @@ func degreesToRadiansHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := degrees * math.Pi / 180
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, degrees), nil
 }
 
 func radiansToDegreesHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -55,5 +54,5 @@ func radiansToDegreesHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := radians * 180 / math.Pi
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, radians), nil
 }
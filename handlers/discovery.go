@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultSearchLimit is the number of matches search_tools returns by default.
+	defaultSearchLimit = 10
+	// maxSearchLimit caps the limit argument of search_tools.
+	maxSearchLimit = 50
+)
+
+type configKey struct{}
+
+// withConfig returns a context carrying the configuration a tool is served under.
+func withConfig(ctx context.Context, cfg *config.Config) context.Context {
+	return context.WithValue(ctx, configKey{}, cfg)
+}
+
+// configFromContext returns the configuration in ctx. Outside a server every
+// category is enabled.
+func configFromContext(ctx context.Context) *config.Config {
+	if cfg, ok := ctx.Value(configKey{}).(*config.Config); ok && cfg != nil {
+		return cfg
+	}
+	cfg := &config.Config{Categories: make(map[config.Category]bool)}
+	for _, cat := range config.AllCategories() {
+		cfg.Categories[cat] = true
+	}
+	return cfg
+}
+
+// registerDiscovery registers the tool search and invocation tools.
+func (r *Registry) registerDiscovery() {
+	cat := config.CategoryDiscovery
+
+	// SearchTools
+	r.addTool(
+		mcp.NewTool("search_tools",
+			mcp.WithDescription("Find tools by keyword, e.g. \"standard deviation\" or \"complex log\". Returns the best matches with their arguments"),
+			mcp.WithString("query", mcp.Description("Keywords matched against tool names, descriptions, categories and arguments")),
+			mcp.WithString("category", mcp.Description("Only return tools in this category")),
+			mcp.WithNumber("limit", mcp.Description(fmt.Sprintf("Maximum number of results (default %d, max %d)", defaultSearchLimit, maxSearchLimit))),
+		),
+		r.searchToolsHandler,
+		cat,
+	)
+
+	// InvokeTool
+	r.addTool(
+		mcp.NewTool("invoke_tool",
+			mcp.WithDescription("Call any enabled tool by name, including tools found with search_tools that are not listed directly"),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Tool name")),
+			mcp.WithObject("arguments", mcp.Description("Arguments of the tool")),
+		),
+		r.invokeToolHandler,
+		cat,
+	)
+}
+
+// isToolServed reports whether the server lists td under cfg. In lazy mode
+// only the discovery tools are listed and the others are reached through
+// invoke_tool.
+func isToolServed(td ToolDefinition, cfg *config.Config) bool {
+	if cfg.ToolMode == config.ToolModeLazy {
+		return td.Category == config.CategoryDiscovery
+	}
+	return isToolEnabled(td, cfg)
+}
+
+// discoverable returns the tools that search_tools and invoke_tool expose
+// under cfg: every enabled tool except the discovery tools themselves.
+func (r *Registry) discoverable(cfg *config.Config) []ToolDefinition {
+	var tools []ToolDefinition
+	for _, td := range r.tools {
+		if td.Category != config.CategoryDiscovery && isToolEnabled(td, cfg) {
+			tools = append(tools, td)
+		}
+	}
+	return tools
+}
+
+// searchTerms splits a query into lowercase words.
+func searchTerms(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// searchScore ranks td against the query terms. Whole-word matches in the
+// name weigh most, then the category, description and argument names.
+func searchScore(td ToolDefinition, terms []string) int {
+	name := strings.ToLower(td.Tool.Name)
+	if strings.Join(terms, "_") == name {
+		return 100
+	}
+	nameWords := searchTerms(name)
+	categoryWords := searchTerms(string(td.Category))
+	descWords := searchTerms(td.Tool.Description)
+
+	score := 0
+	for _, term := range terms {
+		switch {
+		case containsWord(nameWords, term):
+			score += 8
+		case strings.Contains(name, term):
+			score += 4
+		}
+		if containsWord(categoryWords, term) {
+			score += 5
+		}
+		switch {
+		case containsWord(descWords, term):
+			score += 3
+		case len(term) >= 3 && hasWordPrefix(descWords, term):
+			score++
+		}
+		if _, ok := td.Tool.InputSchema.Properties[term]; ok && term != ieeePolicyParam {
+			score += 2
+		}
+	}
+	return score
+}
+
+func containsWord(words []string, term string) bool {
+	for _, w := range words {
+		if w == term {
+			return true
+		}
+	}
+	return false
+}
+
+func hasWordPrefix(words []string, prefix string) bool {
+	for _, w := range words {
+		if strings.HasPrefix(w, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeArguments lists a tool's arguments as "name (type, required)",
+// required ones first. The ieee_policy option shared by every tool is omitted.
+func describeArguments(tool mcp.Tool) string {
+	required := make(map[string]bool)
+	for _, name := range tool.InputSchema.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(tool.InputSchema.Properties))
+	for name := range tool.InputSchema.Properties {
+		if name != ieeePolicyParam {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if required[names[i]] != required[names[j]] {
+			return required[names[i]]
+		}
+		return names[i] < names[j]
+	})
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		typ := "any"
+		if schema, ok := tool.InputSchema.Properties[name].(map[string]any); ok {
+			if t, ok := schema["type"].(string); ok {
+				typ = t
+			}
+			if typ == "array" {
+				if items, ok := schema["items"].(map[string]any); ok {
+					if it, ok := items["type"].(string); ok {
+						typ = it + "[]"
+					}
+				}
+			}
+		}
+		if required[name] {
+			parts[i] = fmt.Sprintf("%s (%s, required)", name, typ)
+		} else {
+			parts[i] = fmt.Sprintf("%s (%s)", name, typ)
+		}
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (r *Registry) searchToolsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	terms := searchTerms(req.GetString("query", ""))
+	category := config.Category(strings.TrimSpace(strings.ToLower(req.GetString("category", ""))))
+	limit := int(req.GetFloat("limit", defaultSearchLimit))
+	if limit < 1 {
+		return mcp.NewToolResultError("limit must be at least 1"), nil
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	if category != "" {
+		valid := false
+		for _, c := range config.AllCategories() {
+			valid = valid || c == category
+		}
+		if !valid {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown category %q", category)), nil
+		}
+	}
+	if len(terms) == 0 && category == "" {
+		return mcp.NewToolResultError("provide a query or a category"), nil
+	}
+
+	type match struct {
+		td    ToolDefinition
+		score int
+	}
+	var matches []match
+	for _, td := range r.discoverable(configFromContext(ctx)) {
+		if category != "" && td.Category != category {
+			continue
+		}
+		score := searchScore(td, terms)
+		if len(terms) > 0 && score == 0 {
+			continue
+		}
+		matches = append(matches, match{td, score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].td.Tool.Name < matches[j].td.Tool.Name
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	if len(matches) == 0 {
+		return mcp.NewToolResultText("no matching tools"), nil
+	}
+
+	lines := make([]string, 0, 2*len(matches))
+	tools := make([]map[string]any, len(matches))
+	for i, m := range matches {
+		lines = append(lines,
+			fmt.Sprintf("%s [%s]: %s", m.td.Tool.Name, m.td.Category, m.td.Tool.Description),
+			"  arguments: "+describeArguments(m.td.Tool))
+		tools[i] = map[string]any{
+			"name":        m.td.Tool.Name,
+			"category":    m.td.Category,
+			"description": m.td.Tool.Description,
+			"inputSchema": m.td.Tool.InputSchema,
+		}
+	}
+	return mcp.NewToolResultStructured(map[string]any{"tools": tools}, strings.Join(lines, "\n")), nil
+}
+
+func (r *Registry) invokeToolHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	cfg := configFromContext(ctx)
+	var target *ToolDefinition
+	for _, td := range r.discoverable(cfg) {
+		if td.Tool.Name == name {
+			target = &td
+			break
+		}
+	}
+	if target == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown tool %q: use search_tools to find tools", name)), nil
+	}
+
+	args := map[string]any{}
+	switch v := req.GetArguments()["arguments"].(type) {
+	case nil:
+	case map[string]any:
+		for k, val := range v {
+			args[k] = val
+		}
+	default:
+		return mcp.NewToolResultError("arguments must be an object"), nil
+	}
+	// An ieee_policy given to invoke_tool applies to the invoked tool
+	if policy, ok := req.GetArguments()[ieeePolicyParam]; ok {
+		if _, set := args[ieeePolicyParam]; !set {
+			args[ieeePolicyParam] = policy
+		}
+	}
+
+	inner := mcp.CallToolRequest{}
+	inner.Params.Name = name
+	inner.Params.Arguments = args
+	return r.wrap(*target, cfg)(ctx, inner)
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func allCategoriesConfig() *config.Config {
+	cfg := &config.Config{Categories: make(map[config.Category]bool)}
+	for _, cat := range config.AllCategories() {
+		cfg.Categories[cat] = true
+	}
+	return cfg
+}
+
+func TestSearchToolsRanking(t *testing.T) {
+	tests := []struct {
+		query string
+		first string
+	}{
+		{"std_dev", "std_dev"},
+		{"standard deviation", "std_dev"},
+		{"complex log", "complex_log"},
+		{"hypot", "hypot"},
+		{"factorial", "factorial"},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			result := callTool(t, r, context.Background(), "search_tools", map[string]any{"query": tt.query})
+
+			require.False(t, result.IsError, resultText(result))
+			first := strings.SplitN(resultText(result), " ", 2)[0]
+			assert.Equal(t, tt.first, first)
+		})
+	}
+}
+
+func TestSearchToolsOutput(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, context.Background(), "search_tools", map[string]any{"query": "hypot", "limit": 1.0})
+
+	require.False(t, result.IsError)
+	assert.Equal(t, "hypot [power]: sqrt(x^2 + y^2) without overflow\n  arguments: x (number, required), y (number, required)", resultText(result))
+	tools := result.StructuredContent.(map[string]any)["tools"].([]map[string]any)
+	assert.Len(t, tools, 1)
+}
+
+func TestSearchToolsCategoryFilter(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, context.Background(), "search_tools", map[string]any{"category": "bitwise", "limit": 50.0})
+
+	require.False(t, result.IsError)
+	for _, line := range strings.Split(resultText(result), "\n") {
+		if !strings.HasPrefix(line, " ") {
+			assert.Contains(t, line, "[bitwise]")
+		}
+	}
+}
+
+func TestSearchToolsOnlyEnabledTools(t *testing.T) {
+	r := NewRegistry()
+	cfg := &config.Config{Categories: map[config.Category]bool{config.CategoryArithmetic: true}}
+
+	result := callToolWithConfig(t, r, context.Background(), cfg, "search_tools", map[string]any{"query": "square root"})
+
+	assert.NotContains(t, resultText(result), "sqrt")
+}
+
+func TestSearchToolsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+	}{
+		{"no query", map[string]any{}},
+		{"unknown category", map[string]any{"category": "alchemy"}},
+		{"bad limit", map[string]any{"query": "add", "limit": 0.0}},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, context.Background(), "search_tools", tt.args)
+
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestInvokeTool(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	result := callTool(t, r, ctx, "invoke_tool", map[string]any{"name": "multiply", "arguments": map[string]any{"a": 6.0, "b": 7.0}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "42", resultText(result))
+
+	// Invoked tools take part in the session like direct calls
+	result = callTool(t, r, ctx, "invoke_tool", map[string]any{"name": "add", "arguments": map[string]any{"a": "$ans", "b": 1.0}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "43", resultText(result))
+}
+
+func TestInvokeToolIEEEPolicy(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, context.Background(), "invoke_tool", map[string]any{
+		"name":        "sqrt",
+		"arguments":   map[string]any{"x": -1.0},
+		"ieee_policy": "strict",
+	})
+
+	assert.True(t, result.IsError)
+}
+
+func TestInvokeToolErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+	}{
+		{"unknown tool", map[string]any{"name": "nope"}},
+		{"discovery tool", map[string]any{"name": "invoke_tool", "arguments": map[string]any{"name": "add"}}},
+		{"disabled tool", map[string]any{"name": "sqrt", "arguments": map[string]any{"x": 4.0}}},
+		{"arguments not an object", map[string]any{"name": "add", "arguments": []any{1.0, 2.0}}},
+	}
+
+	r := NewRegistry()
+	cfg := &config.Config{Categories: map[config.Category]bool{config.CategoryArithmetic: true}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callToolWithConfig(t, r, context.Background(), cfg, "invoke_tool", tt.args)
+
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestLazyModeAdvertisesDiscoveryOnly(t *testing.T) {
+	r := NewRegistry()
+	cfg := allCategoriesConfig()
+	cfg.ToolMode = config.ToolModeLazy
+
+	s := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	r.RegisterTools(s, cfg)
+
+	tools := s.ListTools()
+	assert.Len(t, tools, 2)
+	assert.Contains(t, tools, "search_tools")
+	assert.Contains(t, tools, "invoke_tool")
+
+	// The served invoke_tool still reaches every enabled tool
+	result, err := tools["invoke_tool"].Handler(context.Background(), makeRequest(map[string]any{
+		"name": "sqrt", "arguments": map[string]any{"x": 16.0},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "4", resultText(result))
+}
+
+func TestSyncToolsSwitchesToolMode(t *testing.T) {
+	r := NewRegistry()
+	eager := allCategoriesConfig()
+	lazy := allCategoriesConfig()
+	lazy.ToolMode = config.ToolModeLazy
+
+	s := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	r.RegisterTools(s, eager)
+	all := len(s.ListTools())
+
+	r.SyncTools(s, eager, lazy)
+	assert.Len(t, s.ListTools(), 2)
+
+	r.SyncTools(s, lazy, eager)
+	assert.Len(t, s.ListTools(), all)
+}
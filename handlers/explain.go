@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// explainParam is the optional flag that asks a tool for a worked solution.
+const explainParam = "explain"
+
+// explainOption adds the explain flag to a tool.
+func explainOption() mcp.ToolOption {
+	return mcp.WithBoolean(explainParam,
+		mcp.Description("Also return the intermediate steps, as structured steps and Markdown"))
+}
+
+// wantExplain reports whether the call asked for a worked solution.
+func wantExplain(req mcp.CallToolRequest) bool {
+	return req.GetBool(explainParam, false)
+}
+
+// explainStep is one step of a worked solution.
+type explainStep struct {
+	Text  string     `json:"text"`
+	Table *stepTable `json:"table,omitempty"`
+}
+
+// stepTable is tabular data attached to a step, such as a deviations table.
+type stepTable struct {
+	Columns []string   `json:"columns"`
+	Rows    [][]string `json:"rows"`
+}
+
+func stepf(format string, args ...any) explainStep {
+	return explainStep{Text: fmt.Sprintf(format, args...)}
+}
+
+// explained attaches steps to a successful result: the structured content
+// holds the result and steps, and a Markdown rendering follows the result
+// text. Error results are returned unchanged.
+func explained(result *mcp.CallToolResult, steps []explainStep) *mcp.CallToolResult {
+	if result.IsError || len(result.Content) == 0 {
+		return result
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		return result
+	}
+	result.Content = append(result.Content, mcp.NewTextContent(renderSteps(steps, text.Text)))
+	result.StructuredContent = map[string]any{
+		"result": text.Text,
+		"steps":  steps,
+	}
+	return result
+}
+
+// renderSteps renders a worked solution as Markdown.
+func renderSteps(steps []explainStep, result string) string {
+	var b strings.Builder
+	for i, s := range steps {
+		fmt.Fprintf(&b, "**Step %d.** %s\n\n", i+1, s.Text)
+		if s.Table != nil {
+			b.WriteString("| " + strings.Join(s.Table.Columns, " | ") + " |\n")
+			b.WriteString("|" + strings.Repeat("---|", len(s.Table.Columns)) + "\n")
+			for _, row := range s.Table.Rows {
+				b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(&b, "**Result:** %s", result)
+	return b.String()
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// explainSteps returns the step texts of an explained result.
+func explainSteps(t *testing.T, result *mcp.CallToolResult) []string {
+	t.Helper()
+	require.False(t, result.IsError, result.Content)
+	require.Len(t, result.Content, 2)
+	steps := result.StructuredContent.(map[string]any)["steps"].([]explainStep)
+	texts := make([]string, len(steps))
+	for i, s := range steps {
+		texts[i] = s.Text
+	}
+	return texts
+}
+
+func TestExplainSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler server.ToolHandlerFunc
+		args    map[string]any
+		result  string
+		steps   []string
+	}{
+		{
+			"gcd", gcdHandler, map[string]any{"a": 48.0, "b": -18.0}, "6",
+			[]string{
+				"Signs do not change the divisors: gcd(48, -18) = gcd(48, 18)",
+				"48 = 2 × 18 + 12, so gcd(48, 18) = gcd(18, 12)",
+				"18 = 1 × 12 + 6, so gcd(18, 12) = gcd(12, 6)",
+				"12 = 2 × 6 + 0, so gcd(12, 6) = gcd(6, 0)",
+				"The remainder is 0, so the gcd is the last divisor, 6",
+			},
+		},
+		{
+			"lcm", lcmHandler, map[string]any{"a": 4.0, "b": 6.0}, "12",
+			[]string{
+				"Use lcm(a, b) = |a| / gcd(a, b) × |b|, finding the gcd with Euclid's algorithm",
+				"4 = 0 × 6 + 4, so gcd(4, 6) = gcd(6, 4)",
+				"6 = 1 × 4 + 2, so gcd(6, 4) = gcd(4, 2)",
+				"4 = 2 × 2 + 0, so gcd(4, 2) = gcd(2, 0)",
+				"The remainder is 0, so the gcd is the last divisor, 2",
+				"lcm = 4 / 2 × 6 = 12",
+			},
+		},
+		{
+			"prime factors", primeFactorsHandler, map[string]any{"n": 90.0}, "2 × 3 × 3 × 5",
+			[]string{
+				"90 is divisible by 2: 90 ÷ 2 = 45",
+				"45 is divisible by 3: 45 ÷ 3 = 15",
+				"15 is divisible by 3: 15 ÷ 3 = 5",
+				"5 has no divisor up to its square root, so it is prime",
+				"90 = 2 × 3^2 × 5",
+			},
+		},
+		{
+			"median even", medianHandler, map[string]any{"numbers": []any{7.0, 1.0, 5.0, 3.0}}, "4",
+			[]string{
+				"Sort the values: [1, 3, 5, 7]",
+				"n = 4 is even, so the median is the mean of the values at positions 2 and 3: (3 + 5) / 2 = 4",
+			},
+		},
+		{
+			"median odd", medianHandler, map[string]any{"numbers": []any{9.0, 1.0, 5.0}}, "5",
+			[]string{
+				"Sort the values: [1, 5, 9]",
+				"n = 3 is odd, so the median is the middle value at position 2: 5",
+			},
+		},
+		{
+			"std_dev", stdDevHandler, map[string]any{"numbers": []any{1.0, 3.0}}, "1",
+			[]string{
+				"Mean: μ = 4 / 2 = 2",
+				"Deviations from the mean and their squares",
+				"Sum of squared deviations: 2",
+				"Variance: σ² = 2 / 2 = 1",
+				"Standard deviation: σ = √1 = 1",
+			},
+		},
+		{
+			"atan2 quadrant II", atan2Handler, map[string]any{"y": 1.0, "x": -1.0}, "2.356194490192345",
+			[]string{
+				"x < 0 and y ≥ 0 (quadrant II), so add π: atan(y/x) + π = -0.7853981633974483 + π = 2.356194490192345",
+				"In degrees: 2.356194490192345 × 180/π = 135°",
+			},
+		},
+		{
+			"complex_pow zero base", complexPowHandler, map[string]any{"x_real": 0.0, "x_imag": 0.0, "y_real": 2.0, "y_imag": 0.0}, "0 + 0i",
+			[]string{"Re(y) = 2 > 0, so 0^y = 0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{"explain": true}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+
+			result, err := tt.handler(context.Background(), makeRequest(args))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.result, result.Content[0].(mcp.TextContent).Text)
+			assert.Equal(t, tt.steps, explainSteps(t, result))
+		})
+	}
+}
+
+func TestExplainVarianceTable(t *testing.T) {
+	result, err := varianceHandler(context.Background(), makeRequest(map[string]any{
+		"numbers": []any{2.0, 4.0, 6.0},
+		"explain": true,
+	}))
+	require.NoError(t, err)
+
+	steps := result.StructuredContent.(map[string]any)["steps"].([]explainStep)
+	require.NotNil(t, steps[1].Table)
+	assert.Equal(t, []string{"x", "x − μ", "(x − μ)²"}, steps[1].Table.Columns)
+	assert.Equal(t, [][]string{{"2", "-2", "4"}, {"4", "0", "0"}, {"6", "2", "4"}}, steps[1].Table.Rows)
+
+	markdown := result.Content[1].(mcp.TextContent).Text
+	assert.Contains(t, markdown, "**Step 1.** Mean: μ = 12 / 3 = 4")
+	assert.Contains(t, markdown, "| x | x − μ | (x − μ)² |\n|---|---|---|\n| 2 | -2 | 4 |")
+	assert.Contains(t, markdown, "**Result:** 2.6666666666666665")
+}
+
+func TestExplainComplexPow(t *testing.T) {
+	// i^2 = -1
+	result, err := complexPowHandler(context.Background(), makeRequest(map[string]any{
+		"x_real": 0.0, "x_imag": 1.0, "y_real": 2.0, "y_imag": 0.0, "explain": true,
+	}))
+	require.NoError(t, err)
+
+	steps := explainSteps(t, result)
+	require.Len(t, steps, 4)
+	assert.Equal(t, "Write the base in polar form x = r·e^(iθ): r = |x| = 1, θ = arg(x) = 1.5707963267948966", steps[0])
+	assert.Equal(t, "Take the logarithm: ln x = ln r + iθ = 0 + 1.5707963267948966i", steps[1])
+}
+
+func TestExplainOffByDefault(t *testing.T) {
+	result, err := gcdHandler(context.Background(), makeRequest(map[string]any{"a": 12.0, "b": 8.0}))
+	require.NoError(t, err)
+
+	assert.Len(t, result.Content, 1)
+	assert.Nil(t, result.StructuredContent)
+}
+
+func TestExplainLeavesErrorsUnchanged(t *testing.T) {
+	ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+	result, err := complexPowHandler(ctx, makeRequest(map[string]any{
+		"x_real": 0.0, "x_imag": 0.0, "y_real": -1.0, "y_imag": 0.0, "explain": true,
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Len(t, result.Content, 1)
+
+	result, err = medianHandler(context.Background(), makeRequest(map[string]any{"numbers": []any{}, "explain": true}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Len(t, result.Content, 1)
+}
@@ -121,6 +121,9 @@ func frexpHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	frac, exp := math.Frexp(x)
+	if err := checkIEEE(ctx, frac, x); err != nil {
+		return ieeeErrorResult(err), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("frac: %g, exp: %d", frac, exp)), nil
 }
 
@@ -134,7 +137,10 @@ func ldexpHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Ldexp(frac, exp)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, frac != 0 && !math.IsInf(frac, 0)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, frac), nil
 }
 
 func modfHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -143,6 +149,9 @@ func modfHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	integer, frac := math.Modf(x)
+	if err := firstError(checkIEEE(ctx, integer, x), checkIEEE(ctx, frac, x)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("integer: %g, frac: %g", integer, frac)), nil
 }
 
@@ -152,6 +161,7 @@ func ilogbHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Ilogb(x)
+	recordResult(ctx, float64(result))
 	return mcp.NewToolResultText(fmt.Sprintf("%d", result)), nil
 }
 
@@ -165,7 +175,7 @@ func nextafterHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTo
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Nextafter(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
 
 func fmaHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -182,7 +192,7 @@ func fmaHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.FMA(x, y, z)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y, z), nil
 }
 
 func signbitHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
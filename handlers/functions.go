@@ -0,0 +1,510 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/expr"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxFunctions limits the functions defined per session.
+	maxFunctions = 128
+	// maxCallDepth bounds nested user function calls, including recursion.
+	maxCallDepth = 64
+	// maxFunctionCalls bounds the user function calls of one request, which
+	// definitions that each call the previous one twice make exponential.
+	maxFunctionCalls = 10_000_000
+)
+
+// userFunction is a function defined with define_function.
+type userFunction struct {
+	Name   string   `json:"name"`
+	Params []string `json:"params"`
+	Body   string   `json:"body"`
+
+	node expr.Node
+}
+
+func (f *userFunction) signature() string {
+	return fmt.Sprintf("%s(%s) = %s", f.Name, strings.Join(f.Params, ", "), f.node)
+}
+
+// newUserFunction validates and parses a function definition.
+func newUserFunction(name string, params []string, body string) (*userFunction, error) {
+	if !variableNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid function name %q: use letters, digits and underscores", name)
+	}
+	if expr.IsBuiltin(name) {
+		return nil, fmt.Errorf("function name %q is a built-in function", name)
+	}
+	seen := make(map[string]bool, len(params))
+	for _, p := range params {
+		if !variableNamePattern.MatchString(p) {
+			return nil, fmt.Errorf("invalid parameter name %q", p)
+		}
+		if seen[p] {
+			return nil, fmt.Errorf("duplicate parameter %q", p)
+		}
+		seen[p] = true
+	}
+	node, err := expr.Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return &userFunction{Name: name, Params: params, Body: body, node: node}, nil
+}
+
+// functionLibrary holds functions persisted to a file and shared by all
+// sessions served under configurations naming that file.
+type functionLibrary struct {
+	mu    sync.Mutex
+	path  string
+	funcs map[string]*userFunction
+}
+
+type functionLibraryFile struct {
+	Functions []*userFunction `json:"functions"`
+}
+
+// load reads the library file. A missing file is an empty library.
+func (l *functionLibrary) load() error {
+	data, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var file functionLibraryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("%s: %w", l.path, err)
+	}
+	for _, f := range file.Functions {
+		parsed, err := newUserFunction(f.Name, f.Params, f.Body)
+		if err != nil {
+			return fmt.Errorf("%s: function %s: %w", l.path, f.Name, err)
+		}
+		l.funcs[parsed.Name] = parsed
+	}
+	return nil
+}
+
+// save writes the library file atomically. The caller must hold l.mu.
+func (l *functionLibrary) save() error {
+	file := functionLibraryFile{Functions: make([]*userFunction, 0, len(l.funcs))}
+	for _, f := range l.funcs {
+		file.Functions = append(file.Functions, f)
+	}
+	sort.Slice(file.Functions, func(i, j int) bool { return file.Functions[i].Name < file.Functions[j].Name })
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), ".functions-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.path)
+}
+
+// add stores f and saves the library, leaving it unchanged if saving fails.
+func (l *functionLibrary) add(f *userFunction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previous, existed := l.funcs[f.Name]
+	l.funcs[f.Name] = f
+	if err := l.save(); err != nil {
+		if existed {
+			l.funcs[f.Name] = previous
+		} else {
+			delete(l.funcs, f.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+// remove deletes the named function and saves the library, leaving it
+// unchanged if saving fails. It reports whether the function was stored.
+func (l *functionLibrary) remove(name string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, found := l.funcs[name]
+	if !found {
+		return false, nil
+	}
+	delete(l.funcs, name)
+	if err := l.save(); err != nil {
+		l.funcs[name] = f
+		return false, err
+	}
+	return true, nil
+}
+
+// has reports whether the library stores the named function.
+func (l *functionLibrary) has(name string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, found := l.funcs[name]
+	return found
+}
+
+// reload replaces the functions by those stored in the file, keeping them
+// if it cannot be read. It holds l.mu, so a save in progress finishes first
+// and what it saved is read back.
+func (l *functionLibrary) reload() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fresh := &functionLibrary{path: l.path, funcs: make(map[string]*userFunction)}
+	if err := fresh.load(); err != nil {
+		return err
+	}
+	l.funcs = fresh.funcs
+	return nil
+}
+
+// LoadFunctionLibrary loads the functions stored in path, replacing those
+// of a library previously loaded from it. Tools served under a
+// configuration whose FunctionsFile is path share these functions and
+// persist new ones there; each file is a separate library.
+func (r *Registry) LoadFunctionLibrary(path string) error {
+	r.sessions.mu.Lock()
+	lib, found := r.sessions.libraries[path]
+	if !found {
+		lib = &functionLibrary{path: path, funcs: make(map[string]*userFunction)}
+	}
+	r.sessions.mu.Unlock()
+	if err := lib.reload(); err != nil {
+		return err
+	}
+	if !found {
+		r.sessions.mu.Lock()
+		if _, found := r.sessions.libraries[path]; !found {
+			r.sessions.libraries[path] = lib
+		}
+		r.sessions.mu.Unlock()
+	}
+	return nil
+}
+
+// library returns the function library of the configuration in ctx, or nil
+// if it has none.
+func (s *sessionStore) library(ctx context.Context) *functionLibrary {
+	path := configFromContext(ctx).FunctionsFile
+	if path == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.libraries[path]
+}
+
+// writableLibrary returns the function library clients may change under the
+// configuration in ctx, or an error explaining why there is none.
+func (s *sessionStore) writableLibrary(ctx context.Context) (*functionLibrary, error) {
+	lib := s.library(ctx)
+	if lib == nil {
+		return nil, errors.New("function persistence is not configured (set MATH_FUNCTIONS_FILE)")
+	}
+	if configFromContext(ctx).FunctionsReadOnly {
+		return nil, errors.New("the function library is read-only")
+	}
+	return lib, nil
+}
+
+// registerFunctions registers user-defined function tools.
+func (r *Registry) registerFunctions() {
+	cat := config.CategoryFunctions
+
+	// DefineFunction
+	r.addTool(
+		mcp.NewTool("define_function",
+			mcp.WithDescription("Define a function for this session, e.g. name f, params [x, y], body \"x^2 + sin(y)\". Bodies may use built-in functions, constants (pi, e), session variables and other defined functions"),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Function name")),
+			mcp.WithArray("params", mcp.Required(), mcp.Description("Parameter names"), mcp.WithStringItems()),
+			mcp.WithString("body", mcp.Required(), mcp.Description("Expression using the parameters")),
+			mcp.WithBoolean("persist", mcp.Description("Also save the function to the server's function library, shared by all sessions on this endpoint")),
+		),
+		r.sessions.defineFunctionHandler,
+		cat,
+	)
+
+	// CallFunction
+	r.addTool(
+		mcp.NewTool("call_function",
+			mcp.WithDescription("Evaluate a defined function"),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Function name")),
+			mcp.WithArray("args", mcp.Required(), mcp.Description("Argument values in parameter order"), mcp.WithNumberItems()),
+		),
+		r.sessions.callFunctionHandler,
+		cat,
+	)
+
+	// ListFunctions
+	r.addTool(
+		mcp.NewTool("list_functions",
+			mcp.WithDescription("List the functions defined in this session and in the function library"),
+		),
+		r.sessions.listFunctionsHandler,
+		cat,
+	)
+
+	// DeleteFunction
+	r.addTool(
+		mcp.NewTool("delete_function",
+			mcp.WithDescription("Delete a function from this session, or with persist also from the function library"),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Function name")),
+			mcp.WithBoolean("persist", mcp.Description("Also delete the function from the server's function library")),
+		),
+		r.sessions.deleteFunctionHandler,
+		cat,
+	)
+}
+
+// functions returns the functions visible to a session: the library
+// overlaid with the session's own definitions.
+func (s *sessionStore) functions(ctx context.Context) map[string]*userFunction {
+	funcs := make(map[string]*userFunction)
+	if lib := s.library(ctx); lib != nil {
+		lib.mu.Lock()
+		for name, f := range lib.funcs {
+			funcs[name] = f
+		}
+		lib.mu.Unlock()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name, f := range s.state(sessionID(ctx)).funcs {
+		funcs[name] = f
+	}
+	return funcs
+}
+
+// expressionEnv returns an evaluation environment with the session's
+// variables, answer history and functions. Calls of the functions stop with
+// an error once ctx is done or there have been maxFunctionCalls of them, so
+// one environment should serve one request.
+func (s *sessionStore) expressionEnv(ctx context.Context) *expr.Env {
+	vars, history := s.snapshot(sessionID(ctx))
+	for i, v := range history {
+		vars[fmt.Sprintf("ans%d", i+1)] = v
+	}
+	if len(history) > 0 {
+		vars["ans"] = history[0]
+	}
+
+	env := &expr.Env{Vars: vars, Funcs: make(map[string]expr.Func)}
+	depth, calls := 0, 0
+	for name, f := range s.functions(ctx) {
+		f := f
+		env.Funcs[name] = expr.Func{
+			Arity: len(f.Params),
+			Fn: func(args []float64) (float64, error) {
+				if err := ctx.Err(); err != nil {
+					return 0, err
+				}
+				if calls++; calls > maxFunctionCalls {
+					return 0, fmt.Errorf("too many function calls (max %d per request)", maxFunctionCalls)
+				}
+				depth++
+				defer func() { depth-- }()
+				if depth > maxCallDepth {
+					return 0, fmt.Errorf("function calls nested too deeply (max %d)", maxCallDepth)
+				}
+				local := make(map[string]float64, len(vars)+len(args))
+				for k, v := range vars {
+					local[k] = v
+				}
+				for i, p := range f.Params {
+					local[p] = args[i]
+				}
+				return expr.Eval(f.node, &expr.Env{Vars: local, Funcs: env.Funcs})
+			},
+		}
+	}
+	return env
+}
+
+func (s *sessionStore) defineFunctionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	params, err := req.RequireStringSlice("params")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	body, err := req.RequireString("body")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	persist := req.GetBool("persist", false)
+
+	f, err := newUserFunction(strings.TrimSpace(name), params, body)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var lib *functionLibrary
+	if persist {
+		if lib, err = s.writableLibrary(ctx); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	} else if sessionID(ctx) == "" {
+		return mcp.NewToolResultError("define_function needs an MCP session unless persist is set: calls without one, such as REST API requests, keep no state"), nil
+	}
+
+	// Calls must resolve to built-ins or functions defined so far
+	known := s.functions(ctx)
+	for _, call := range expr.Calls(f.node) {
+		if _, ok := known[call]; !ok && !expr.IsBuiltin(call) && call != f.Name {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown function %q", call)), nil
+		}
+	}
+
+	s.mu.Lock()
+	st := s.state(sessionID(ctx))
+	previous, exists := st.funcs[f.Name]
+	if !exists && len(st.funcs) >= maxFunctions {
+		s.mu.Unlock()
+		return mcp.NewToolResultError(fmt.Sprintf("too many functions (max %d per session)", maxFunctions)), nil
+	}
+	st.funcs[f.Name] = f
+	s.mu.Unlock()
+
+	if persist {
+		if err := lib.add(f); err != nil {
+			// Leave the session as it was, so a failed call changes nothing
+			s.mu.Lock()
+			if exists {
+				st.funcs[f.Name] = previous
+			} else {
+				delete(st.funcs, f.Name)
+			}
+			s.mu.Unlock()
+			return mcp.NewToolResultError(fmt.Sprintf("failed to save function library: %v", err)), nil
+		}
+		return mcp.NewToolResultText(f.signature() + " (persisted)"), nil
+	}
+	return mcp.NewToolResultText(f.signature()), nil
+}
+
+func (s *sessionStore) callFunctionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	args, err := req.RequireFloatSlice("args")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	env := s.expressionEnv(ctx)
+	f, ok := env.Funcs[name]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown function %q", name)), nil
+	}
+	if len(args) != f.Arity {
+		return mcp.NewToolResultError(fmt.Sprintf("%s expects %d argument(s), got %d", name, f.Arity, len(args))), nil
+	}
+	result, err := f.Fn(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return floatResult(ctx, result, args...), nil
+}
+
+func (s *sessionStore) listFunctionsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	funcs := s.functions(ctx)
+	if len(funcs) == 0 {
+		return mcp.NewToolResultText("no functions"), nil
+	}
+
+	persisted := make(map[string]bool)
+	if lib := s.library(ctx); lib != nil {
+		lib.mu.Lock()
+		for name, f := range lib.funcs {
+			persisted[name] = funcs[name] == f
+		}
+		lib.mu.Unlock()
+	}
+
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, len(names))
+	for i, name := range names {
+		lines[i] = funcs[name].signature()
+		if persisted[name] {
+			lines[i] += " (persisted)"
+		}
+	}
+	return mcp.NewToolResultText(strings.Join(lines, "\n")), nil
+}
+
+func (s *sessionStore) deleteFunctionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	persist := req.GetBool("persist", false)
+	if !persist && sessionID(ctx) == "" {
+		return noSessionError("delete_function"), nil
+	}
+
+	// Remove the persisted function first, so that a failed save leaves the
+	// session unchanged too
+	persisted := false
+	if persist {
+		lib, err := s.writableLibrary(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if persisted, err = lib.remove(name); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to save function library: %v", err)), nil
+		}
+	}
+
+	s.mu.Lock()
+	st := s.state(sessionID(ctx))
+	_, found := st.funcs[name]
+	delete(st.funcs, name)
+	s.mu.Unlock()
+
+	if !found && !persisted {
+		if lib := s.library(ctx); !persist && lib != nil && lib.has(name) {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is in the function library; set persist to delete it there", name)), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("unknown function %q", name)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("deleted %s", name)), nil
+}
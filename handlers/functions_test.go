@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefineAndCallFunction(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	result := callTool(t, r, ctx, "define_function", map[string]any{
+		"name":   "f",
+		"params": []any{"x", "y"},
+		"body":   "x^2 + 2y",
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "f(x, y) = x^2 + 2 * y", resultText(result))
+
+	result = callTool(t, r, ctx, "call_function", map[string]any{"name": "f", "args": []any{3.0, 1.5}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "12", resultText(result))
+
+	// Results join the answer history
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	assert.Equal(t, "12", resultText(result))
+}
+
+func TestCallFunctionUsesSessionState(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "rate", "value": 0.5})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "interest", "params": []any{"p"}, "body": "p * rate"})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "g", "params": []any{"x"}, "body": "interest(x) + 1"})
+
+	result := callTool(t, r, ctx, "call_function", map[string]any{"name": "g", "args": []any{10.0}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "6", resultText(result))
+
+	result = callTool(t, r, ctx, "call_function", map[string]any{"name": "g", "args": []any{"$ans"}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "4", resultText(result))
+}
+
+func TestFunctionsAreSessionScoped(t *testing.T) {
+	r := NewRegistry()
+
+	callTool(t, r, sessionContext("s1"), "define_function", map[string]any{"name": "f", "params": []any{"x"}, "body": "x"})
+	result := callTool(t, r, sessionContext("s2"), "call_function", map[string]any{"name": "f", "args": []any{1.0}})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "unknown function")
+}
+
+func TestDefineFunctionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+	}{
+		{"builtin name", map[string]any{"name": "sin", "params": []any{"x"}, "body": "x"}},
+		{"invalid name", map[string]any{"name": "f-1", "params": []any{"x"}, "body": "x"}},
+		{"duplicate param", map[string]any{"name": "f", "params": []any{"x", "x"}, "body": "x"}},
+		{"syntax error", map[string]any{"name": "f", "params": []any{"x"}, "body": "x +"}},
+		{"unknown function", map[string]any{"name": "f", "params": []any{"x"}, "body": "g(x)"}},
+		{"persist without library", map[string]any{"name": "f", "params": []any{"x"}, "body": "x", "persist": true}},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, sessionContext("s1"), "define_function", tt.args)
+
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestCallFunctionErrors(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"x"}, "body": "x * y"})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "loop", "params": []any{"x"}, "body": "loop(x)"})
+
+	tests := []struct {
+		name string
+		args map[string]any
+		want string
+	}{
+		{"wrong arity", map[string]any{"name": "f", "args": []any{1.0, 2.0}}, "expects 1 argument"},
+		{"unbound variable", map[string]any{"name": "f", "args": []any{1.0}}, "unknown variable"},
+		{"unbounded recursion", map[string]any{"name": "loop", "args": []any{1.0}}, "nested too deeply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, ctx, "call_function", tt.args)
+
+			assert.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.want)
+		})
+	}
+}
+
+func TestListAndDeleteFunctions(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	result := callTool(t, r, ctx, "list_functions", map[string]any{})
+	assert.Equal(t, "no functions", resultText(result))
+
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "sq", "params": []any{"x"}, "body": "x*x"})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "area", "params": []any{"r"}, "body": "pi r^2"})
+	result = callTool(t, r, ctx, "list_functions", map[string]any{})
+	assert.Equal(t, "area(r) = pi * r^2\nsq(x) = x * x", resultText(result))
+
+	result = callTool(t, r, ctx, "delete_function", map[string]any{"name": "sq"})
+	require.False(t, result.IsError)
+	result = callTool(t, r, ctx, "delete_function", map[string]any{"name": "sq"})
+	assert.True(t, result.IsError)
+}
+
+// libraryConfig returns a configuration with every category enabled that
+// persists functions to path.
+func libraryConfig(path string) *config.Config {
+	cfg := allCategoriesConfig()
+	cfg.FunctionsFile = path
+	return cfg
+}
+
+func TestPersistedFunctions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "functions.json")
+	cfg := libraryConfig(path)
+
+	r := NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	result := callToolWithConfig(t, r, sessionContext("s1"), cfg, "define_function", map[string]any{
+		"name": "cube", "params": []any{"x"}, "body": "x^3", "persist": true,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "cube(x) = x^3 (persisted)", resultText(result))
+
+	// A new process loads the library and shares it with every session
+	r = NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	result = callToolWithConfig(t, r, sessionContext("s2"), cfg, "call_function", map[string]any{"name": "cube", "args": []any{2.0}})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "8", resultText(result))
+
+	// Deleting from the library takes persist
+	result = callToolWithConfig(t, r, sessionContext("s2"), cfg, "delete_function", map[string]any{"name": "cube"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "cube is in the function library; set persist to delete it there", resultText(result))
+	result = callToolWithConfig(t, r, sessionContext("s2"), cfg, "delete_function", map[string]any{"name": "cube", "persist": true})
+	require.False(t, result.IsError, resultText(result))
+	r = NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	result = callToolWithConfig(t, r, sessionContext("s3"), cfg, "list_functions", map[string]any{})
+	assert.Equal(t, "no functions", resultText(result))
+}
+
+func TestFunctionLibrariesAreScopedByFile(t *testing.T) {
+	dir := t.TempDir()
+	shared, other := libraryConfig(filepath.Join(dir, "shared.json")), libraryConfig(filepath.Join(dir, "other.json"))
+	r := NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(shared.FunctionsFile))
+	require.NoError(t, r.LoadFunctionLibrary(other.FunctionsFile))
+
+	result := callToolWithConfig(t, r, sessionContext("s1"), shared, "define_function", map[string]any{
+		"name": "f", "params": []any{"x"}, "body": "2x", "persist": true,
+	})
+	require.False(t, result.IsError, resultText(result))
+
+	result = callToolWithConfig(t, r, sessionContext("s2"), shared, "call_function", map[string]any{"name": "f", "args": []any{2.0}})
+	assert.Equal(t, "4", resultText(result))
+	result = callToolWithConfig(t, r, sessionContext("s3"), other, "call_function", map[string]any{"name": "f", "args": []any{2.0}})
+	assert.True(t, result.IsError)
+	result = callTool(t, r, sessionContext("s4"), "call_function", map[string]any{"name": "f", "args": []any{2.0}})
+	assert.True(t, result.IsError)
+}
+
+func TestReadOnlyFunctionLibrary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "functions.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"functions": [{"name": "f", "params": ["x"], "body": "x + 1"}]}`), 0o600))
+	cfg := libraryConfig(path)
+	cfg.FunctionsReadOnly = true
+	r := NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	ctx := sessionContext("s1")
+
+	result := callToolWithConfig(t, r, ctx, cfg, "define_function", map[string]any{
+		"name": "g", "params": []any{"x"}, "body": "x", "persist": true,
+	})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "the function library is read-only", resultText(result))
+	result = callToolWithConfig(t, r, ctx, cfg, "delete_function", map[string]any{"name": "f", "persist": true})
+	assert.True(t, result.IsError)
+
+	// Nothing changed: g was not defined in the session and f remains
+	result = callToolWithConfig(t, r, ctx, cfg, "list_functions", map[string]any{})
+	assert.Equal(t, "f(x) = x + 1 (persisted)", resultText(result))
+}
+
+func TestFailedPersistChangesNothing(t *testing.T) {
+	// The library loads as empty, but its directory does not exist so saving fails
+	path := filepath.Join(t.TempDir(), "missing", "functions.json")
+	cfg := libraryConfig(path)
+	r := NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	ctx := sessionContext("s1")
+
+	result := callToolWithConfig(t, r, ctx, cfg, "define_function", map[string]any{
+		"name": "f", "params": []any{"x"}, "body": "x", "persist": true,
+	})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "failed to save function library")
+
+	result = callToolWithConfig(t, r, ctx, cfg, "list_functions", map[string]any{})
+	assert.Equal(t, "no functions", resultText(result))
+
+	// Without a library nothing is defined either
+	ctx = sessionContext("s2")
+	result = callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"x"}, "body": "x", "persist": true})
+	assert.True(t, result.IsError)
+	result = callTool(t, r, ctx, "list_functions", map[string]any{})
+	assert.Equal(t, "no functions", resultText(result))
+}
+
+func TestLoadFunctionLibraryMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "functions.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"functions": [{"name": "f", "params": ["x"], "body": "x +"}]}`), 0o600))
+
+	err := NewRegistry().LoadFunctionLibrary(path)
+
+	assert.Error(t, err)
+}
+
+func TestReloadWaitsForPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "functions.json")
+	r := NewRegistry()
+	require.NoError(t, r.LoadFunctionLibrary(path))
+	lib := r.sessions.libraries[path]
+
+	// A reload during a save waits for it and reads back what it saved
+	lib.mu.Lock()
+	reloaded := make(chan error)
+	go func() { reloaded <- r.LoadFunctionLibrary(path) }()
+	lib.funcs["cube"] = &userFunction{Name: "cube", Params: []string{"x"}, Body: "x^3"}
+	require.NoError(t, lib.save())
+	lib.mu.Unlock()
+	require.NoError(t, <-reloaded)
+
+	assert.Same(t, lib, r.sessions.libraries[path])
+	assert.True(t, lib.has("cube"))
+}
+
+func TestExponentialFunctionChainFailsFast(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+	result := callTool(t, r, ctx, "define_function", map[string]any{"name": "f0", "params": []any{"x"}, "body": "x"})
+	require.False(t, result.IsError, resultText(result))
+	// Each level calls the one below twice, so f40 makes 2^40 calls
+	for k := 1; k <= 40; k++ {
+		body := fmt.Sprintf("f%d(x) + f%d(x)", k-1, k-1)
+		result = callTool(t, r, ctx, "define_function", map[string]any{"name": fmt.Sprintf("f%d", k), "params": []any{"x"}, "body": body})
+		require.False(t, result.IsError, resultText(result))
+	}
+
+	start := time.Now()
+	result = callTool(t, r, ctx, "call_function", map[string]any{"name": "f40", "args": []any{1.0}})
+	require.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "too many function calls (max 10000000 per request)")
+	assert.Less(t, time.Since(start), 30*time.Second)
+
+	// A canceled request stops at the next call
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	result = callTool(t, r, canceled, "call_function", map[string]any{"name": "f40", "args": []any{1.0}})
+	require.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "context canceled")
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ieeePolicyParam is the optional per-call argument overriding the server policy.
+const ieeePolicyParam = "ieee_policy"
+
+// smallestNormalFloat64 is the smallest positive normal float64 (2^-1022).
+const smallestNormalFloat64 = 0x1p-1022
+
+// IEEEErrorKind identifies the kind of special value rejected in strict mode.
+type IEEEErrorKind string
+
+// Kinds of IEEE special values reported in strict mode.
+const (
+	IEEENaN          IEEEErrorKind = "nan"
+	IEEEInfinity     IEEEErrorKind = "infinity"
+	IEEEDivideByZero IEEEErrorKind = "divide_by_zero"
+	IEEEOverflow     IEEEErrorKind = "overflow"
+	IEEEUnderflow    IEEEErrorKind = "underflow"
+	IEEESubnormal    IEEEErrorKind = "subnormal"
+)
+
+// IEEEError is returned when a result is rejected by the strict IEEE policy.
+type IEEEError struct {
+	Kind  IEEEErrorKind
+	Value float64
+}
+
+func (e *IEEEError) Error() string {
+	return fmt.Sprintf("ieee %s: result %g rejected in strict mode", e.Kind, e.Value)
+}
+
+type ieeePolicyKey struct{}
+
+// withIEEEPolicy returns a context carrying the given policy.
+func withIEEEPolicy(ctx context.Context, policy config.IEEEPolicy) context.Context {
+	return context.WithValue(ctx, ieeePolicyKey{}, policy)
+}
+
+// ieeePolicyFromContext returns the policy in ctx, defaulting to permissive.
+func ieeePolicyFromContext(ctx context.Context) config.IEEEPolicy {
+	if policy, ok := ctx.Value(ieeePolicyKey{}).(config.IEEEPolicy); ok {
+		return policy
+	}
+	return config.IEEEPermissive
+}
+
+// ieeePolicyOption adds the per-call policy override argument to a tool.
+var ieeePolicyOption = mcp.WithString(ieeePolicyParam,
+	mcp.Enum(string(config.IEEEPermissive), string(config.IEEEStrict)),
+	mcp.Description("Special-value policy for this call: permissive returns NaN/Inf as-is, strict reports them as errors (defaults to the server setting)"),
+)
+
+// ieeePolicyMiddleware resolves the policy for each call, preferring the
+// per-call argument over the server default, and stores it in the context.
+func ieeePolicyMiddleware(defaultPolicy config.IEEEPolicy) server.ToolHandlerMiddleware {
+	if defaultPolicy == "" {
+		defaultPolicy = config.IEEEPermissive
+	}
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			policy := defaultPolicy
+			if raw := req.GetString(ieeePolicyParam, ""); raw != "" {
+				p, ok := config.ParseIEEEPolicy(raw)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid %s %q: must be permissive or strict", ieeePolicyParam, raw)), nil
+				}
+				policy = p
+			}
+			return next(withIEEEPolicy(ctx, policy), req)
+		}
+	}
+}
+
+// checkIEEE applies the policy in ctx to v. The inputs are the operands v was
+// computed from and are used to tell an overflow from a propagated infinity.
+// It always returns nil in permissive mode.
+func checkIEEE(ctx context.Context, v float64, inputs ...float64) error {
+	if ieeePolicyFromContext(ctx) != config.IEEEStrict {
+		return nil
+	}
+	switch {
+	case math.IsNaN(v):
+		return &IEEEError{Kind: IEEENaN, Value: v}
+	case math.IsInf(v, 0):
+		for _, in := range inputs {
+			if math.IsInf(in, 0) {
+				return &IEEEError{Kind: IEEEInfinity, Value: v}
+			}
+		}
+		return &IEEEError{Kind: IEEEOverflow, Value: v}
+	case v != 0 && math.Abs(v) < smallestNormalFloat64:
+		return &IEEEError{Kind: IEEESubnormal, Value: v}
+	}
+	return nil
+}
+
+// checkUnderflow reports an underflow in strict mode when v was flushed to
+// zero although the exact result is known to be nonzero.
+func checkUnderflow(ctx context.Context, v float64, exactNonzero bool) error {
+	if ieeePolicyFromContext(ctx) == config.IEEEStrict && v == 0 && exactNonzero {
+		return &IEEEError{Kind: IEEEUnderflow, Value: v}
+	}
+	return nil
+}
+
+// checkPole reports a division by zero in strict mode when v is the infinite
+// result of evaluating a function at a pole.
+func checkPole(ctx context.Context, v float64, atPole bool) error {
+	if ieeePolicyFromContext(ctx) == config.IEEEStrict && atPole && math.IsInf(v, 0) {
+		return &IEEEError{Kind: IEEEDivideByZero, Value: v}
+	}
+	return nil
+}
+
+// firstError returns the first non-nil error.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ieeeErrorResult converts an error into a tool error result, attaching the
+// kind as structured content when it is an IEEEError.
+func ieeeErrorResult(err error) *mcp.CallToolResult {
+	result := mcp.NewToolResultError(err.Error())
+	if ieeeErr, ok := err.(*IEEEError); ok {
+		result.StructuredContent = map[string]any{
+			"error": "ieee_" + string(ieeeErr.Kind),
+			"kind":  string(ieeeErr.Kind),
+			"value": fmt.Sprintf("%g", ieeeErr.Value),
+		}
+	}
+	return result
+}
+
+// floatResult formats v with %g after applying the policy in ctx, and
+// records it as the session's latest answer.
+func floatResult(ctx context.Context, v float64, inputs ...float64) *mcp.CallToolResult {
+	if err := checkIEEE(ctx, v, inputs...); err != nil {
+		return ieeeErrorResult(err)
+	}
+	recordResult(ctx, v)
+	return mcp.NewToolResultText(fmt.Sprintf("%g", v))
+}
+
+// checkComplexIEEE applies the policy in ctx to both parts of c.
+func checkComplexIEEE(ctx context.Context, c complex128, inputs ...complex128) error {
+	parts := make([]float64, 0, 2*len(inputs))
+	for _, in := range inputs {
+		parts = append(parts, real(in), imag(in))
+	}
+	return firstError(
+		checkIEEE(ctx, real(c), parts...),
+		checkIEEE(ctx, imag(c), parts...),
+	)
+}
+
+// complexResult formats c with formatComplex after applying the policy in ctx.
+func complexResult(ctx context.Context, c complex128, inputs ...complex128) *mcp.CallToolResult {
+	if err := checkComplexIEEE(ctx, c, inputs...); err != nil {
+		return ieeeErrorResult(err)
+	}
+	return mcp.NewToolResultText(formatComplex(c))
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIEEEPermissive(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler server.ToolHandlerFunc
+		args    map[string]any
+		want    string
+	}{
+		{"exp overflow", expHandler, map[string]any{"x": 1000.0}, "+Inf"},
+		{"pow pole", powHandler, map[string]any{"x": 0.0, "y": -1.0}, "+Inf"},
+		{"exp underflow", expHandler, map[string]any{"x": -1000.0}, "0"},
+		{"gamma overflow", gammaHandler, map[string]any{"x": 200.0}, "+Inf"},
+		{"nextafter subnormal", nextafterHandler, map[string]any{"x": 0.0, "y": 1.0}, "5e-324"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withIEEEPolicy(context.Background(), config.IEEEPermissive)
+			result, err := tt.handler(ctx, makeRequest(tt.args))
+
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+			assert.Equal(t, tt.want, result.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestIEEEStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler server.ToolHandlerFunc
+		args    map[string]any
+		kind    IEEEErrorKind
+	}{
+		{"exp overflow", expHandler, map[string]any{"x": 1000.0}, IEEEOverflow},
+		{"multiply overflow", multiplyHandler, map[string]any{"a": 1e308, "b": 10.0}, IEEEOverflow},
+		{"sum overflow", sumHandler, map[string]any{"numbers": []any{1e308, 1e308}}, IEEEOverflow},
+		{"pow pole", powHandler, map[string]any{"x": 0.0, "y": -1.0}, IEEEDivideByZero},
+		{"logb of zero", logbHandler, map[string]any{"x": 0.0}, IEEEDivideByZero},
+		{"exp underflow", expHandler, map[string]any{"x": -1000.0}, IEEEUnderflow},
+		{"product underflow", productHandler, map[string]any{"numbers": []any{1e-200, 1e-200}}, IEEEUnderflow},
+		{"nextafter subnormal", nextafterHandler, map[string]any{"x": 0.0, "y": 1.0}, IEEESubnormal},
+		{"complex exp overflow", complexExpHandler, map[string]any{"real": 1000.0, "imag": 0.0}, IEEEOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+			result, err := tt.handler(ctx, makeRequest(tt.args))
+
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, string(tt.kind))
+			structured, ok := result.StructuredContent.(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, string(tt.kind), structured["kind"])
+		})
+	}
+}
+
+func TestIEEEStrictAllowsFiniteResults(t *testing.T) {
+	ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+	result, err := expHandler(ctx, makeRequest(map[string]any{"x": 1.0}))
+
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "2.718")
+}
+
+func TestGammaPoleAlwaysErrors(t *testing.T) {
+	for _, policy := range []config.IEEEPolicy{config.IEEEPermissive, config.IEEEStrict} {
+		ctx := withIEEEPolicy(context.Background(), policy)
+		result, err := gammaHandler(ctx, makeRequest(map[string]any{"x": -2.0}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError, "policy %s", policy)
+	}
+}
+
+func TestIEEEPolicyMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultPolicy config.IEEEPolicy
+		override      string
+		wantErr       bool
+	}{
+		{"server permissive", config.IEEEPermissive, "", false},
+		{"server strict", config.IEEEStrict, "", true},
+		{"call overrides to strict", config.IEEEPermissive, "strict", true},
+		{"call overrides to permissive", config.IEEEStrict, "permissive", false},
+		{"empty server default", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{"x": 1000.0}
+			if tt.override != "" {
+				args[ieeePolicyParam] = tt.override
+			}
+			handler := ieeePolicyMiddleware(tt.defaultPolicy)(expHandler)
+			result, err := handler(context.Background(), makeRequest(args))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantErr, result.IsError)
+		})
+	}
+}
+
+func TestIEEEPolicyMiddlewareInvalidOverride(t *testing.T) {
+	handler := ieeePolicyMiddleware(config.IEEEPermissive)(expHandler)
+	result, err := handler(context.Background(), makeRequest(map[string]any{"x": 1.0, ieeePolicyParam: "lenient"}))
+
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "invalid ieee_policy")
+}
+
+func TestToolsAcceptIEEEPolicy(t *testing.T) {
+	registry := NewRegistry()
+
+	for _, td := range registry.tools {
+		assert.Contains(t, td.Tool.InputSchema.Properties, ieeePolicyParam, "tool %s", td.Tool.Name)
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/interp"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxInterpolationPoints caps the points of a data set, and the values
+	// of a grid.
+	maxInterpolationPoints = 100000
+	// maxQueryPoints caps the points evaluated in one call.
+	maxQueryPoints = 10000
+)
+
+// Ways to treat query points outside the data.
+const (
+	extrapolateExtend = "extend"
+	extrapolateClamp  = "clamp"
+	extrapolateError  = "error"
+)
+
+// registerInterpolation registers interpolation tools.
+func (r *Registry) registerInterpolation() {
+	cat := config.CategoryInterpolation
+
+	// Interpolate
+	r.addTool(
+		mcp.NewTool("interpolate",
+			mcp.WithDescription("Interpolate between points (x, y), such as the rows of a table, and evaluate at many points in one call. "+
+				"Methods: linear (default); nearest, the value at the nearest point; polynomial, the Lagrange or Newton polynomial through all the points; "+
+				"natural_spline, the cubic spline with zero curvature at the ends; clamped_spline, the cubic spline with given end slopes; "+
+				"pchip, a monotone cubic that does not overshoot. "+
+				"Points outside the data are extrapolated from the end pieces by default"),
+			mcp.WithArray("x", mcp.Required(), mcp.Description("Distinct values of x, in any order"), mcp.WithNumberItems()),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Values of y, one per value of x"), mcp.WithNumberItems()),
+			mcp.WithArray("at", mcp.Required(), mcp.Description("Points to evaluate at"), mcp.WithNumberItems()),
+			mcp.WithString("method", mcp.Enum(
+				string(interp.Linear), string(interp.Nearest), string(interp.Polynomial),
+				string(interp.NaturalSpline), string(interp.ClampedSpline), string(interp.PCHIP),
+			), mcp.Description("Method (default linear)")),
+			mcp.WithNumber("start_slope", mcp.Description("Slope at the first point, for clamped_spline")),
+			mcp.WithNumber("end_slope", mcp.Description("Slope at the last point, for clamped_spline")),
+			extrapolationParam(),
+		),
+		interpolateHandler,
+		cat,
+	)
+
+	// Interpolate 2-D
+	r.addTool(
+		mcp.NewTool("interpolate_2d",
+			mcp.WithDescription("Interpolate values z on a rectangular grid of x and y, such as a two-way table, and evaluate at many points (x, y) in one call. "+
+				"Methods: bilinear (default), linear along each axis; bicubic, natural cubic splines along each axis. "+
+				"Points outside the grid are extrapolated from the edge pieces by default"),
+			mcp.WithArray("x", mcp.Required(), mcp.Description("Distinct values of x, one per row of z, in any order"), mcp.WithNumberItems()),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Distinct values of y, one per column of z, in any order"), mcp.WithNumberItems()),
+			matrixParam("z", "Values on the grid, with z[i][j] at (x[i], y[j]),"),
+			mcp.WithArray("at",
+				mcp.Required(),
+				mcp.Description("Points to evaluate at, as pairs [x, y], e.g. [[1.5, 20], [2, 35]]"),
+				mcp.Items(map[string]any{"type": "array", "items": map[string]any{"type": "number"}}),
+			),
+			mcp.WithString("method", mcp.Enum(string(interp.Bilinear), string(interp.Bicubic)), mcp.Description("Method (default bilinear)")),
+			extrapolationParam(),
+		),
+		interpolate2DHandler,
+		cat,
+	)
+}
+
+// extrapolationParam declares how to treat query points outside the data.
+func extrapolationParam() mcp.ToolOption {
+	return mcp.WithString("extrapolation",
+		mcp.Enum(extrapolateExtend, extrapolateClamp, extrapolateError),
+		mcp.Description("For points outside the data: extend, continue the end pieces, with a warning (default); clamp, take the value at the nearest end; error, refuse them"),
+	)
+}
+
+// extrapolation reads and checks the extrapolation argument.
+func extrapolation(req mcp.CallToolRequest) (string, error) {
+	mode := req.GetString("extrapolation", extrapolateExtend)
+	switch mode {
+	case extrapolateExtend, extrapolateClamp, extrapolateError:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown extrapolation %q; use extend, clamp or error", mode)
+}
+
+// outside clamps t into [lo, hi] under clamp and reports whether it was
+// outside, or returns an error under error.
+func outside(mode, name string, t, lo, hi float64) (float64, bool, error) {
+	if t >= lo && t <= hi {
+		return t, false, nil
+	}
+	switch mode {
+	case extrapolateError:
+		return 0, false, fmt.Errorf("%s = %g lies outside the data range [%g, %g]; set extrapolation to \"extend\" or \"clamp\" to allow it", name, t, lo, hi)
+	case extrapolateClamp:
+		return math.Max(lo, math.Min(t, hi)), true, nil
+	}
+	return t, true, nil
+}
+
+// interpolationResult reports the values at the query points: a single
+// value becomes ans, several are listed. It warns of extrapolated points.
+func interpolationResult(ctx context.Context, values []float64, extrapolated int, mode string, method interp.Method, inputs []float64) *mcp.CallToolResult {
+	for _, v := range values {
+		if err := checkIEEE(ctx, v, inputs...); err != nil {
+			return ieeeErrorResult(err)
+		}
+	}
+	var text string
+	if len(values) == 1 {
+		recordResult(ctx, values[0])
+		text = fmt.Sprintf("%g", values[0])
+	} else {
+		text = formatFloats(values)
+	}
+	if extrapolated > 0 && mode == extrapolateExtend {
+		text += fmt.Sprintf("\nwarning: %d of the points lie outside the data, so their values are extrapolated and may be unreliable", extrapolated)
+	}
+	result := mcp.NewToolResultText(text)
+	if allFinite(values) {
+		result.StructuredContent = map[string]any{
+			"values":       values,
+			"method":       string(method),
+			"extrapolated": extrapolated,
+		}
+	}
+	return result
+}
+
+func interpolateHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := req.RequireFloatSlice("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	y, err := req.RequireFloatSlice("y")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(x) > maxInterpolationPoints {
+		return mcp.NewToolResultError(fmt.Sprintf("x may have at most %d points", maxInterpolationPoints)), nil
+	}
+	at, err := req.RequireFloatSlice("at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(at) == 0 || len(at) > maxQueryPoints {
+		return mcp.NewToolResultError(fmt.Sprintf("at must have between 1 and %d points", maxQueryPoints)), nil
+	}
+	method := interp.Method(req.GetString("method", string(interp.Linear)))
+	var opts interp.Options
+	if method == interp.ClampedSpline {
+		if opts.StartSlope, err = req.RequireFloat("start_slope"); err != nil {
+			return mcp.NewToolResultError("clamped_spline needs start_slope and end_slope"), nil
+		}
+		if opts.EndSlope, err = req.RequireFloat("end_slope"); err != nil {
+			return mcp.NewToolResultError("clamped_spline needs start_slope and end_slope"), nil
+		}
+	}
+	mode, err := extrapolation(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	p, err := interp.New(method, x, y, opts)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	lo, hi := p.Domain()
+	values := make([]float64, len(at))
+	extrapolated := 0
+	for i, t := range at {
+		t, out, err := outside(mode, "at", t, lo, hi)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if out {
+			extrapolated++
+		}
+		values[i] = p.At(t)
+	}
+	return interpolationResult(ctx, values, extrapolated, mode, method, concatFloats(x, y, at)), nil
+}
+
+func interpolate2DHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := req.RequireFloatSlice("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	y, err := req.RequireFloatSlice("y")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	z, err := matrixArg(req, "z")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(z.Data) > maxInterpolationPoints {
+		return mcp.NewToolResultError(fmt.Sprintf("z may have at most %d values", maxInterpolationPoints)), nil
+	}
+	at, err := pairsArg(req, "at")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	method := interp.Method(req.GetString("method", string(interp.Bilinear)))
+	mode, err := extrapolation(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	g, err := interp.NewGrid(method, x, y, z.ToRows())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	xlo, xhi, ylo, yhi := g.Domain()
+	values := make([]float64, len(at))
+	extrapolated := 0
+	var inputs []float64
+	for i, q := range at {
+		u, outX, err := outside(mode, fmt.Sprintf("at[%d] x", i), q[0], xlo, xhi)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		v, outY, err := outside(mode, fmt.Sprintf("at[%d] y", i), q[1], ylo, yhi)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if outX || outY {
+			extrapolated++
+		}
+		values[i] = g.At(u, v)
+		inputs = append(inputs, q[0], q[1])
+	}
+	return interpolationResult(ctx, values, extrapolated, mode, method, concatFloats(x, y, z.Data, inputs)), nil
+}
+
+// pairsArg reads an array of points [x, y].
+func pairsArg(req mcp.CallToolRequest, name string) ([][2]float64, error) {
+	raw, ok := req.GetArguments()[name].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of pairs [x, y]", name)
+	}
+	if len(raw) == 0 || len(raw) > maxQueryPoints {
+		return nil, fmt.Errorf("%s must have between 1 and %d points", name, maxQueryPoints)
+	}
+	pairs := make([][2]float64, len(raw))
+	for i, item := range raw {
+		pair, ok := item.([]any)
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("%s[%d] must be a pair [x, y]", name, i)
+		}
+		for j, v := range pair {
+			f, ok := v.(float64)
+			if !ok {
+				return nil, fmt.Errorf("%s[%d][%d] must be a number", name, i, j)
+			}
+			pairs[i][j] = f
+		}
+	}
+	return pairs, nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolateTool(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		want []float64
+	}{
+		{"linear by default", map[string]any{}, []float64{0.5, 1, 0.5}},
+		{"nearest", map[string]any{"method": "nearest"}, []float64{0, 1, 1}},
+		{"polynomial", map[string]any{"method": "polynomial"}, []float64{0.75, 1, 0.75}},
+		{"natural spline", map[string]any{"method": "natural_spline"}, []float64{0.6875, 1, 0.6875}},
+		{"clamped spline", map[string]any{"method": "clamped_spline", "start_slope": 0.0, "end_slope": 0.0}, []float64{0.5, 1, 0.5}},
+		{"pchip", map[string]any{"method": "pchip"}, []float64{0.75, 1, 0.75}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{"x": []any{0.0, 1.0, 2.0}, "y": []any{0.0, 1.0, 0.0}, "at": []any{0.5, 1.0, 1.5}}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "interpolate", args)
+			require.False(t, result.IsError, resultText(result))
+			assert.NotContains(t, resultText(result), "warning")
+			content := result.StructuredContent.(map[string]any)
+			assert.InDeltaSlice(t, tt.want, content["values"], 1e-15)
+			assert.Equal(t, 0, content["extrapolated"])
+		})
+	}
+}
+
+func TestInterpolateSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// Looking up one value in a descending table makes it ans
+	result := callTool(t, r, ctx, "interpolate", map[string]any{
+		"x":  []any{100.0, 50.0, 25.0},
+		"y":  []any{0.2, 0.6, 0.9},
+		"at": []any{40.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "0.72", resultText(result))
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	assert.Equal(t, "0.72", resultText(result))
+}
+
+func TestInterpolateExtrapolation(t *testing.T) {
+	args := func(mode string) map[string]any {
+		a := map[string]any{"x": []any{0.0, 1.0, 2.0}, "y": []any{0.0, 2.0, 3.0}, "at": []any{-1.0, 1.5, 4.0}}
+		if mode != "" {
+			a["extrapolation"] = mode
+		}
+		return a
+	}
+
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "interpolate", args(""))
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "[-2, 2.5, 5]\nwarning: 2 of the points lie outside the data, so their values are extrapolated and may be unreliable", resultText(result))
+	assert.Equal(t, 2, result.StructuredContent.(map[string]any)["extrapolated"])
+
+	result = callTool(t, NewRegistry(), sessionContext("s1"), "interpolate", args("clamp"))
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "[0, 2.5, 3]", resultText(result))
+
+	result = callTool(t, NewRegistry(), sessionContext("s1"), "interpolate", args("error"))
+	require.True(t, result.IsError)
+	assert.Equal(t, `at = -1 lies outside the data range [0, 2]; set extrapolation to "extend" or "clamp" to allow it`, resultText(result))
+}
+
+func TestInterpolateNotFinite(t *testing.T) {
+	// The spline through these values overflows between them
+	args := map[string]any{"x": []any{0.0, 1.0, 2.0}, "y": []any{0.0, 1e308, -1e308}, "at": []any{1.5}, "method": "natural_spline"}
+
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "interpolate", args)
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "NaN", resultText(result))
+	assert.Nil(t, result.StructuredContent)
+
+	cfg := allCategoriesConfig()
+	cfg.IEEEPolicy = config.IEEEStrict
+	result = callToolWithConfig(t, NewRegistry(), sessionContext("s1"), cfg, "interpolate", args)
+	assert.True(t, result.IsError)
+}
+
+func TestInterpolate2DTool(t *testing.T) {
+	// z = x + 10y on a grid with rows for x and columns for y
+	args := map[string]any{
+		"x":  []any{0.0, 1.0, 2.0},
+		"y":  []any{0.0, 1.0},
+		"z":  []any{[]any{0.0, 10.0}, []any{1.0, 11.0}, []any{2.0, 12.0}},
+		"at": []any{[]any{0.5, 0.5}, []any{1.5, 0.25}, []any{2.0, 1.0}},
+	}
+	for _, method := range []string{"bilinear", "bicubic"} {
+		t.Run(method, func(t *testing.T) {
+			args["method"] = method
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "interpolate_2d", args)
+			require.False(t, result.IsError, resultText(result))
+			content := result.StructuredContent.(map[string]any)
+			assert.InDeltaSlice(t, []float64{5.5, 4, 12}, content["values"], 1e-14)
+			assert.Equal(t, method, content["method"])
+		})
+	}
+
+	delete(args, "method")
+	args["at"] = []any{[]any{1.0, 2.0}}
+	args["extrapolation"] = "clamp"
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "interpolate_2d", args)
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "11", resultText(result))
+}
+
+func TestInterpolationToolErrors(t *testing.T) {
+	tests := []struct {
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"interpolate", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0}, "at": []any{1.5}}, "there are 2 values of x but 1 of y"},
+		{"interpolate", map[string]any{"x": []any{1.0, 1.0}, "y": []any{1.0, 2.0}, "at": []any{1.5}}, "the values of x must be distinct, but 1 appears twice"},
+		{"interpolate", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "at": []any{}}, "at must have between 1 and 10000 points"},
+		{"interpolate", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "at": []any{1.5}, "method": "clamped_spline"}, "clamped_spline needs start_slope and end_slope"},
+		{"interpolate", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "at": []any{1.5}, "method": "cubic"}, `unknown method "cubic"`},
+		{"interpolate", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "at": []any{1.5}, "extrapolation": "wrap"}, `unknown extrapolation "wrap"; use extend, clamp or error`},
+		{"interpolate_2d", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "z": []any{[]any{1.0, 2.0}, []any{3.0, 4.0}}, "at": []any{[]any{1.5}}}, "at[0] must be a pair [x, y]"},
+		{"interpolate_2d", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0}, "z": []any{[]any{1.0, 2.0}, []any{3.0, 4.0}}, "at": []any{[]any{1.5, 1.5}}}, "z has 2 rows but there are 3 values of x"},
+		{"interpolate_2d", map[string]any{"x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "z": []any{[]any{1.0, 2.0}, []any{3.0, 4.0}}, "at": []any{[]any{1.5, 3.0}}, "extrapolation": "error"}, "at[0] y = 3 lies outside the data range [1, 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.want)
+		})
+	}
+}
@@ -0,0 +1,632 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// libULPs is how far bounds computed with the math library's exp, log, sin
+// and cos are widened. The library is accurate to within 1 ulp; the extra
+// ulp is a safety margin.
+const libULPs = 2
+
+// exactErrorMin is the magnitude below which the rounding error of a product,
+// quotient or square root may underflow and can no longer be computed
+// exactly with an FMA.
+const exactErrorMin = 0x1p-969
+
+// registerInterval registers interval arithmetic tools.
+func (r *Registry) registerInterval() {
+	cat := config.CategoryInterval
+
+	// Add
+	r.addTool(
+		mcp.NewTool("interval_add",
+			mcp.WithDescription("Enclosure of a + b for intervals a and b"),
+			intervalParam("a", "First operand"),
+			intervalParam("b", "Second operand"),
+		),
+		intervalAddHandler,
+		cat,
+	)
+
+	// Subtract
+	r.addTool(
+		mcp.NewTool("interval_subtract",
+			mcp.WithDescription("Enclosure of a - b for intervals a and b"),
+			intervalParam("a", "First operand"),
+			intervalParam("b", "Second operand"),
+		),
+		intervalSubtractHandler,
+		cat,
+	)
+
+	// Multiply
+	r.addTool(
+		mcp.NewTool("interval_multiply",
+			mcp.WithDescription("Enclosure of a * b for intervals a and b"),
+			intervalParam("a", "First operand"),
+			intervalParam("b", "Second operand"),
+		),
+		intervalMultiplyHandler,
+		cat,
+	)
+
+	// Divide
+	r.addTool(
+		mcp.NewTool("interval_divide",
+			mcp.WithDescription("Enclosure of a / b for intervals a and b. If b contains 0 the result is unbounded and may be a union of two intervals"),
+			intervalParam("a", "Dividend"),
+			intervalParam("b", "Divisor"),
+		),
+		intervalDivideHandler,
+		cat,
+	)
+
+	// Pow
+	r.addTool(
+		mcp.NewTool("interval_pow",
+			mcp.WithDescription("Enclosure of x^y for intervals x and y. Negative x requires an integer exponent"),
+			intervalParam("x", "Base"),
+			intervalParam("y", "Exponent"),
+		),
+		intervalPowHandler,
+		cat,
+	)
+
+	// Sqrt
+	r.addTool(
+		mcp.NewTool("interval_sqrt",
+			mcp.WithDescription("Enclosure of the square root of interval x"),
+			intervalParam("x", "Argument"),
+		),
+		intervalSqrtHandler,
+		cat,
+	)
+
+	// Exp
+	r.addTool(
+		mcp.NewTool("interval_exp",
+			mcp.WithDescription("Enclosure of e^x for interval x"),
+			intervalParam("x", "Exponent"),
+		),
+		intervalExpHandler,
+		cat,
+	)
+
+	// Log
+	r.addTool(
+		mcp.NewTool("interval_log",
+			mcp.WithDescription("Enclosure of the natural logarithm of interval x"),
+			intervalParam("x", "Argument"),
+		),
+		intervalLogHandler,
+		cat,
+	)
+
+	// Sin
+	r.addTool(
+		mcp.NewTool("interval_sin",
+			mcp.WithDescription("Enclosure of sin(x) for interval x in radians"),
+			intervalParam("x", "Angle in radians"),
+		),
+		intervalSinHandler,
+		cat,
+	)
+
+	// Cos
+	r.addTool(
+		mcp.NewTool("interval_cos",
+			mcp.WithDescription("Enclosure of cos(x) for interval x in radians"),
+			intervalParam("x", "Angle in radians"),
+		),
+		intervalCosHandler,
+		cat,
+	)
+}
+
+// intervalParam declares an interval argument.
+func intervalParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name,
+		mcp.Required(),
+		mcp.Description(description+" as [lo, hi], or a number for a point interval"),
+		mcp.WithNumberItems(),
+		mcp.MinItems(2),
+		mcp.MaxItems(2),
+	)
+}
+
+// intervalArgs reads the named interval arguments.
+func intervalArgs(req mcp.CallToolRequest, names ...string) ([]interval, error) {
+	xs := make([]interval, len(names))
+	for i, name := range names {
+		x, err := intervalArg(req, name)
+		if err != nil {
+			return nil, err
+		}
+		xs[i] = x
+	}
+	return xs, nil
+}
+
+func intervalAddHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	xs, err := intervalArgs(req, "a", "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalAdd(xs[0], xs[1])), nil
+}
+
+func intervalSubtractHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	xs, err := intervalArgs(req, "a", "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalSub(xs[0], xs[1])), nil
+}
+
+func intervalMultiplyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	xs, err := intervalArgs(req, "a", "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalMul(xs[0], xs[1])), nil
+}
+
+func intervalDivideHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	xs, err := intervalArgs(req, "a", "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result, err := intervalDiv(xs[0], xs[1])
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(result...), nil
+}
+
+func intervalPowHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	xs, err := intervalArgs(req, "x", "y")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result, err := intervalPow(xs[0], xs[1])
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(result...), nil
+}
+
+func intervalSqrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := intervalArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result, err := intervalSqrt(x)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(result), nil
+}
+
+func intervalExpHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := intervalArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalExp(x)), nil
+}
+
+func intervalLogHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := intervalArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result, err := intervalLog(x)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(result), nil
+}
+
+func intervalSinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := intervalArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalSin(x)), nil
+}
+
+func intervalCosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := intervalArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return intervalResult(intervalCos(x)), nil
+}
+
+// interval is a closed interval [lo, hi] of reals. An infinite bound means
+// the interval is unbounded on that side.
+type interval struct {
+	lo, hi float64
+}
+
+func (x interval) String() string {
+	return fmt.Sprintf("[%g, %g]", x.lo, x.hi)
+}
+
+func (x interval) contains(v float64) bool {
+	return x.lo <= v && v <= x.hi
+}
+
+// isPoint reports whether x holds a single number.
+func (x interval) isPoint() bool {
+	return x.lo == x.hi
+}
+
+// intervalArg reads an interval argument given as [lo, hi], or as a number
+// for the point interval [x, x].
+func intervalArg(req mcp.CallToolRequest, name string) (interval, error) {
+	var x interval
+	if v, ok := req.GetArguments()[name].(float64); ok {
+		x = interval{v, v}
+	} else {
+		bounds, err := req.RequireFloatSlice(name)
+		if err != nil {
+			return x, err
+		}
+		if len(bounds) != 2 {
+			return x, fmt.Errorf("%s must be [lo, hi], got %d numbers", name, len(bounds))
+		}
+		x = interval{bounds[0], bounds[1]}
+	}
+	switch {
+	case math.IsNaN(x.lo) || math.IsNaN(x.hi):
+		return x, fmt.Errorf("%s must not contain NaN", name)
+	case x.lo > x.hi:
+		return x, fmt.Errorf("%s: lower bound %g exceeds upper bound %g", name, x.lo, x.hi)
+	case math.IsInf(x.lo, 1) || math.IsInf(x.hi, -1):
+		return x, fmt.Errorf("%s must contain a real number", name)
+	}
+	return x, nil
+}
+
+// intervalResult formats the union of the intervals as the tool result.
+func intervalResult(xs ...interval) *mcp.CallToolResult {
+	parts := make([]string, len(xs))
+	for i, x := range xs {
+		parts[i] = x.String()
+	}
+	return mcp.NewToolResultText(strings.Join(parts, " ∪ "))
+}
+
+// enclose returns bounds on an exact value from its rounded value v and err,
+// the exact value minus v, or NaN when only |err| ≤ 1 ulp is known.
+func enclose(v, err float64) (float64, float64) {
+	switch {
+	case err > 0:
+		return v, math.Nextafter(v, math.Inf(1))
+	case err < 0:
+		return math.Nextafter(v, math.Inf(-1)), v
+	case err == 0:
+		return v, v
+	}
+	return math.Nextafter(v, math.Inf(-1)), math.Nextafter(v, math.Inf(1))
+}
+
+// underflowBounds encloses a nonzero exact value that rounded to 0.
+func underflowBounds(positive bool) (float64, float64) {
+	if positive {
+		return 0, math.SmallestNonzeroFloat64
+	}
+	return -math.SmallestNonzeroFloat64, 0
+}
+
+// addBounds encloses a + b, using TwoSum to find the rounding error.
+func addBounds(a, b float64) (float64, float64) {
+	s := a + b
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return s, s
+	}
+	bb := s - a
+	err := (a - (s - bb)) + (b - bb)
+	if math.IsInf(s, 0) {
+		err = math.NaN()
+	}
+	return enclose(s, err)
+}
+
+// mulBounds encloses a * b, taking 0 * ∞ as 0 as interval bounds require.
+func mulBounds(a, b float64) (float64, float64) {
+	if a == 0 || b == 0 {
+		return 0, 0
+	}
+	p := a * b
+	switch {
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return p, p
+	case p == 0:
+		return underflowBounds((a > 0) == (b > 0))
+	case math.IsInf(p, 0) || math.Abs(p) < exactErrorMin:
+		return enclose(p, math.NaN())
+	}
+	return enclose(p, math.FMA(a, b, -p))
+}
+
+// divBounds encloses a / b for b ≠ 0, except ∞ / ∞.
+func divBounds(a, b float64) (float64, float64) {
+	if a == 0 {
+		return 0, 0
+	}
+	q := a / b
+	switch {
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return q, q
+	case q == 0:
+		return underflowBounds((a > 0) == (b > 0))
+	case math.IsInf(q, 0) || math.Abs(a) < exactErrorMin || math.Abs(q) < smallestNormalFloat64:
+		return enclose(q, math.NaN())
+	}
+	// a − q·b is exact and has the sign of (a/b − q)·b
+	r := math.FMA(-q, b, a)
+	if b < 0 {
+		r = -r
+	}
+	return enclose(q, r)
+}
+
+// sqrtBounds encloses √x for x ≥ 0.
+func sqrtBounds(x float64) (float64, float64) {
+	s := math.Sqrt(x)
+	switch {
+	case x == 0 || math.IsInf(x, 0):
+		return s, s
+	case x < exactErrorMin:
+		return enclose(s, math.NaN())
+	}
+	return enclose(s, math.FMA(-s, s, x))
+}
+
+// libBounds encloses the exact value of a math library result v by widening
+// it libULPs outward, unless it is known to be exact.
+func libBounds(v float64, exact bool) (float64, float64) {
+	lo, hi := v, v
+	if exact {
+		return lo, hi
+	}
+	for range libULPs {
+		lo = math.Nextafter(lo, math.Inf(-1))
+		hi = math.Nextafter(hi, math.Inf(1))
+	}
+	return lo, hi
+}
+
+func intervalAdd(a, b interval) interval {
+	lo, _ := addBounds(a.lo, b.lo)
+	_, hi := addBounds(a.hi, b.hi)
+	return interval{lo, hi}
+}
+
+func intervalSub(a, b interval) interval {
+	lo, _ := addBounds(a.lo, -b.hi)
+	_, hi := addBounds(a.hi, -b.lo)
+	return interval{lo, hi}
+}
+
+func intervalMul(a, b interval) interval {
+	result := interval{math.Inf(1), math.Inf(-1)}
+	for _, x := range []float64{a.lo, a.hi} {
+		for _, y := range []float64{b.lo, b.hi} {
+			lo, hi := mulBounds(x, y)
+			result.lo = math.Min(result.lo, lo)
+			result.hi = math.Max(result.hi, hi)
+		}
+	}
+	return result
+}
+
+// intervalDiv encloses a / b. When b contains 0 the quotient is unbounded
+// and may be the union of two intervals.
+func intervalDiv(a, b interval) ([]interval, error) {
+	inf := math.Inf(1)
+	if !b.contains(0) {
+		result := interval{inf, -inf}
+		for _, x := range []float64{a.lo, a.hi} {
+			for _, y := range []float64{b.lo, b.hi} {
+				if math.IsInf(x, 0) && math.IsInf(y, 0) {
+					// The other corners bound the quotient
+					continue
+				}
+				lo, hi := divBounds(x, y)
+				result.lo = math.Min(result.lo, lo)
+				result.hi = math.Max(result.hi, hi)
+			}
+		}
+		return []interval{result}, nil
+	}
+
+	switch {
+	case b.lo == 0 && b.hi == 0:
+		return nil, errors.New("division by [0, 0] is undefined")
+	case a.contains(0):
+		return []interval{{-inf, inf}}, nil
+	case a.hi < 0:
+		// Quotients of a.hi, the endpoint nearest zero, bound the result
+		_, below := divBounds(a.hi, b.hi)
+		above, _ := divBounds(a.hi, b.lo)
+		switch {
+		case b.hi == 0:
+			return []interval{{above, inf}}, nil
+		case b.lo == 0:
+			return []interval{{-inf, below}}, nil
+		}
+		return []interval{{-inf, below}, {above, inf}}, nil
+	default:
+		_, below := divBounds(a.lo, b.lo)
+		above, _ := divBounds(a.lo, b.hi)
+		switch {
+		case b.hi == 0:
+			return []interval{{-inf, below}}, nil
+		case b.lo == 0:
+			return []interval{{above, inf}}, nil
+		}
+		return []interval{{-inf, below}, {above, inf}}, nil
+	}
+}
+
+// powNonnegative encloses a^n for a ≥ 0 and n ≥ 1 by repeated squaring,
+// rounding the lower bound down and the upper bound up at every step.
+func powNonnegative(a float64, n uint64) (float64, float64) {
+	lo, hi := 1.0, 1.0
+	baseLo, baseHi := a, a
+	for n > 0 {
+		if n&1 == 1 {
+			lo, _ = mulBounds(lo, baseLo)
+			_, hi = mulBounds(hi, baseHi)
+		}
+		n >>= 1
+		if n > 0 {
+			baseLo, _ = mulBounds(baseLo, baseLo)
+			_, baseHi = mulBounds(baseHi, baseHi)
+		}
+	}
+	return lo, hi
+}
+
+// intervalPowInt encloses x^n for an integer n.
+func intervalPowInt(x interval, n int64) ([]interval, error) {
+	if n == 0 {
+		return []interval{{1, 1}}, nil
+	}
+	m := uint64(n)
+	if n < 0 {
+		m = uint64(-n)
+	}
+
+	var p interval
+	switch {
+	case x.lo >= 0:
+		p.lo, _ = powNonnegative(x.lo, m)
+		_, p.hi = powNonnegative(x.hi, m)
+	case m%2 == 1:
+		// Odd powers are increasing: (−a)^m = −(a^m)
+		_, hi := powNonnegative(-x.lo, m)
+		p.lo = -hi
+		if x.hi >= 0 {
+			_, p.hi = powNonnegative(x.hi, m)
+		} else {
+			lo, _ := powNonnegative(-x.hi, m)
+			p.hi = -lo
+		}
+	case x.hi <= 0:
+		p.lo, _ = powNonnegative(-x.hi, m)
+		_, p.hi = powNonnegative(-x.lo, m)
+	default:
+		_, p.hi = powNonnegative(math.Max(-x.lo, x.hi), m)
+	}
+
+	if n < 0 {
+		return intervalDiv(interval{1, 1}, p)
+	}
+	return []interval{p}, nil
+}
+
+// intervalPow encloses x^y. An integer point exponent allows any base;
+// otherwise x^y = exp(y·ln x) is only defined for x ≥ 0, and the negative
+// part of x is ignored.
+func intervalPow(x, y interval) ([]interval, error) {
+	if y.isPoint() && math.Trunc(y.lo) == y.lo && math.Abs(y.lo) <= 1<<53 {
+		return intervalPowInt(x, int64(y.lo))
+	}
+	if x.hi < 0 {
+		return nil, fmt.Errorf("x^y with non-integer y requires x ≥ 0, got x = %s", x)
+	}
+	x.lo = math.Max(x.lo, 0)
+	if x.hi == 0 {
+		if y.lo > 0 {
+			return []interval{{0, 0}}, nil
+		}
+		return nil, fmt.Errorf("0^y is undefined for y ≤ 0 in y = %s", y)
+	}
+	ln, err := intervalLog(x)
+	if err != nil {
+		return nil, err
+	}
+	return []interval{intervalExp(intervalMul(y, ln))}, nil
+}
+
+func intervalSqrt(x interval) (interval, error) {
+	if x.hi < 0 {
+		return interval{}, fmt.Errorf("sqrt is undefined on %s", x)
+	}
+	lo, _ := sqrtBounds(math.Max(x.lo, 0))
+	_, hi := sqrtBounds(x.hi)
+	return interval{lo, hi}, nil
+}
+
+func intervalExp(x interval) interval {
+	lo, _ := libBounds(math.Exp(x.lo), x.lo == 0 || math.IsInf(x.lo, 0))
+	_, hi := libBounds(math.Exp(x.hi), x.hi == 0 || math.IsInf(x.hi, 0))
+	return interval{math.Max(lo, 0), hi}
+}
+
+// intervalLog encloses ln x, which is unbounded below when x reaches 0.
+func intervalLog(x interval) (interval, error) {
+	if x.hi <= 0 {
+		return interval{}, fmt.Errorf("log is undefined on %s", x)
+	}
+	lo := math.Inf(-1)
+	if x.lo > 0 {
+		lo, _ = libBounds(math.Log(x.lo), x.lo == 1 || math.IsInf(x.lo, 0))
+	}
+	_, hi := libBounds(math.Log(x.hi), x.hi == 1 || math.IsInf(x.hi, 0))
+	return interval{lo, hi}, nil
+}
+
+// containsPeriodicPoint reports whether x may contain c + 2kπ for some
+// integer k. Points within rounding distance of x count as contained, so
+// that the answer errs on the side of a wider enclosure.
+func containsPeriodicPoint(x interval, c float64) bool {
+	margin := 8 * epsilon * math.Max(1, math.Max(math.Abs(x.lo), math.Abs(x.hi)))
+	k := math.Ceil((x.lo - margin - c) / (2 * math.Pi))
+	return c+2*math.Pi*k <= x.hi+margin
+}
+
+// intervalPeriodic encloses f over x for f = sin or cos, whose maxima are at
+// peak + 2kπ and minima at peak + π + 2kπ.
+func intervalPeriodic(f func(float64) float64, peak float64, x interval) interval {
+	if math.IsInf(x.lo, 0) || math.IsInf(x.hi, 0) || x.hi-x.lo >= 2*math.Pi {
+		return interval{-1, 1}
+	}
+	// sin(0) = 0 and cos(0) = 1 are exact
+	loA, hiA := libBounds(f(x.lo), x.lo == 0)
+	loB, hiB := libBounds(f(x.hi), x.hi == 0)
+	result := interval{math.Min(loA, loB), math.Max(hiA, hiB)}
+	if containsPeriodicPoint(x, peak) {
+		result.hi = 1
+	}
+	if containsPeriodicPoint(x, peak+math.Pi) {
+		result.lo = -1
+	}
+	return interval{math.Max(result.lo, -1), math.Min(result.hi, 1)}
+}
+
+func intervalSin(x interval) interval {
+	return intervalPeriodic(math.Sin, math.Pi/2, x)
+}
+
+func intervalCos(x interval) interval {
+	return intervalPeriodic(math.Cos, 0, x)
+}
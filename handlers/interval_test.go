@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalTools(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"add exact", intervalAddHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{3.0, 4.0}}, "[4, 6]"},
+		{"add rounds outward", intervalAddHandler, map[string]any{"a": 0.1, "b": 0.2}, "[0.3, 0.30000000000000004]"},
+		{"add point and interval", intervalAddHandler, map[string]any{"a": 1.0, "b": []any{2.0, 3.0}}, "[3, 4]"},
+		{"add unbounded", intervalAddHandler, map[string]any{"a": []any{math.Inf(-1), 0.0}, "b": []any{1.0, 2.0}}, "[-Inf, 2]"},
+		{"subtract", intervalSubtractHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{3.0, 5.0}}, "[-4, -1]"},
+		{"multiply mixed signs", intervalMultiplyHandler, map[string]any{"a": []any{-1.0, 2.0}, "b": []any{3.0, 4.0}}, "[-4, 8]"},
+		{"multiply zero by unbounded", intervalMultiplyHandler, map[string]any{"a": []any{0.0, 0.0}, "b": []any{1.0, math.Inf(1)}}, "[0, 0]"},
+		{"divide", intervalDivideHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{4.0, 8.0}}, "[0.125, 0.5]"},
+		{"divide rounds outward", intervalDivideHandler, map[string]any{"a": 1.0, "b": 3.0}, "[0.3333333333333333, 0.33333333333333337]"},
+		{"divide by interval straddling zero", intervalDivideHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{-1.0, 1.0}}, "[-Inf, -1] ∪ [1, +Inf]"},
+		{"divide by interval ending at zero", intervalDivideHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{-4.0, 0.0}}, "[-Inf, -0.25]"},
+		{"divide by interval starting at zero", intervalDivideHandler, map[string]any{"a": []any{1.0, 2.0}, "b": []any{0.0, 4.0}}, "[0.25, +Inf]"},
+		{"divide negative by zero interval", intervalDivideHandler, map[string]any{"a": []any{-2.0, -1.0}, "b": []any{-1.0, 2.0}}, "[-Inf, -0.5] ∪ [1, +Inf]"},
+		{"divide zero-containing intervals", intervalDivideHandler, map[string]any{"a": []any{-1.0, 1.0}, "b": []any{-1.0, 1.0}}, "[-Inf, +Inf]"},
+		{"divide by unbounded", intervalDivideHandler, map[string]any{"a": []any{1.0, math.Inf(1)}, "b": []any{1.0, math.Inf(1)}}, "[0, +Inf]"},
+		{"even power", intervalPowHandler, map[string]any{"x": []any{-2.0, 3.0}, "y": 2.0}, "[0, 9]"},
+		{"odd power of negative", intervalPowHandler, map[string]any{"x": []any{-2.0, -1.0}, "y": 3.0}, "[-8, -1]"},
+		{"negative power", intervalPowHandler, map[string]any{"x": []any{-1.0, 1.0}, "y": -1.0}, "[-Inf, -1] ∪ [1, +Inf]"},
+		{"negative even power", intervalPowHandler, map[string]any{"x": []any{-1.0, 2.0}, "y": -2.0}, "[0.25, +Inf]"},
+		{"zeroth power", intervalPowHandler, map[string]any{"x": []any{-5.0, 5.0}, "y": 0.0}, "[1, 1]"},
+		{"sqrt exact", intervalSqrtHandler, map[string]any{"x": []any{4.0, 9.0}}, "[2, 3]"},
+		{"sqrt clips domain", intervalSqrtHandler, map[string]any{"x": []any{-1.0, 4.0}}, "[0, 2]"},
+		{"exp of zero", intervalExpHandler, map[string]any{"x": 0.0}, "[1, 1]"},
+		{"exp unbounded below", intervalExpHandler, map[string]any{"x": []any{math.Inf(-1), 0.0}}, "[0, 1]"},
+		{"log of one", intervalLogHandler, map[string]any{"x": 1.0}, "[0, 0]"},
+		{"log from zero", intervalLogHandler, map[string]any{"x": []any{0.0, 1.0}}, "[-Inf, 0]"},
+		{"sin over a maximum", intervalSinHandler, map[string]any{"x": []any{0.0, 3.0}}, "[0, 1]"},
+		{"sin full period", intervalSinHandler, map[string]any{"x": []any{0.0, 7.0}}, "[-1, 1]"},
+		{"cos over a minimum", intervalCosHandler, map[string]any{"x": []any{0.0, 4.0}}, "[-1, 1]"},
+		{"cos of zero", intervalCosHandler, map[string]any{"x": 0.0}, "[1, 1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestIntervalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+	}{
+		{"missing argument", intervalAddHandler, map[string]any{"a": []any{1.0, 2.0}}},
+		{"one bound", intervalAddHandler, map[string]any{"a": []any{1.0}, "b": 1.0}},
+		{"reversed bounds", intervalAddHandler, map[string]any{"a": []any{2.0, 1.0}, "b": 1.0}},
+		{"empty at infinity", intervalAddHandler, map[string]any{"a": []any{math.Inf(1), math.Inf(1)}, "b": 1.0}},
+		{"divide by zero", intervalDivideHandler, map[string]any{"a": []any{1.0, 2.0}, "b": 0.0}},
+		{"fractional power of negative", intervalPowHandler, map[string]any{"x": []any{-4.0, -1.0}, "y": 0.5}},
+		{"zero to non-positive power", intervalPowHandler, map[string]any{"x": 0.0, "y": []any{-1.0, 1.0}}},
+		{"sqrt of negative", intervalSqrtHandler, map[string]any{"x": []any{-4.0, -1.0}}},
+		{"log of non-positive", intervalLogHandler, map[string]any{"x": []any{-1.0, 0.0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestIntervalEnclosesTranscendentals(t *testing.T) {
+	ln, err := intervalLog(interval{2, 10})
+	require.NoError(t, err)
+	root, err := intervalPow(interval{4, 9}, interval{0.5, 0.5})
+	require.NoError(t, err)
+	unbounded, err := intervalPow(interval{2, 2}, interval{1, math.Inf(1)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		got   interval
+		exact []float64
+	}{
+		{"exp", intervalExp(interval{0, 1}), []float64{1, math.E}},
+		{"log", ln, []float64{math.Ln2, math.Ln10}},
+		{"sin", intervalSin(interval{-0.5, 0.5}), []float64{math.Sin(-0.5), math.Sin(0.5)}},
+		{"cos", intervalCos(interval{1, 2}), []float64{math.Cos(2), math.Cos(1)}},
+		{"pow", root[0], []float64{2, 3}},
+		{"pow unbounded exponent", unbounded[0], []float64{2, math.Inf(1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.LessOrEqual(t, tt.got.lo, tt.exact[0])
+			assert.GreaterOrEqual(t, tt.got.hi, tt.exact[1])
+			// Widening stays within a few ulps of the exact bounds
+			assert.InDelta(t, tt.exact[0], tt.got.lo, 1e-14)
+			if !math.IsInf(tt.exact[1], 0) {
+				assert.InDelta(t, tt.exact[1], tt.got.hi, 1e-14)
+			}
+		})
+	}
+}
+
+// randomFloat returns a float with a random sign, mantissa and exponent.
+func randomFloat(rng *rand.Rand) float64 {
+	v := math.Ldexp(1+rng.Float64(), rng.Intn(200)-100)
+	if rng.Intn(2) == 0 {
+		return -v
+	}
+	return v
+}
+
+// exact returns v as an exact big.Float.
+func exact(v float64) *big.Float {
+	return new(big.Float).SetPrec(2048).SetFloat64(v)
+}
+
+func TestIntervalBoundsAreRigorous(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for range 10000 {
+		a, b := randomFloat(rng), randomFloat(rng)
+
+		lo, hi := addBounds(a, b)
+		sum := new(big.Float).SetPrec(2048).Add(exact(a), exact(b))
+		require.True(t, exact(lo).Cmp(sum) <= 0 && sum.Cmp(exact(hi)) <= 0, "%g + %g not in [%g, %g]", a, b, lo, hi)
+		require.LessOrEqual(t, hi, math.Nextafter(lo, math.Inf(1)))
+
+		lo, hi = mulBounds(a, b)
+		product := new(big.Float).SetPrec(2048).Mul(exact(a), exact(b))
+		require.True(t, exact(lo).Cmp(product) <= 0 && product.Cmp(exact(hi)) <= 0, "%g * %g not in [%g, %g]", a, b, lo, hi)
+		require.LessOrEqual(t, hi, math.Nextafter(lo, math.Inf(1)))
+
+		// lo ≤ a/b ≤ hi  ⇔  lo·b ≤ a ≤ hi·b for b > 0
+		lo, hi = divBounds(a, b)
+		sb, sa := exact(b), exact(a)
+		if b < 0 {
+			sb.Neg(sb)
+			sa.Neg(sa)
+		}
+		loB := new(big.Float).SetPrec(2048).Mul(exact(lo), sb)
+		hiB := new(big.Float).SetPrec(2048).Mul(exact(hi), sb)
+		require.True(t, loB.Cmp(sa) <= 0 && sa.Cmp(hiB) <= 0, "%g / %g not in [%g, %g]", a, b, lo, hi)
+		require.LessOrEqual(t, hi, math.Nextafter(lo, math.Inf(1)))
+
+		// lo² ≤ x ≤ hi²
+		x := math.Abs(a)
+		lo, hi = sqrtBounds(x)
+		lo2 := new(big.Float).SetPrec(2048).Mul(exact(lo), exact(lo))
+		hi2 := new(big.Float).SetPrec(2048).Mul(exact(hi), exact(hi))
+		require.True(t, lo2.Cmp(exact(x)) <= 0 && exact(x).Cmp(hi2) <= 0, "sqrt(%g) not in [%g, %g]", x, lo, hi)
+	}
+}
+
+func TestIntervalBoundsExtremes(t *testing.T) {
+	// Overflow leaves the largest finite number as the lower bound
+	lo, hi := mulBounds(math.MaxFloat64, 2)
+	assert.Equal(t, math.MaxFloat64, lo)
+	assert.True(t, math.IsInf(hi, 1))
+
+	// Underflow to zero keeps the sign of the exact product
+	lo, hi = mulBounds(-0x1p-600, 0x1p-600)
+	assert.Equal(t, -math.SmallestNonzeroFloat64, lo)
+	assert.Equal(t, 0.0, hi)
+
+	// Subnormal results are still enclosed
+	lo, hi = divBounds(0x1p-1070, 3)
+	assert.Less(t, lo, 0x1p-1070/3)
+	assert.Greater(t, hi, 0x1p-1070/3)
+}
+
+func TestIntervalSessionReferences(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "w", "value": 2.0})
+	result := callTool(t, r, ctx, "interval_multiply", map[string]any{"a": []any{"$w", 3.0}, "b": "$w"})
+
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "[4, 6]", resultText(result))
+}
@@ -0,0 +1,827 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/linalg"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxMatrixPower caps the exponent of matrix_power.
+	maxMatrixPower = 1 << 30
+	// illConditioned is the condition number from which solve_linear_system
+	// warns that the solution has lost most of its accuracy.
+	illConditioned = 1e10
+)
+
+// registerLinearAlgebra registers matrix tools.
+func (r *Registry) registerLinearAlgebra() {
+	cat := config.CategoryLinearAlgebra
+
+	// Add
+	r.addTool(
+		mcp.NewTool("matrix_add",
+			mcp.WithDescription("Sum of two matrices of the same shape"),
+			matrixParam("a", "First matrix"),
+			matrixParam("b", "Second matrix"),
+		),
+		matrixAddHandler,
+		cat,
+	)
+
+	// Multiply
+	r.addTool(
+		mcp.NewTool("matrix_multiply",
+			mcp.WithDescription("Matrix product a·b; a must have as many columns as b has rows"),
+			matrixParam("a", "Left matrix"),
+			matrixParam("b", "Right matrix"),
+		),
+		matrixMultiplyHandler,
+		cat,
+	)
+
+	// Transpose
+	r.addTool(
+		mcp.NewTool("matrix_transpose",
+			mcp.WithDescription("Transpose of a matrix"),
+			matrixParam("matrix", "Matrix"),
+		),
+		matrixTransposeHandler,
+		cat,
+	)
+
+	// Determinant
+	r.addTool(
+		mcp.NewTool("matrix_determinant",
+			mcp.WithDescription("Determinant of a square matrix, computed by LU decomposition with partial pivoting"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		matrixDeterminantHandler,
+		cat,
+	)
+
+	// Inverse
+	r.addTool(
+		mcp.NewTool("matrix_inverse",
+			mcp.WithDescription("Inverse of a square matrix. Fails if the matrix is singular to working precision"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		matrixInverseHandler,
+		cat,
+	)
+
+	// Trace
+	r.addTool(
+		mcp.NewTool("matrix_trace",
+			mcp.WithDescription("Trace (sum of the diagonal) of a square matrix"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		matrixTraceHandler,
+		cat,
+	)
+
+	// Rank
+	r.addTool(
+		mcp.NewTool("matrix_rank",
+			mcp.WithDescription("Numerical rank of a matrix"),
+			matrixParam("matrix", "Matrix"),
+			mcp.WithNumber("tolerance", mcp.Description("Pivots up to this magnitude count as zero (default max(rows, cols)·ε·max|aᵢⱼ|)")),
+		),
+		matrixRankHandler,
+		cat,
+	)
+
+	// Norm
+	r.addTool(
+		mcp.NewTool("matrix_norm",
+			mcp.WithDescription("Matrix norm: Frobenius, 1 (maximum column sum) or inf (maximum row sum)"),
+			matrixParam("matrix", "Matrix"),
+			mcp.WithString("type", mcp.Enum("frobenius", "1", "inf"), mcp.Description("Norm type (default frobenius)")),
+		),
+		matrixNormHandler,
+		cat,
+	)
+
+	// Power
+	r.addTool(
+		mcp.NewTool("matrix_power",
+			mcp.WithDescription("Integer power of a square matrix; negative powers are powers of the inverse"),
+			matrixParam("matrix", "Square matrix"),
+			mcp.WithNumber("n", mcp.Required(), mcp.Description("Integer exponent")),
+		),
+		matrixPowerHandler,
+		cat,
+	)
+
+	// Solve
+	r.addTool(
+		mcp.NewTool("solve_linear_system",
+			mcp.WithDescription("Solve the square system Ax = b by LU decomposition with partial pivoting, reporting the condition number of A"),
+			matrixParam("a", "Square coefficient matrix"),
+			mcp.WithArray("b", mcp.Required(), mcp.Description("Right-hand side, one entry per row of a"), mcp.WithNumberItems()),
+		),
+		solveLinearSystemHandler,
+		cat,
+	)
+
+	// Least squares
+	r.addTool(
+		mcp.NewTool("least_squares",
+			mcp.WithDescription("Minimum-norm least-squares solution x minimizing ‖Ax − b‖₂, for overdetermined, underdetermined or rank-deficient systems"),
+			matrixParam("a", "Coefficient matrix"),
+			mcp.WithArray("b", mcp.Required(), mcp.Description("Right-hand side, one entry per row of a"), mcp.WithNumberItems()),
+		),
+		leastSquaresHandler,
+		cat,
+	)
+
+	// LU
+	r.addTool(
+		mcp.NewTool("lu_decomposition",
+			mcp.WithDescription("LU decomposition PA = LU with partial pivoting: P permutation, L unit lower triangular, U upper triangular"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		luDecompositionHandler,
+		cat,
+	)
+
+	// QR
+	r.addTool(
+		mcp.NewTool("qr_decomposition",
+			mcp.WithDescription("QR decomposition A = QR by Householder reflections: Q orthogonal, R upper triangular"),
+			matrixParam("matrix", "Matrix"),
+		),
+		qrDecompositionHandler,
+		cat,
+	)
+
+	// Cholesky
+	r.addTool(
+		mcp.NewTool("cholesky_decomposition",
+			mcp.WithDescription("Cholesky decomposition A = LLᵀ of a symmetric positive definite matrix, L lower triangular"),
+			matrixParam("matrix", "Symmetric positive definite matrix"),
+		),
+		choleskyDecompositionHandler,
+		cat,
+	)
+
+	// SVD
+	r.addTool(
+		mcp.NewTool("svd",
+			mcp.WithDescription("Thin singular value decomposition A = U·diag(S)·Vᵀ, singular values in decreasing order"),
+			matrixParam("matrix", "Matrix"),
+		),
+		svdHandler,
+		cat,
+	)
+
+	// Eigenvalues
+	r.addTool(
+		mcp.NewTool("eigenvalues",
+			mcp.WithDescription("Eigenvalues of a square matrix, real or complex, sorted by decreasing real part. Symmetric matrices use the Jacobi method, others the shifted QR algorithm"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		eigenvaluesHandler,
+		cat,
+	)
+
+	// Eigenvectors
+	r.addTool(
+		mcp.NewTool("eigenvectors",
+			mcp.WithDescription("Eigenvalues of a square matrix with unit eigenvectors, each scaled so its largest component is real and positive"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		eigenvectorsHandler,
+		cat,
+	)
+
+	// Characteristic polynomial
+	r.addTool(
+		mcp.NewTool("characteristic_polynomial",
+			mcp.WithDescription("Characteristic polynomial det(λI − A) of a square matrix, with coefficients from the highest degree down"),
+			matrixParam("matrix", "Square matrix"),
+		),
+		characteristicPolynomialHandler,
+		cat,
+	)
+
+	// Sparse matrix-vector product
+	r.addTool(
+		mcp.NewTool("sparse_matvec",
+			mcp.WithDescription("Product Ax of a sparse matrix and a vector"),
+			sparseMatrixParam("matrix", "Sparse matrix"),
+			mcp.WithArray("x", mcp.Required(), mcp.Description("Vector, one entry per column of the matrix"), mcp.WithNumberItems()),
+		),
+		sparseMatVecHandler,
+		cat,
+	)
+
+	// Sparse solve
+	r.addTool(
+		mcp.NewTool("sparse_solve",
+			mcp.WithDescription("Solve the square sparse system Ax = b iteratively by conjugate gradient (symmetric positive definite A) or restarted GMRES, reporting iterations and the relative residual ‖b − Ax‖/‖b‖"),
+			sparseMatrixParam("matrix", "Square sparse matrix"),
+			mcp.WithArray("b", mcp.Required(), mcp.Description("Right-hand side, one entry per row of the matrix"), mcp.WithNumberItems()),
+			mcp.WithString("method", mcp.Enum("auto", "cg", "gmres"), mcp.Description("Solver (default auto: cg for symmetric matrices, otherwise gmres)")),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Target relative residual (default %g)", defaultSparseTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit (default 10 per unknown, max %d)", maxSparseIterations))),
+			mcp.WithNumber("restart", mcp.Description(fmt.Sprintf("GMRES restart length, 1 to %d (default %d)", maxGMRESRestart, defaultGMRESRestart))),
+		),
+		sparseSolveHandler,
+		cat,
+	)
+
+	// Sparse structure
+	r.addTool(
+		mcp.NewTool("sparse_info",
+			mcp.WithDescription("Structure report for a sparse matrix: nonzeros, density, nonzeros per row, empty rows and columns, bandwidth, symmetry and diagonal dominance"),
+			sparseMatrixParam("matrix", "Sparse matrix"),
+		),
+		sparseInfoHandler,
+		cat,
+	)
+}
+
+// matrixParam declares a matrix argument.
+func matrixParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name,
+		mcp.Required(),
+		mcp.Description(description+" as an array of rows, e.g. [[1, 2], [3, 4]]"),
+		mcp.Items(map[string]any{
+			"type":  "array",
+			"items": map[string]any{"type": "number"},
+		}),
+	)
+}
+
+// matrixArg reads a matrix argument given as an array of rows.
+func matrixArg(req mcp.CallToolRequest, name string) (*linalg.Matrix, error) {
+	raw, ok := req.GetArguments()[name]
+	if !ok {
+		return nil, fmt.Errorf("required argument %q not found", name)
+	}
+	rows, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of rows", name)
+	}
+	parsed := make([][]float64, len(rows))
+	for i, row := range rows {
+		items, ok := row.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: row %d must be an array of numbers", name, i)
+		}
+		parsed[i] = make([]float64, len(items))
+		for j, item := range items {
+			v, ok := item.(float64)
+			if !ok {
+				return nil, fmt.Errorf("%s[%d][%d] must be a number", name, i, j)
+			}
+			parsed[i][j] = v
+		}
+	}
+	m, err := linalg.FromRows(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return m, nil
+}
+
+// squareMatrixArg reads a matrix argument that must be square.
+func squareMatrixArg(req mcp.CallToolRequest, name string) (*linalg.Matrix, error) {
+	m, err := matrixArg(req, name)
+	if err != nil {
+		return nil, err
+	}
+	if !m.IsSquare() {
+		return nil, fmt.Errorf("%s must be square, got %s", name, m.Shape())
+	}
+	return m, nil
+}
+
+// vectorArg reads a vector argument whose length must match the rows of a.
+func vectorArg(req mcp.CallToolRequest, name string, a *linalg.Matrix) ([]float64, error) {
+	v, err := req.RequireFloatSlice(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) != a.Rows {
+		return nil, fmt.Errorf("%s has %d entries but the matrix has %d rows", name, len(v), a.Rows)
+	}
+	return v, nil
+}
+
+// formatMatrix formats m as a JSON array with one row per line.
+func formatMatrix(m *linalg.Matrix) string {
+	var b strings.Builder
+	b.WriteString("[")
+	for i := range m.Rows {
+		if i > 0 {
+			b.WriteString(",\n ")
+		}
+		b.WriteString("[")
+		for j := range m.Cols {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%g", m.At(i, j))
+		}
+		b.WriteString("]")
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// allFinite reports whether every value is finite, and so representable in JSON.
+func allFinite(values []float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkMatrixIEEE applies the policy in ctx to every entry of m.
+func checkMatrixIEEE(ctx context.Context, m *linalg.Matrix, inputs ...*linalg.Matrix) error {
+	var in []float64
+	for _, x := range inputs {
+		in = append(in, x.Data...)
+	}
+	for _, v := range m.Data {
+		if err := checkIEEE(ctx, v, in...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matrixResult formats m after applying the policy in ctx to its entries.
+// Finite matrices are also returned as structured content.
+func matrixResult(ctx context.Context, m *linalg.Matrix, inputs ...*linalg.Matrix) *mcp.CallToolResult {
+	if err := checkMatrixIEEE(ctx, m, inputs...); err != nil {
+		return ieeeErrorResult(err)
+	}
+	result := mcp.NewToolResultText(formatMatrix(m))
+	if allFinite(m.Data) {
+		result.StructuredContent = map[string]any{"matrix": m.ToRows()}
+	}
+	return result
+}
+
+func matrixAddHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, err := matrixArg(req, "a")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	b, err := matrixArg(req, "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	sum, err := linalg.Add(a, b)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return matrixResult(ctx, sum, a, b), nil
+}
+
+func matrixMultiplyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, err := matrixArg(req, "a")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	b, err := matrixArg(req, "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	product, err := linalg.Mul(a, b)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return matrixResult(ctx, product, a, b), nil
+}
+
+func matrixTransposeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := matrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return matrixResult(ctx, m.T(), m), nil
+}
+
+func matrixDeterminantHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	det, _ := m.Det()
+	return floatResult(ctx, det, m.Data...), nil
+}
+
+func matrixInverseHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	inv, err := m.Inverse()
+	if errors.Is(err, linalg.ErrSingular) {
+		return mcp.NewToolResultError("matrix is singular and has no inverse"), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return matrixResult(ctx, inv, m), nil
+}
+
+func matrixTraceHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	trace, _ := m.Trace()
+	return floatResult(ctx, trace, m.Data...), nil
+}
+
+func matrixRankHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := matrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(m.Data) {
+		return mcp.NewToolResultError("matrix entries must be finite"), nil
+	}
+	tol := req.GetFloat("tolerance", -1)
+	if _, set := req.GetArguments()["tolerance"]; set && tol < 0 {
+		return mcp.NewToolResultError("tolerance must not be negative"), nil
+	}
+	return floatResult(ctx, float64(m.Rank(tol))), nil
+}
+
+func matrixNormHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := matrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	var norm float64
+	switch kind := req.GetString("type", "frobenius"); kind {
+	case "frobenius":
+		norm = m.NormFrobenius()
+	case "1":
+		norm = m.Norm1()
+	case "inf":
+		norm = m.NormInf()
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown norm type %q: must be frobenius, 1 or inf", kind)), nil
+	}
+	return floatResult(ctx, norm, m.Data...), nil
+}
+
+func matrixPowerHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	n, err := req.RequireFloat("n")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if n != math.Trunc(n) || math.Abs(n) > maxMatrixPower {
+		return mcp.NewToolResultError(fmt.Sprintf("n must be an integer between -%d and %d", maxMatrixPower, maxMatrixPower)), nil
+	}
+	p, err := m.Pow(int(n))
+	if errors.Is(err, linalg.ErrSingular) {
+		return mcp.NewToolResultError("matrix is singular, so negative powers are undefined"), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return matrixResult(ctx, p, m), nil
+}
+
+// factor is one named matrix or vector of a decomposition.
+type factor struct {
+	// name is the key in the structured content and, with underscores as
+	// spaces, the label in the text.
+	name   string
+	matrix *linalg.Matrix
+	vector []float64
+}
+
+// factorsResult formats the factors of a decomposition of m, one per
+// paragraph, and returns them as structured content keyed by name.
+func factorsResult(ctx context.Context, m *linalg.Matrix, factors ...factor) *mcp.CallToolResult {
+	var text []string
+	structured := make(map[string]any, len(factors))
+	for _, f := range factors {
+		label := strings.ReplaceAll(f.name, "_", " ")
+		if f.matrix != nil {
+			if err := checkMatrixIEEE(ctx, f.matrix, m); err != nil {
+				return ieeeErrorResult(err)
+			}
+			text = append(text, label+" =\n"+formatMatrix(f.matrix))
+			structured[f.name] = f.matrix.ToRows()
+			continue
+		}
+		text = append(text, label+" = "+formatFloats(f.vector))
+		structured[f.name] = f.vector
+	}
+	result := mcp.NewToolResultText(strings.Join(text, "\n\n"))
+	result.StructuredContent = structured
+	return result
+}
+
+func solveLinearSystemHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, err := squareMatrixArg(req, "a")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	b, err := vectorArg(req, "b", a)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(a.Data) || !allFinite(b) {
+		return mcp.NewToolResultError("a and b must be finite"), nil
+	}
+	lu := linalg.NewLU(a)
+	x, err := lu.Solve(&linalg.Matrix{Rows: len(b), Cols: 1, Data: b})
+	if errors.Is(err, linalg.ErrSingular) {
+		return mcp.NewToolResultError("matrix is singular, so the system has no unique solution; try least_squares"), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := checkMatrixIEEE(ctx, x, a); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+
+	cond := lu.Cond()
+	text := fmt.Sprintf("x = %s\ncondition number (1-norm): %g", formatFloats(x.Data), cond)
+	if cond >= illConditioned {
+		text += fmt.Sprintf("\nwarning: the matrix is ill-conditioned; about %d significant digits of x may be lost", int(math.Log10(cond)))
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{"x": x.Data, "condition_number": cond}
+	return result, nil
+}
+
+func leastSquaresHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, err := matrixArg(req, "a")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	b, err := vectorArg(req, "b", a)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(a.Data) || !allFinite(b) {
+		return mcp.NewToolResultError("a and b must be finite"), nil
+	}
+	x, rank := linalg.NewSVD(a).LeastSquares(b, -1)
+	xm := &linalg.Matrix{Rows: len(x), Cols: 1, Data: x}
+	if err := checkMatrixIEEE(ctx, xm, a); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+
+	ax, _ := linalg.Mul(a, xm)
+	var residual float64
+	for i, v := range ax.Data {
+		residual = math.Hypot(residual, v-b[i])
+	}
+	text := fmt.Sprintf("x = %s\nresidual norm: %g\nrank: %d", formatFloats(x), residual, rank)
+	if rank < a.Cols {
+		text += " (rank deficient; x is the minimum-norm solution)"
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{"x": x, "residual_norm": residual, "rank": rank}
+	return result, nil
+}
+
+func luDecompositionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(m.Data) {
+		return mcp.NewToolResultError("matrix entries must be finite"), nil
+	}
+	lu := linalg.NewLU(m)
+	return factorsResult(ctx, m,
+		factor{name: "P", matrix: lu.P()},
+		factor{name: "L", matrix: lu.L()},
+		factor{name: "U", matrix: lu.U()},
+	), nil
+}
+
+func qrDecompositionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := matrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(m.Data) {
+		return mcp.NewToolResultError("matrix entries must be finite"), nil
+	}
+	qr := linalg.NewQR(m)
+	return factorsResult(ctx, m,
+		factor{name: "Q", matrix: qr.Q},
+		factor{name: "R", matrix: qr.R},
+	), nil
+}
+
+func choleskyDecompositionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(m.Data) {
+		return mcp.NewToolResultError("matrix entries must be finite"), nil
+	}
+	l, err := linalg.NewCholesky(m)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return factorsResult(ctx, m, factor{name: "L", matrix: l}), nil
+}
+
+func svdHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := matrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !allFinite(m.Data) {
+		return mcp.NewToolResultError("matrix entries must be finite"), nil
+	}
+	svd := linalg.NewSVD(m)
+	return factorsResult(ctx, m,
+		factor{name: "U", matrix: svd.U},
+		factor{name: "singular_values", vector: svd.S},
+		factor{name: "V", matrix: svd.V},
+	), nil
+}
+
+// eigenArg reads a finite square matrix and computes its eigendecomposition.
+func eigenArg(req mcp.CallToolRequest, name string) (*linalg.Eigen, error) {
+	m, err := squareMatrixArg(req, name)
+	if err != nil {
+		return nil, err
+	}
+	if !allFinite(m.Data) {
+		return nil, errors.New("matrix entries must be finite")
+	}
+	e, err := linalg.NewEigen(m)
+	if errors.Is(err, linalg.ErrNoConvergence) {
+		return nil, errors.New("eigenvalue iteration did not converge")
+	}
+	return e, err
+}
+
+// formatRealOrComplex formats a real value as a number and a complex one
+// as a + bi.
+func formatRealOrComplex(c complex128) string {
+	if imag(c) == 0 {
+		return fmt.Sprintf("%g", real(c))
+	}
+	return formatComplex(c)
+}
+
+// formatComplexVector formats v as a list of real or complex entries.
+func formatComplexVector(v []complex128) string {
+	strs := make([]string, len(v))
+	for i, c := range v {
+		strs[i] = formatRealOrComplex(c)
+	}
+	return "[" + strings.Join(strs, ", ") + "]"
+}
+
+// complexJSON represents c as a {"real", "imag"} object.
+func complexJSON(c complex128) map[string]any {
+	return map[string]any{"real": real(c), "imag": imag(c)}
+}
+
+func eigenvaluesHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	e, err := eigenArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := checkEigenvaluesIEEE(ctx, e.Values); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	values := make([]any, len(e.Values))
+	for i, v := range e.Values {
+		values[i] = complexJSON(v)
+	}
+	result := mcp.NewToolResultText(formatComplexVector(e.Values))
+	if finiteComplex(e.Values) {
+		result.StructuredContent = map[string]any{"eigenvalues": values, "symmetric": e.Symmetric}
+	}
+	return result, nil
+}
+
+// checkEigenvaluesIEEE applies the policy in ctx to eigenvalues of a matrix
+// with finite entries, which overflow if they are not finite.
+func checkEigenvaluesIEEE(ctx context.Context, values []complex128) error {
+	for _, v := range values {
+		if err := checkComplexIEEE(ctx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finiteComplex reports whether both parts of every value are finite.
+func finiteComplex(values []complex128) bool {
+	for _, v := range values {
+		if !allFinite([]float64{real(v), imag(v)}) {
+			return false
+		}
+	}
+	return true
+}
+
+func eigenvectorsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	e, err := eigenArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := checkEigenvaluesIEEE(ctx, e.Values); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	text := make([]string, len(e.Values))
+	pairs := make([]any, len(e.Values))
+	for k, value := range e.Values {
+		text[k] = fmt.Sprintf("λ = %s\nv = %s", formatRealOrComplex(value), formatComplexVector(e.Vectors[k]))
+		vector := make([]any, len(e.Vectors[k]))
+		for i, c := range e.Vectors[k] {
+			vector[i] = complexJSON(c)
+		}
+		pairs[k] = map[string]any{"value": complexJSON(value), "vector": vector}
+	}
+	if e.Defective {
+		text = append(text, "warning: the eigenvectors are linearly dependent, so the matrix is defective or nearly so and they do not form a basis")
+	}
+	result := mcp.NewToolResultText(strings.Join(text, "\n\n"))
+	if finiteComplex(e.Values) {
+		result.StructuredContent = map[string]any{"eigenpairs": pairs, "symmetric": e.Symmetric, "defective": e.Defective}
+	}
+	return result, nil
+}
+
+// formatPolynomial formats coefficients, highest degree first, as a
+// polynomial in variable, e.g. "x^2 - 5x - 2".
+func formatPolynomial(coef []float64, variable string) string {
+	var b strings.Builder
+	degree := len(coef) - 1
+	for i, c := range coef {
+		power := degree - i
+		if c == 0 && !(power == 0 && b.Len() == 0) {
+			continue
+		}
+		abs := math.Abs(c)
+		switch {
+		case b.Len() == 0 && c < 0:
+			b.WriteString("-")
+		case b.Len() > 0 && c < 0:
+			b.WriteString(" - ")
+		case b.Len() > 0:
+			b.WriteString(" + ")
+		}
+		if abs != 1 || power == 0 {
+			fmt.Fprintf(&b, "%g", abs)
+		}
+		switch {
+		case power == 1:
+			b.WriteString(variable)
+		case power > 1:
+			fmt.Fprintf(&b, "%s^%d", variable, power)
+		}
+	}
+	return b.String()
+}
+
+func characteristicPolynomialHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := squareMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	coef, _ := m.CharPoly()
+	for _, c := range coef {
+		if err := checkIEEE(ctx, c, m.Data...); err != nil {
+			return ieeeErrorResult(err), nil
+		}
+	}
+	result := mcp.NewToolResultText(formatPolynomial(coef, "λ"))
+	if allFinite(coef) {
+		result.StructuredContent = map[string]any{"coefficients": coef}
+	}
+	return result, nil
+}
@@ -0,0 +1,377 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// matrixJSON converts rows to the nested arrays of a decoded JSON argument.
+func matrixJSON(rows ...[]float64) []any {
+	out := make([]any, len(rows))
+	for i, row := range rows {
+		items := make([]any, len(row))
+		for j, v := range row {
+			items[j] = v
+		}
+		out[i] = items
+	}
+	return out
+}
+
+func TestMatrixTools(t *testing.T) {
+	sq := matrixJSON([]float64{1, 2}, []float64{3, 4})
+
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"add", matrixAddHandler, map[string]any{"a": sq, "b": sq}, "[[2, 4],\n [6, 8]]"},
+		{"multiply", matrixMultiplyHandler, map[string]any{"a": sq, "b": matrixJSON([]float64{1}, []float64{1})}, "[[3],\n [7]]"},
+		{"transpose", matrixTransposeHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2, 3})}, "[[1],\n [2],\n [3]]"},
+		{"determinant", matrixDeterminantHandler, map[string]any{"matrix": sq}, "-2"},
+		{"inverse", matrixInverseHandler, map[string]any{"matrix": matrixJSON([]float64{2, 0}, []float64{0, 4})}, "[[0.5, 0],\n [0, 0.25]]"},
+		{"trace", matrixTraceHandler, map[string]any{"matrix": sq}, "5"},
+		{"rank", matrixRankHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2}, []float64{2, 4})}, "1"},
+		{"rank with tolerance", matrixRankHandler, map[string]any{"matrix": matrixJSON([]float64{1, 0}, []float64{0, 1e-9}), "tolerance": 1e-6}, "1"},
+		{"frobenius norm", matrixNormHandler, map[string]any{"matrix": matrixJSON([]float64{3, 4})}, "5"},
+		{"1-norm", matrixNormHandler, map[string]any{"matrix": sq, "type": "1"}, "6"},
+		{"inf-norm", matrixNormHandler, map[string]any{"matrix": sq, "type": "inf"}, "7"},
+		{"power", matrixPowerHandler, map[string]any{"matrix": matrixJSON([]float64{1, 1}, []float64{1, 0}), "n": 5.0}, "[[8, 5],\n [5, 3]]"},
+		{"negative power", matrixPowerHandler, map[string]any{"matrix": matrixJSON([]float64{2}), "n": -2.0}, "[[0.25]]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestMatrixStructuredContent(t *testing.T) {
+	result, err := matrixTransposeHandler(context.Background(), makeRequest(map[string]any{
+		"matrix": matrixJSON([]float64{1, 2}),
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"matrix": [][]float64{{1}, {2}}}, result.StructuredContent)
+}
+
+func TestMatrixErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"missing", matrixTransposeHandler, map[string]any{}, `required argument "matrix" not found`},
+		{"not rows", matrixTransposeHandler, map[string]any{"matrix": []any{1.0, 2.0}}, "matrix: row 0 must be an array of numbers"},
+		{"ragged", matrixTransposeHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2}, []float64{3})}, "matrix: row 1 has 1 entries, expected 2"},
+		{"non-number entry", matrixTransposeHandler, map[string]any{"matrix": []any{[]any{1.0, "x"}}}, "matrix[0][1] must be a number"},
+		{"empty", matrixTransposeHandler, map[string]any{"matrix": []any{}}, "matrix: matrix must have at least one row and one column"},
+		{"shape mismatch", matrixAddHandler, map[string]any{"a": matrixJSON([]float64{1, 2}), "b": matrixJSON([]float64{1})}, "cannot add 1×2 and 1×1 matrices: shapes differ"},
+		{"inner dimensions", matrixMultiplyHandler, map[string]any{"a": matrixJSON([]float64{1, 2}), "b": matrixJSON([]float64{1, 2})}, "cannot multiply 1×2 by 1×2 matrix: 2 columns but 1 rows"},
+		{"not square", matrixDeterminantHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2})}, "matrix must be square, got 1×2"},
+		{"singular", matrixInverseHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2}, []float64{2, 4})}, "matrix is singular and has no inverse"},
+		{"singular negative power", matrixPowerHandler, map[string]any{"matrix": matrixJSON([]float64{0}), "n": -1.0}, "matrix is singular, so negative powers are undefined"},
+		{"fractional power", matrixPowerHandler, map[string]any{"matrix": matrixJSON([]float64{1}), "n": 0.5}, "n must be an integer between -1073741824 and 1073741824"},
+		{"unknown norm", matrixNormHandler, map[string]any{"matrix": matrixJSON([]float64{1}), "type": "2"}, `unknown norm type "2": must be frobenius, 1 or inf`},
+		{"negative tolerance", matrixRankHandler, map[string]any{"matrix": matrixJSON([]float64{1}), "tolerance": -1.0}, "tolerance must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestMatrixIEEEPolicy(t *testing.T) {
+	ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+
+	result, err := matrixMultiplyHandler(ctx, makeRequest(map[string]any{
+		"a": matrixJSON([]float64{1e200}),
+		"b": matrixJSON([]float64{1e200}),
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	// Permissive results with infinities have no structured content
+	result, err = matrixMultiplyHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{1e200}),
+		"b": matrixJSON([]float64{1e200}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "[[+Inf]]", resultText(result))
+	assert.Nil(t, result.StructuredContent)
+
+	// The eigenvalues of a matrix with finite entries can overflow
+	huge := matrixJSON([]float64{1e308, 1e308}, []float64{1e308, 1e308})
+	for _, handler := range []func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error){eigenvaluesHandler, eigenvectorsHandler} {
+		result, err = handler(ctx, makeRequest(map[string]any{"matrix": huge}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, "overflow", result.StructuredContent.(map[string]any)["kind"])
+
+		result, err = handler(context.Background(), makeRequest(map[string]any{"matrix": huge}))
+		require.NoError(t, err)
+		assert.Contains(t, resultText(result), "+Inf")
+		assert.Nil(t, result.StructuredContent)
+	}
+}
+
+func TestMatrixSessionReferences(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "k", "value": 3.0})
+	result := callTool(t, r, ctx, "matrix_determinant", map[string]any{"matrix": []any{[]any{"$k", 0.0}, []any{0.0, "$k"}}})
+
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "9", resultText(result))
+
+	// Scalar results join the answer history
+	result = callTool(t, r, ctx, "add", map[string]any{"a": "$ans", "b": 1.0})
+	assert.Equal(t, "10", resultText(result))
+}
+
+func TestSolveLinearSystem(t *testing.T) {
+	result, err := solveLinearSystemHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{2, 1, 1}, []float64{4, -6, 0}, []float64{-2, 7, 2}),
+		"b": []any{5.0, -2.0, 9.0},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+
+	structured := result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{1, 1, 2}, structured["x"], 1e-14)
+	assert.Greater(t, structured["condition_number"], 1.0)
+	assert.Contains(t, resultText(result), "condition number (1-norm): ")
+	assert.NotContains(t, resultText(result), "warning")
+
+	result, err = solveLinearSystemHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{2, 0}, []float64{0, 4}),
+		"b": []any{1.0, 1.0},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "x = [0.5, 0.25]\ncondition number (1-norm): 2", resultText(result))
+}
+
+func TestSolveLinearSystemIllConditioned(t *testing.T) {
+	result, err := solveLinearSystemHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{1, 1}, []float64{1, 1 + 1e-12}),
+		"b": []any{2.0, 2.0},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "warning: the matrix is ill-conditioned; about 12 significant digits of x may be lost")
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Line through (0, 1), (1, 2), (2, 4)
+	result, err := leastSquaresHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{1, 0}, []float64{1, 1}, []float64{1, 2}),
+		"b": []any{1.0, 2.0, 4.0},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+
+	structured := result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{5.0 / 6, 1.5}, structured["x"], 1e-14)
+	assert.InDelta(t, 1/math.Sqrt(6), structured["residual_norm"], 1e-14)
+	assert.Equal(t, 2, structured["rank"])
+
+	// Rank deficient
+	result, err = leastSquaresHandler(context.Background(), makeRequest(map[string]any{
+		"a": matrixJSON([]float64{1, 1}, []float64{1, 1}),
+		"b": []any{2.0, 2.0},
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, resultText(result), "rank: 1 (rank deficient; x is the minimum-norm solution)")
+}
+
+func TestDecompositions(t *testing.T) {
+	sq := matrixJSON([]float64{1, 2}, []float64{3, 4})
+
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		matrix  []any
+		want    string
+	}{
+		{"lu", luDecompositionHandler, matrixJSON([]float64{0, 2}, []float64{1, 3}), "P =\n[[0, 1],\n [1, 0]]\n\nL =\n[[1, 0],\n [0, 1]]\n\nU =\n[[1, 3],\n [0, 2]]"},
+		{"qr", qrDecompositionHandler, matrixJSON([]float64{2, 1}, []float64{0, 3}), "Q =\n[[-1, 0],\n [0, 1]]\n\nR =\n[[-2, -1],\n [0, 3]]"},
+		{"cholesky", choleskyDecompositionHandler, matrixJSON([]float64{4, 2}, []float64{2, 5}), "L =\n[[2, 0],\n [1, 2]]"},
+		{"svd", svdHandler, matrixJSON([]float64{0, 2}, []float64{3, 0}), "U =\n[[0, 1],\n [1, 0]]\n\nsingular values = [3, 2]\n\nV =\n[[1, 0],\n [0, 1]]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(map[string]any{"matrix": tt.matrix}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+
+	result, err := svdHandler(context.Background(), makeRequest(map[string]any{"matrix": sq}))
+	require.NoError(t, err)
+	structured := result.StructuredContent.(map[string]any)
+	assert.Contains(t, structured, "U")
+	assert.Contains(t, structured, "V")
+	assert.Len(t, structured["singular_values"], 2)
+}
+
+func TestSolverErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"singular system", solveLinearSystemHandler, map[string]any{"a": matrixJSON([]float64{1, 2}, []float64{2, 4}), "b": []any{1.0, 2.0}}, "matrix is singular, so the system has no unique solution; try least_squares"},
+		{"not square", solveLinearSystemHandler, map[string]any{"a": matrixJSON([]float64{1, 2}), "b": []any{1.0}}, "a must be square, got 1×2"},
+		{"b length", solveLinearSystemHandler, map[string]any{"a": matrixJSON([]float64{1}), "b": []any{1.0, 2.0}}, "b has 2 entries but the matrix has 1 rows"},
+		{"missing b", leastSquaresHandler, map[string]any{"a": matrixJSON([]float64{1})}, `required argument "b" not found`},
+		{"not symmetric", choleskyDecompositionHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2}, []float64{3, 4})}, "matrix must be symmetric"},
+		{"not positive definite", choleskyDecompositionHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2}, []float64{2, 1})}, "matrix is not positive definite"},
+		{"lu not square", luDecompositionHandler, map[string]any{"matrix": matrixJSON([]float64{1, 2})}, "matrix must be square, got 1×2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestEigenTools(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		matrix  []any
+		want    string
+	}{
+		{"real eigenvalues", eigenvaluesHandler, matrixJSON([]float64{2, 0}, []float64{0, 3}), "[3, 2]"},
+		{"complex eigenvalues", eigenvaluesHandler, matrixJSON([]float64{0, -1}, []float64{1, 0}), "[0 + 1i, 0 - 1i]"},
+		{"eigenvectors", eigenvectorsHandler, matrixJSON([]float64{1, 0}, []float64{0, 2}), "λ = 2\nv = [0, 1]\n\nλ = 1\nv = [1, 0]"},
+		{"characteristic polynomial", characteristicPolynomialHandler, matrixJSON([]float64{1, 2}, []float64{3, 4}), "λ^2 - 5λ - 2"},
+		{"characteristic polynomial 3x3", characteristicPolynomialHandler, matrixJSON([]float64{6, -11, 6}, []float64{1, 0, 0}, []float64{0, 1, 0}), "λ^3 - 6λ^2 + 11λ - 6"},
+		{"characteristic polynomial identity", characteristicPolynomialHandler, matrixJSON([]float64{1, 0}, []float64{0, 1}), "λ^2 - 2λ + 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(map[string]any{"matrix": tt.matrix}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestEigenStructuredContent(t *testing.T) {
+	result, err := eigenvaluesHandler(context.Background(), makeRequest(map[string]any{
+		"matrix": matrixJSON([]float64{0, -1}, []float64{1, 0}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"eigenvalues": []any{
+			map[string]any{"real": 0.0, "imag": 1.0},
+			map[string]any{"real": 0.0, "imag": -1.0},
+		},
+		"symmetric": false,
+	}, result.StructuredContent)
+
+	// Markov chain steady state
+	result, err = eigenvectorsHandler(context.Background(), makeRequest(map[string]any{
+		"matrix": matrixJSON([]float64{0.9, 0.5}, []float64{0.1, 0.5}),
+	}))
+	require.NoError(t, err)
+	pairs := result.StructuredContent.(map[string]any)["eigenpairs"].([]any)
+	first := pairs[0].(map[string]any)
+	assert.InDelta(t, 1, first["value"].(map[string]any)["real"], 1e-14)
+	vector := first["vector"].([]any)
+	assert.InDelta(t, 5, vector[0].(map[string]any)["real"].(float64)/vector[1].(map[string]any)["real"].(float64), 1e-13)
+	assert.Equal(t, false, result.StructuredContent.(map[string]any)["defective"])
+
+	// A defective matrix has one eigenvector for a double eigenvalue
+	result, err = eigenvectorsHandler(context.Background(), makeRequest(map[string]any{
+		"matrix": matrixJSON([]float64{1, 1}, []float64{0, 1}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, true, result.StructuredContent.(map[string]any)["defective"])
+	assert.Contains(t, resultText(result), "warning: the eigenvectors are linearly dependent")
+
+	result, err = characteristicPolynomialHandler(context.Background(), makeRequest(map[string]any{
+		"matrix": matrixJSON([]float64{1, 2}, []float64{3, 4}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"coefficients": []float64{1, -5, -2}}, result.StructuredContent)
+}
+
+func TestFormatPolynomial(t *testing.T) {
+	tests := []struct {
+		coef []float64
+		want string
+	}{
+		{[]float64{1, -5, -2}, "x^2 - 5x - 2"},
+		{[]float64{-1, 0, 0.5}, "-x^2 + 0.5"},
+		{[]float64{2, 1}, "2x + 1"},
+		{[]float64{1, 0, 0}, "x^2"},
+		{[]float64{0}, "0"},
+		{[]float64{-3}, "-3"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatPolynomial(tt.coef, "x"))
+	}
+}
+
+func TestEigenErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		matrix  []any
+		want    string
+	}{
+		{"not square", eigenvaluesHandler, matrixJSON([]float64{1, 2}), "matrix must be square, got 1×2"},
+		{"not finite", eigenvectorsHandler, []any{[]any{math.Inf(1)}}, "matrix entries must be finite"},
+		{"polynomial not square", characteristicPolynomialHandler, matrixJSON([]float64{1}, []float64{2}), "matrix must be square, got 2×1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(map[string]any{"matrix": tt.matrix}))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
@@ -7,7 +7,6 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -79,7 +78,7 @@ func logHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError("logarithm undefined for non-positive numbers"), nil
 	}
 	result := math.Log(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func log10Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -91,7 +90,7 @@ func log10Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError("logarithm undefined for non-positive numbers"), nil
 	}
 	result := math.Log10(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func log2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -103,7 +102,7 @@ func log2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError("logarithm undefined for non-positive numbers"), nil
 	}
 	result := math.Log2(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func log1pHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -115,7 +114,7 @@ func log1pHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError("log1p undefined for x <= -1"), nil
 	}
 	result := math.Log1p(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func logbHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -124,5 +123,8 @@ func logbHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Logb(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkPole(ctx, result, x == 0); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, x), nil
 }
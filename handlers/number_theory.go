@@ -26,6 +26,7 @@ func (r *Registry) registerNumberTheory() {
 			mcp.WithDescription("Greatest common divisor of a and b"),
 			mcp.WithNumber("a", mcp.Required(), mcp.Description("First integer")),
 			mcp.WithNumber("b", mcp.Required(), mcp.Description("Second integer")),
+			explainOption(),
 		),
 		gcdHandler,
 		cat,
@@ -37,6 +38,7 @@ func (r *Registry) registerNumberTheory() {
 			mcp.WithDescription("Least common multiple of a and b"),
 			mcp.WithNumber("a", mcp.Required(), mcp.Description("First integer")),
 			mcp.WithNumber("b", mcp.Required(), mcp.Description("Second integer")),
+			explainOption(),
 		),
 		lcmHandler,
 		cat,
@@ -77,6 +79,7 @@ func (r *Registry) registerNumberTheory() {
 		mcp.NewTool("prime_factors",
 			mcp.WithDescription("Prime factorization of n"),
 			mcp.WithNumber("n", mcp.Required(), mcp.Description("Positive integer")),
+			explainOption(),
 		),
 		primeFactorsHandler,
 		cat,
@@ -93,6 +96,10 @@ func gcdHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := gcd(abs64(int64(a)), abs64(int64(b)))
+	recordResult(ctx, float64(result))
+	if wantExplain(req) {
+		return explained(mcp.NewToolResultText(fmt.Sprintf("%d", result)), gcdSteps(int64(a), int64(b))), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("%d", result)), nil
 }
 
@@ -106,11 +113,25 @@ func lcmHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if a == 0 || b == 0 {
+		recordResult(ctx, 0)
+		if wantExplain(req) {
+			return explained(mcp.NewToolResultText("0"), []explainStep{
+				stepf("0 is a multiple of every integer, so lcm(%d, %d) = 0", a, b),
+			}), nil
+		}
 		return mcp.NewToolResultText("0"), nil
 	}
 	absA := abs64(int64(a))
 	absB := abs64(int64(b))
-	result := absA / gcd(absA, absB) * absB
+	g := gcd(absA, absB)
+	result := absA / g * absB
+	recordResult(ctx, float64(result))
+	if wantExplain(req) {
+		steps := []explainStep{stepf("Use lcm(a, b) = |a| / gcd(a, b) × |b|, finding the gcd with Euclid's algorithm")}
+		steps = append(steps, gcdSteps(int64(a), int64(b))...)
+		steps = append(steps, stepf("lcm = %d / %d × %d = %d", absA, g, absB, result))
+		return explained(mcp.NewToolResultText(fmt.Sprintf("%d", result)), steps), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("%d", result)), nil
 }
 
@@ -126,6 +147,7 @@ func factorialHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTo
 		return mcp.NewToolResultError("factorial too large (max n=170 for float64)"), nil
 	}
 	result := factorial(n)
+	recordBigResult(ctx, result)
 	return mcp.NewToolResultText(result.String()), nil
 }
 
@@ -141,6 +163,7 @@ func fibonacciHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTo
 		return mcp.NewToolResultError("fibonacci index too large (max n=1000)"), nil
 	}
 	result := fibonacci(n)
+	recordBigResult(ctx, result)
 	return mcp.NewToolResultText(result.String()), nil
 }
 
@@ -166,11 +189,79 @@ func primeFactorsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 	for i, f := range factors {
 		strs[i] = fmt.Sprintf("%d", f)
 	}
-	return mcp.NewToolResultText(strings.Join(strs, " × ")), nil
+	result := mcp.NewToolResultText(strings.Join(strs, " × "))
+	if wantExplain(req) {
+		return explained(result, primeFactorSteps(int64(n))), nil
+	}
+	return result, nil
 }
 
 // Helper functions
 
+// recordBigResult records an integer result as the latest answer, rounded
+// to the nearest float64.
+func recordBigResult(ctx context.Context, n *big.Int) {
+	v, _ := new(big.Float).SetInt(n).Float64()
+	recordResult(ctx, v)
+}
+
+// gcdSteps explains Euclid's algorithm for gcd(a, b).
+func gcdSteps(a, b int64) []explainStep {
+	var steps []explainStep
+	if a < 0 || b < 0 {
+		steps = append(steps, stepf("Signs do not change the divisors: gcd(%d, %d) = gcd(%d, %d)", a, b, abs64(a), abs64(b)))
+		a, b = abs64(a), abs64(b)
+	}
+	if b == 0 {
+		return append(steps, stepf("gcd(%d, 0) = %d", a, a))
+	}
+	for b != 0 {
+		q, r := a/b, a%b
+		steps = append(steps, stepf("%d = %d × %d + %d, so gcd(%d, %d) = gcd(%d, %d)", a, q, b, r, a, b, b, r))
+		a, b = b, r
+	}
+	return append(steps, stepf("The remainder is 0, so the gcd is the last divisor, %d", a))
+}
+
+// primeFactorSteps explains the trial division that factors n.
+func primeFactorSteps(n int64) []explainStep {
+	if n == 1 {
+		return []explainStep{stepf("1 has no prime factors")}
+	}
+	var steps []explainStep
+	m := n
+	divide := func(p int64) {
+		for m%p == 0 {
+			steps = append(steps, stepf("%d is divisible by %d: %d ÷ %d = %d", m, p, m, p, m/p))
+			m /= p
+		}
+	}
+	divide(2)
+	for i := int64(3); i*i <= m; i += 2 {
+		divide(i)
+	}
+	if m > 1 {
+		steps = append(steps, stepf("%d has no divisor up to its square root, so it is prime", m))
+	}
+
+	// Group repeated factors as powers
+	factors := primeFactors(n)
+	var powers []string
+	for i := 0; i < len(factors); {
+		j := i
+		for j < len(factors) && factors[j] == factors[i] {
+			j++
+		}
+		if j-i > 1 {
+			powers = append(powers, fmt.Sprintf("%d^%d", factors[i], j-i))
+		} else {
+			powers = append(powers, fmt.Sprintf("%d", factors[i]))
+		}
+		i = j
+	}
+	return append(steps, stepf("%d = %s", n, strings.Join(powers, " × ")))
+}
+
 func gcd(a, b int64) int64 {
 	for b != 0 {
 		a, b = b, a%b
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/calculus"
+	"github.com/sagacient/math-mcp-server/expr"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxODEEquations caps the equations of solve_ode; the stiff method
+	// estimates and factorizes an n×n Jacobian at every step.
+	maxODEEquations = 100
+	// maxODETimes caps the output times of solve_ode.
+	maxODETimes = 10000
+	// defaultODETolerance and defaultODEAbsoluteTolerance are the default
+	// local error tolerances of the adaptive methods.
+	defaultODETolerance         = 1e-6
+	defaultODEAbsoluteTolerance = 1e-9
+)
+
+// compileSystem compiles the right-hand sides of an ODE system as functions
+// of the time and the variables in the session's environment.
+func (s *sessionStore) compileSystem(ctx context.Context, equations []string, timeVariable string, variables []string) (calculus.System, error) {
+	params := append([]string{timeVariable}, variables...)
+	env := s.expressionEnv(ctx)
+	fs := make([]*expr.Function, len(equations))
+	for i, eq := range equations {
+		f, err := expr.Compile(eq, params, env)
+		if err != nil {
+			return nil, fmt.Errorf("equation %d: %w", i+1, err)
+		}
+		fs[i] = f
+	}
+	args := make([]float64, len(params))
+	return func(t float64, y, dy []float64) error {
+		args[0] = t
+		copy(args[1:], y)
+		for i, f := range fs {
+			v, err := f.Eval(args...)
+			if err != nil {
+				return fmt.Errorf("equation %d: %w", i+1, err)
+			}
+			dy[i] = v
+		}
+		return nil
+	}, nil
+}
+
+// odeError converts an error from solving an ODE into a message, naming the
+// time variable and suggesting a remedy where there is one.
+func odeError(err error, timeVariable string, method calculus.Method) string {
+	var odeErr *calculus.ODEError
+	if !errors.As(err, &odeErr) {
+		return err.Error()
+	}
+	msg := fmt.Sprintf("%v at %s = %g", odeErr.Err, timeVariable, odeErr.T)
+	stiff := ""
+	if method == calculus.RK45 {
+		stiff = `; if the problem is stiff, use method "stiff"`
+	}
+	switch {
+	case errors.Is(err, calculus.ErrStepTooSmall):
+		msg += "; the solution may blow up there" + stiff
+	case errors.Is(err, calculus.ErrTooManyEvaluations):
+		msg += stiff
+		if method == calculus.RK4 {
+			msg += "; use a larger step"
+		}
+	}
+	return msg
+}
+
+// formatTrajectory formats a trajectory as comma-separated rows under a
+// header naming the columns.
+func formatTrajectory(traj calculus.Trajectory, timeVariable string, variables []string) string {
+	var b strings.Builder
+	b.WriteString(strings.Join(append([]string{timeVariable}, variables...), ", "))
+	for i, t := range traj.Times {
+		fmt.Fprintf(&b, "\n%g", t)
+		for _, v := range traj.States[i] {
+			fmt.Fprintf(&b, ", %g", v)
+		}
+	}
+	return b.String()
+}
+
+func (s *sessionStore) solveODEHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	equations, err := req.RequireStringSlice("equations")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(equations) == 0 || len(equations) > maxODEEquations {
+		return mcp.NewToolResultError(fmt.Sprintf("equations must list between 1 and %d expressions", maxODEEquations)), nil
+	}
+	variables, err := req.RequireStringSlice("variables")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(variables) != len(equations) {
+		return mcp.NewToolResultError(fmt.Sprintf("there are %d equations but %d variables", len(equations), len(variables))), nil
+	}
+	initial, err := req.RequireFloatSlice("initial")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(initial) != len(variables) {
+		return mcp.NewToolResultError(fmt.Sprintf("initial has %d values but there are %d variables", len(initial), len(variables))), nil
+	}
+	times, err := req.RequireFloatSlice("times")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(times) == 0 || len(times) > maxODETimes {
+		return mcp.NewToolResultError(fmt.Sprintf("times must list between 1 and %d times", maxODETimes)), nil
+	}
+	start := req.GetFloat("start", 0)
+	timeVariable := req.GetString("time_variable", "t")
+	if slices.Contains(variables, timeVariable) {
+		return mcp.NewToolResultError(fmt.Sprintf("the time variable %q is also one of the variables", timeVariable)), nil
+	}
+	method := calculus.Method(req.GetString("method", string(calculus.RK45)))
+	step := req.GetFloat("step", 0)
+	if !(step >= 0) || math.IsInf(step, 0) {
+		return mcp.NewToolResultError("step must be a positive number"), nil
+	}
+	rel, abs, err := toleranceArgs(req, defaultODETolerance, defaultODEAbsoluteTolerance)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	f, err := s.compileSystem(ctx, equations, timeVariable, variables)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	traj, err := calculus.SolveODE(f, start, initial, times, calculus.ODEOptions{Method: method, Step: step, RelTol: rel, AbsTol: abs})
+	if err != nil {
+		return mcp.NewToolResultError(odeError(err, timeVariable, method)), nil
+	}
+	inputs := concatFloats([]float64{start}, initial)
+	for _, state := range traj.States {
+		for _, v := range state {
+			if err := checkIEEE(ctx, v, inputs...); err != nil {
+				return ieeeErrorResult(err), nil
+			}
+		}
+	}
+
+	text := formatTrajectory(traj, timeVariable, variables)
+	text += fmt.Sprintf("\nsteps: %d, rejected: %d, evaluations: %d", traj.Steps, traj.Rejected, traj.Evaluations)
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{
+		"times":       traj.Times,
+		"variables":   variables,
+		"states":      traj.States,
+		"steps":       traj.Steps,
+		"rejected":    traj.Rejected,
+		"evaluations": traj.Evaluations,
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveODETool(t *testing.T) {
+	for _, method := range []string{"rk45", "rk4", "stiff"} {
+		t.Run(method, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "solve_ode", map[string]any{
+				"equations": []any{"v", "-x"},
+				"variables": []any{"x", "v"},
+				"initial":   []any{1.0, 0.0},
+				"times":     []any{0.0, 1.0, 2.0},
+				"method":    method,
+			})
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), "t, x, v\n0, 1, 0\n1, 0.54")
+
+			content := result.StructuredContent.(map[string]any)
+			assert.Equal(t, []float64{0, 1, 2}, content["times"])
+			states := content["states"].([][]float64)
+			for i, ts := range []float64{0, 1, 2} {
+				assert.InDelta(t, math.Cos(ts), states[i][0], 1e-4)
+				assert.InDelta(t, -math.Sin(ts), states[i][1], 1e-4)
+			}
+			assert.Positive(t, content["evaluations"])
+		})
+	}
+}
+
+func TestSolveODESession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// An SIR epidemic with parameters from the session
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "beta", "value": 0.3})
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "gamma", "value": 0.1})
+	result := callTool(t, r, ctx, "solve_ode", map[string]any{
+		"equations":     []any{"-beta * S * I", "beta * S * I - gamma * I", "gamma * I"},
+		"variables":     []any{"S", "I", "R"},
+		"initial":       []any{0.99, 0.01, 0.0},
+		"times":         []any{50.0, 100.0, 300.0},
+		"time_variable": "day",
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "day, S, I, R\n50, ")
+
+	states := result.StructuredContent.(map[string]any)["states"].([][]float64)
+	for _, state := range states {
+		assert.InDelta(t, 1, state[0]+state[1]+state[2], 1e-8)
+	}
+	// With R0 = 3 the epidemic ends with about 94% recovered
+	final := states[len(states)-1]
+	assert.Less(t, final[1], 1e-3)
+	assert.InDelta(t, 0.94, final[2], 0.01)
+}
+
+func TestSolveODEStiffTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "solve_ode", map[string]any{
+		"equations": []any{"-0.04 a + 1e4 b c", "0.04 a - 1e4 b c - 3e7 b^2", "3e7 b^2"},
+		"variables": []any{"a", "b", "c"},
+		"initial":   []any{1.0, 0.0, 0.0},
+		"times":     []any{40.0},
+		"method":    "stiff",
+		"tolerance": 1e-4,
+	})
+	require.False(t, result.IsError, resultText(result))
+	content := result.StructuredContent.(map[string]any)
+	assert.InDelta(t, 0.7158, content["states"].([][]float64)[0][0], 2e-3)
+	assert.Less(t, content["steps"], 200)
+}
+
+func TestSolveODEErrors(t *testing.T) {
+	base := map[string]any{
+		"equations": []any{"x^2"},
+		"variables": []any{"x"},
+		"initial":   []any{1.0},
+		"times":     []any{2.0},
+	}
+	tests := []struct {
+		name string
+		args map[string]any
+		msg  string
+	}{
+		{"blow-up", map[string]any{}, "step size became too small at t = 1"},
+		{"rk4 blow-up", map[string]any{"method": "rk4"}, "solution is not finite at t = "},
+		{"variable count", map[string]any{"variables": []any{"x", "y"}}, "there are 1 equations but 2 variables"},
+		{"initial count", map[string]any{"initial": []any{1.0, 2.0}}, "initial has 2 values but there are 1 variables"},
+		{"no times", map[string]any{"times": []any{}}, "times must list between 1"},
+		{"time variable clash", map[string]any{"time_variable": "x"}, `the time variable "x" is also one of the variables`},
+		{"bad equation", map[string]any{"equations": []any{"x +"}}, "equation 1: "},
+		{"unknown name", map[string]any{"equations": []any{"k * x"}}, "equation 1: "},
+		{"backwards and forwards", map[string]any{"times": []any{1.0, -1.0}}, "output times must run monotonically away from the initial time"},
+		{"negative tolerance", map[string]any{"tolerance": -1.0}, "tolerance must be a non-negative number"},
+		{"unknown method", map[string]any{"method": "euler"}, `unknown method "euler"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{}
+			for k, v := range base {
+				args[k] = v
+			}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "solve_ode", args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
@@ -0,0 +1,463 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/optimize"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultMinimizeTolerance is the default tolerance of minimisation.
+	defaultMinimizeTolerance = 1e-8
+	// defaultMinimizeIterations and maxMinimizeIterations are the default
+	// and the largest iteration limits of minimisation.
+	defaultMinimizeIterations = 1000
+	maxMinimizeIterations     = 100000
+	// maxLPVariables and maxLPConstraints cap the size of linear programs.
+	maxLPVariables   = 200
+	maxLPConstraints = 500
+)
+
+// registerOptimization registers minimisation and linear programming tools.
+func (r *Registry) registerOptimization() {
+	cat := config.CategoryOptimization
+
+	// Minimize scalar
+	r.addTool(
+		mcp.NewTool("minimize_scalar",
+			mcp.WithDescription("Find the minimum, or maximum, of an expression in one variable on an interval [lower, upper]. "+
+				"Methods: brent (default), Brent's parabolic interpolation, fast for smooth functions; golden, golden section search. "+
+				"Both find the minimum of a function with a single minimum on the interval, otherwise a local one; the ends are checked too. "+
+				"Reports the point, the value there, the iterations and whether the tolerance was met, or that the expression falls without bound, as at a pole"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to minimise, e.g. \"x^4 - 3x\"")),
+			mcp.WithString("variable", mcp.Description("Variable to minimise over (default x)")),
+			pointParam("lower", "Lower end of the interval (required)"),
+			pointParam("upper", "Upper end of the interval (required)"),
+			mcp.WithString("method", mcp.Enum("brent", "golden"), mcp.Description("Method (default brent)")),
+			mcp.WithBoolean("maximize", mcp.Description("Find the maximum instead (default false)")),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Absolute accuracy of the point (default %g); a minimum can only be located to about 1e-8 relative", defaultMinimizeTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit, 1 to %d (default %d)", maxMinimizeIterations, defaultMinimizeIterations))),
+		),
+		r.sessions.minimizeScalarHandler,
+		cat,
+	)
+
+	// Minimize
+	r.addTool(
+		mcp.NewTool("minimize",
+			mcp.WithDescription("Find a local minimum, or maximum, of an expression in several variables from a starting guess, optionally within bounds. "+
+				"Methods: bfgs (default), a quasi-Newton method with numerical gradients, fast for smooth functions; nelder-mead, the downhill simplex method, "+
+				"which needs no gradients and copes with functions that are not smooth. "+
+				"Bounds are kept by a change of variables, so the expression is never evaluated outside them. "+
+				"Reports the point, the value there, the iterations and whether the tolerance was met, or that the expression falls without bound"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to minimise, e.g. \"(1 - x)^2 + 100 (y - x^2)^2\"")),
+			variablesParam(),
+			mcp.WithArray("guess", mcp.Required(), mcp.Description("Starting values of the variables, in the same order"), mcp.WithNumberItems()),
+			mcp.WithString("method", mcp.Enum(string(optimize.BFGS), string(optimize.NelderMead)), mcp.Description("Method (default bfgs)")),
+			optionalBoundsParam("lower", "Lower bounds"),
+			optionalBoundsParam("upper", "Upper bounds"),
+			mcp.WithBoolean("maximize", mcp.Description("Find a maximum instead (default false)")),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Accuracy of the point and the value, relative to their size when above 1 (default %g)", defaultMinimizeTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit, 1 to %d (default %d)", maxMinimizeIterations, defaultMinimizeIterations))),
+		),
+		r.sessions.minimizeHandler,
+		cat,
+	)
+
+	// Linear program
+	r.addTool(
+		mcp.NewTool("linear_program",
+			mcp.WithDescription("Solve a linear program: minimise, or maximise, a linear objective subject to linear equality and inequality constraints, by the two-phase simplex method. "+
+				"Constraints are written as text, e.g. \"2x + 3y <= 12\", with <=, >= or =; strict < and > are not supported. Variables are nonnegative unless nonnegative is false. "+
+				"Reports the optimal point and value, or that the problem is infeasible or unbounded"),
+			mcp.WithString("objective", mcp.Required(), mcp.Description("Linear expression to optimise, e.g. \"3x + 5y\"")),
+			variablesParam(),
+			mcp.WithArray("constraints", mcp.Required(), mcp.Description("Linear constraints, e.g. [\"x <= 4\", \"2y <= 12\", \"3x + 2y <= 18\"]"), mcp.WithStringItems()),
+			mcp.WithBoolean("maximize", mcp.Description("Maximise the objective instead of minimising it (default false)")),
+			mcp.WithBoolean("nonnegative", mcp.Description("Require every variable to be at least 0 (default true)")),
+		),
+		r.sessions.linearProgramHandler,
+		cat,
+	)
+}
+
+// optionalBoundsParam declares optional bounds, one per variable.
+func optionalBoundsParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name,
+		mcp.Description(description+`, one per variable in the same order: numbers, "inf" or "-inf" for none, or expressions such as "pi/2"`),
+		mcp.Items(boundSchema),
+	)
+}
+
+// minimizeOptions reads the tolerance and iteration limit of minimisation.
+func minimizeOptions(req mcp.CallToolRequest) (float64, int, error) {
+	tol := req.GetFloat("tolerance", defaultMinimizeTolerance)
+	if !(tol >= 0) || math.IsInf(tol, 0) {
+		return 0, 0, errors.New("tolerance must be a non-negative number")
+	}
+	maxIter := req.GetInt("max_iterations", defaultMinimizeIterations)
+	if maxIter < 1 || maxIter > maxMinimizeIterations {
+		return 0, 0, fmt.Errorf("max_iterations must be between 1 and %d", maxMinimizeIterations)
+	}
+	return tol, maxIter, nil
+}
+
+// unboundedWarning is appended to the results of minimisations stopped
+// because the expression fell, or when maximising rose, without limit.
+func unboundedWarning(maximize bool, where string) string {
+	direction, kind := "decreases", "minimum"
+	if maximize {
+		direction, kind = "increases", "maximum"
+	}
+	return fmt.Sprintf("\nwarning: the expression %s without bound %s, so it has no %s there", direction, where, kind)
+}
+
+// extremum names the value found by a minimisation, which is negated back
+// when maximising.
+func extremum(maximize bool, value float64) (string, float64) {
+	if maximize {
+		return "maximum", -value
+	}
+	return "minimum", value
+}
+
+func (s *sessionStore) minimizeScalarHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variable := req.GetString("variable", "x")
+	var ends [2]float64
+	for i, name := range []string{"lower", "upper"} {
+		v, ok, err := s.pointValue(ctx, req, name)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !ok {
+			return mcp.NewToolResultError("give the interval with lower and upper"), nil
+		}
+		ends[i] = v
+	}
+	method := req.GetString("method", "brent")
+	maximize := req.GetBool("maximize", false)
+	tol, maxIter, err := minimizeOptions(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	mf, err := s.compileFunction(ctx, expression, []string{variable})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	f := func(x float64) (float64, error) {
+		v, err := mf([]float64{x})
+		if maximize {
+			v = -v
+		}
+		return v, err
+	}
+	var minimize func(optimize.Func, float64, float64, float64, int) (optimize.ScalarResult, error)
+	switch method {
+	case "brent":
+		minimize = optimize.Brent
+	case "golden":
+		minimize = optimize.GoldenSection
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown method %q", method)), nil
+	}
+	r, err := minimize(f, ends[0], ends[1], tol, maxIter)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	kind, value := extremum(maximize, r.Value)
+	if math.IsInf(r.Value, 1) {
+		return mcp.NewToolResultError(fmt.Sprintf("the expression is not finite anywhere it was evaluated between %s = %g and %s = %g", variable, ends[0], variable, ends[1])), nil
+	}
+
+	text := fmt.Sprintf("%s = %g\n%s: %g\niterations: %d, evaluations: %d", variable, r.X, kind, value, r.Iterations, r.Evaluations)
+	switch {
+	case r.Unbounded:
+		text += unboundedWarning(maximize, "next to this point, as at a pole")
+	case !r.Converged:
+		text += notConvergedWarning
+	}
+	if !r.Unbounded {
+		recordResult(ctx, r.X)
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{
+		"x":           r.X,
+		"value":       value,
+		"iterations":  r.Iterations,
+		"evaluations": r.Evaluations,
+		"converged":   r.Converged,
+		"unbounded":   r.Unbounded,
+		"method":      method,
+	}
+	return result, nil
+}
+
+func (s *sessionStore) minimizeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, guess, err := pointArgs(req, "guess")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	args := req.GetArguments()
+	var lower, upper []float64
+	for _, bound := range []struct {
+		name string
+		dst  *[]float64
+	}{{"lower", &lower}, {"upper", &upper}} {
+		v, ok := args[bound.name]
+		if !ok {
+			continue
+		}
+		raw, ok := v.([]any)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("%s must be an array of bounds", bound.name)), nil
+		}
+		if len(raw) != len(variables) {
+			return mcp.NewToolResultError(fmt.Sprintf("%s has %d values but there are %d variables", bound.name, len(raw), len(variables))), nil
+		}
+		*bound.dst = make([]float64, len(raw))
+		for i, item := range raw {
+			if (*bound.dst)[i], err = s.boundValue(ctx, fmt.Sprintf("%s[%d]", bound.name, i), item); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+	}
+	for i := range lower {
+		if upper != nil && lower[i] > upper[i] {
+			return mcp.NewToolResultError(fmt.Sprintf("the lower bound of %s exceeds its upper bound", variables[i])), nil
+		}
+	}
+	method := optimize.Method(req.GetString("method", string(optimize.BFGS)))
+	maximize := req.GetBool("maximize", false)
+	tol, maxIter, err := minimizeOptions(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	mf, err := s.compileFunction(ctx, expression, variables)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	f := func(x []float64) (float64, error) {
+		v, err := mf(x)
+		if maximize {
+			v = -v
+		}
+		return v, err
+	}
+	r, err := optimize.Minimize(f, guess, optimize.Options{Method: method, Lower: lower, Upper: upper, Tol: tol, MaxIter: maxIter})
+	if errors.Is(err, optimize.ErrNotFiniteStart) {
+		return mcp.NewToolResultError("the expression is not finite at the starting guess"), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	kind, value := extremum(maximize, r.Value)
+
+	text := fmt.Sprintf("%s\n%s: %g\niterations: %d, evaluations: %d", formatAssignments(variables, r.X), kind, value, r.Iterations, r.Evaluations)
+	switch {
+	case r.Unbounded:
+		text += unboundedWarning(maximize, "from the guess")
+	case !r.Converged:
+		text += notConvergedWarning
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{
+		"variables":   variables,
+		"x":           r.X,
+		"value":       value,
+		"iterations":  r.Iterations,
+		"evaluations": r.Evaluations,
+		"converged":   r.Converged,
+		"unbounded":   r.Unbounded,
+		"method":      string(method),
+	}
+	return result, nil
+}
+
+// relations are the comparisons recognised in linear constraints, longest
+// first so that "<=" is not read as "=".
+var relations = []struct {
+	token    string
+	relation optimize.Relation
+}{
+	{"<=", optimize.LessEqual},
+	{">=", optimize.GreaterEqual},
+	{"==", optimize.Equal},
+	{"≤", optimize.LessEqual},
+	{"≥", optimize.GreaterEqual},
+	{"=", optimize.Equal},
+}
+
+// strictRelation matches the strict comparisons < and >, which a linear
+// program cannot enforce: its optimum may lie on the boundary.
+var strictRelation = regexp.MustCompile(`[<>]([^=]|$)`)
+
+// splitConstraint splits a constraint such as "2x + y <= 4" at its
+// relation into lhs - rhs and the relation.
+func splitConstraint(constraint string) (string, optimize.Relation, error) {
+	if strictRelation.MatchString(constraint) {
+		return "", "", fmt.Errorf("%q is a strict inequality, which linear programs do not support; use <= or >=", constraint)
+	}
+	for _, r := range relations {
+		before, after, found := strings.Cut(constraint, r.token)
+		if !found {
+			continue
+		}
+		for _, other := range relations {
+			if strings.Contains(after, other.token) {
+				return "", "", fmt.Errorf("%q has more than one comparison", constraint)
+			}
+		}
+		if strings.TrimSpace(before) == "" || strings.TrimSpace(after) == "" {
+			return "", "", fmt.Errorf("%q needs an expression on each side of %q", constraint, r.token)
+		}
+		return fmt.Sprintf("(%s) - (%s)", before, after), r.relation, nil
+	}
+	return "", "", fmt.Errorf("%q has no comparison; use <=, >= or =", constraint)
+}
+
+// linearCoefficients finds the coefficients a and constant c of an
+// expression a·x + c in the variables, by evaluating it at zero and on the
+// axes, and checks that it is linear at two more points.
+func (s *sessionStore) linearCoefficients(ctx context.Context, expression string, variables []string) ([]float64, float64, error) {
+	f, err := s.compileFunction(ctx, expression, variables)
+	if err != nil {
+		return nil, 0, err
+	}
+	n := len(variables)
+	x := make([]float64, n)
+	c, err := f(x)
+	if err != nil {
+		return nil, 0, err
+	}
+	a := make([]float64, n)
+	for i := range x {
+		x[i] = 1
+		v, err := f(x)
+		if err != nil {
+			return nil, 0, err
+		}
+		a[i] = v - c
+		x[i] = 0
+	}
+	if !allFinite(a) || !allFinite([]float64{c}) {
+		return nil, 0, errors.New("is not finite")
+	}
+	for _, point := range []func(i int) float64{
+		func(i int) float64 { return 1.5 + float64(i) },
+		func(i int) float64 { return -2.25 - 0.5*float64(i) },
+	} {
+		want, scale := c, math.Abs(c)+1
+		for i := range x {
+			x[i] = point(i)
+			want += a[i] * x[i]
+			scale += math.Abs(a[i] * x[i])
+		}
+		v, err := f(x)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !(math.Abs(v-want) <= 1e-9*scale) {
+			return nil, 0, errors.New("is not linear in the variables")
+		}
+	}
+	return a, c, nil
+}
+
+func (s *sessionStore) linearProgramHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	objective, err := req.RequireString("objective")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, err := req.RequireStringSlice("variables")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(variables) == 0 || len(variables) > maxLPVariables {
+		return mcp.NewToolResultError(fmt.Sprintf("variables must list between 1 and %d names", maxLPVariables)), nil
+	}
+	texts, err := req.RequireStringSlice("constraints")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(texts) > maxLPConstraints {
+		return mcp.NewToolResultError(fmt.Sprintf("there may be at most %d constraints", maxLPConstraints)), nil
+	}
+	maximize := req.GetBool("maximize", false)
+	nonnegative := req.GetBool("nonnegative", true)
+
+	costs, constant, err := s.linearCoefficients(ctx, objective, variables)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("objective: %v", err)), nil
+	}
+	if maximize {
+		for i := range costs {
+			costs[i] = -costs[i]
+		}
+	}
+	constraints := make([]optimize.Constraint, len(texts))
+	for i, text := range texts {
+		expression, relation, err := splitConstraint(text)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("constraint %d: %v", i+1, err)), nil
+		}
+		a, c, err := s.linearCoefficients(ctx, expression, variables)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("constraint %d: %v", i+1, err)), nil
+		}
+		constraints[i] = optimize.Constraint{Coefficients: a, Relation: relation, RHS: -c}
+	}
+
+	lp, err := optimize.LinearProgram(costs, constraints, nonnegative)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	content := map[string]any{
+		"status":     string(lp.Status),
+		"variables":  variables,
+		"iterations": lp.Iterations,
+	}
+	var text string
+	switch lp.Status {
+	case optimize.Infeasible:
+		text = "status: infeasible\nno point satisfies all the constraints"
+	case optimize.Unbounded:
+		direction := "decreased"
+		if maximize {
+			direction = "increased"
+		}
+		text = fmt.Sprintf("status: unbounded\nthe objective can be %s without limit within the constraints", direction)
+	default:
+		kind, value := extremum(maximize, lp.Value)
+		value += constant
+		recordResult(ctx, value)
+		text = fmt.Sprintf("%s\n%s: %g\nstatus: optimal", formatAssignments(variables, lp.X), kind, value)
+		content["x"] = lp.X
+		content["value"] = value
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = content
+	return result, nil
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimizeScalarTool(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   map[string]any
+		want   float64
+		value  float64
+		method string
+	}{
+		{"brent by default", map[string]any{"expression": "exp(x) - 2x"}, math.Ln2, 2 - 2*math.Ln2, "brent"},
+		{"golden", map[string]any{"expression": "exp(x) - 2x", "method": "golden"}, math.Ln2, 2 - 2*math.Ln2, "golden"},
+		{"maximize", map[string]any{"expression": "2x - exp(x)", "maximize": true}, math.Ln2, 2*math.Ln2 - 2, "brent"},
+		{"minimum at an end", map[string]any{"expression": "x"}, -1, -1, "brent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{"lower": -1.0, "upper": "e"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "minimize_scalar", args)
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), "\niterations: ")
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.want, content["x"], 1e-7)
+			assert.InDelta(t, tt.value, content["value"], 1e-12)
+			assert.Equal(t, true, content["converged"])
+			assert.Equal(t, false, content["unbounded"])
+			assert.Equal(t, tt.method, content["method"])
+		})
+	}
+}
+
+func TestMinimizeScalarSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// The cheapest can of volume 1: surface 2πr² + 2/r
+	result := callTool(t, r, ctx, "minimize_scalar", map[string]any{
+		"expression": "2pi r^2 + 2/r",
+		"variable":   "r",
+		"lower":      0.1,
+		"upper":      2.0,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "r = 0.54192")
+	assert.Contains(t, resultText(result), "\nminimum: 5.5358")
+
+	// The point becomes the latest answer
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	ans, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, math.Cbrt(1/(2*math.Pi)), ans, 1e-7)
+}
+
+func TestMinimizeUnboundedTools(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		msg  string
+	}{
+		{"pole", "minimize_scalar", map[string]any{"expression": "1/x", "lower": -1.0, "upper": 1.0},
+			"warning: the expression decreases without bound next to this point, as at a pole, so it has no minimum there"},
+		{"pole maximize", "minimize_scalar", map[string]any{"expression": "1/x", "lower": -1.0, "upper": 1.0, "maximize": true},
+			"warning: the expression increases without bound next to this point"},
+		{"linear", "minimize", map[string]any{"expression": "x + y", "variables": []any{"x", "y"}, "guess": []any{0.0, 0.0}},
+			"warning: the expression decreases without bound from the guess, so it has no minimum there"},
+		{"start at a maximum", "minimize", map[string]any{"expression": "-x^2", "variables": []any{"x"}, "guess": []any{0.0}},
+			"warning: the expression decreases without bound from the guess"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			ctx := sessionContext("s1")
+			result := callTool(t, r, ctx, tt.tool, tt.args)
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), tt.msg)
+			content := result.StructuredContent.(map[string]any)
+			assert.Equal(t, true, content["unbounded"])
+			assert.Equal(t, false, content["converged"])
+
+			// No point is recorded as the answer
+			result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestMinimizeScalarErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		msg  string
+	}{
+		{"no interval", map[string]any{"expression": "x^2", "lower": 0.0}, "give the interval with lower and upper"},
+		{"empty interval", map[string]any{"expression": "x^2", "lower": 1.0, "upper": 1.0}, "interval must have distinct ends"},
+		{"not finite", map[string]any{"expression": "log(x)", "lower": -2.0, "upper": -1.0}, "the expression is not finite anywhere it was evaluated"},
+		{"method", map[string]any{"expression": "x^2", "lower": 0.0, "upper": 1.0, "method": "newton"}, `unknown method "newton"`},
+		{"iterations", map[string]any{"expression": "x^2", "lower": 0.0, "upper": 1.0, "max_iterations": 0.0}, "max_iterations must be between 1 and 100000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "minimize_scalar", tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
+
+func TestMinimizeTool(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  map[string]any
+		want  []float64
+		value float64
+	}{
+		{"bfgs", map[string]any{}, []float64{1, 1}, 0},
+		{"nelder-mead", map[string]any{"method": "nelder-mead"}, []float64{1, 1}, 0},
+		{"bounded", map[string]any{"guess": []any{1.0, 2.0}, "lower": []any{"-inf", 1.5}, "upper": []any{"inf", "inf"}}, []float64{1.2243, 1.5}, 0.0504},
+		{"maximize", map[string]any{"expression": "-(1 - x)^2 - 100 (y - x^2)^2", "maximize": true}, []float64{1, 1}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{
+				"expression": "(1 - x)^2 + 100 (y - x^2)^2",
+				"variables":  []any{"x", "y"},
+				"guess":      []any{-1.2, 1.0},
+			}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "minimize", args)
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), "\niterations: ")
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDeltaSlice(t, tt.want, content["x"].([]float64), 1e-4)
+			assert.InDelta(t, tt.value, content["value"], 1e-4)
+			assert.Equal(t, true, content["converged"])
+		})
+	}
+}
+
+func TestMinimizeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		msg  string
+	}{
+		{"guess length", map[string]any{"guess": []any{0.0}}, "guess has 1 values but there are 2 variables"},
+		{"bounds length", map[string]any{"lower": []any{0.0}}, "lower has 1 values but there are 2 variables"},
+		{"crossed bounds", map[string]any{"lower": []any{0.0, 2.0}, "upper": []any{1.0, 1.0}}, "the lower bound of y exceeds its upper bound"},
+		{"not finite", map[string]any{"expression": "log(x) + y", "guess": []any{-1.0, 0.0}}, "the expression is not finite at the starting guess"},
+		{"method", map[string]any{"method": "powell"}, `unknown method "powell"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{
+				"expression": "x^2 + y^2",
+				"variables":  []any{"x", "y"},
+				"guess":      []any{1.0, 1.0},
+			}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "minimize", args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
+
+func TestLinearProgramTool(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+	result := callTool(t, r, ctx, "linear_program", map[string]any{
+		"objective":   "3x + 5y",
+		"variables":   []any{"x", "y"},
+		"constraints": []any{"x <= 4", "2y ≤ 12", "3x + 2y <= 18"},
+		"maximize":    true,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "x = 2, y = 6\nmaximum: 36\nstatus: optimal", resultText(result))
+	content := result.StructuredContent.(map[string]any)
+	assert.Equal(t, "optimal", content["status"])
+	assert.InDeltaSlice(t, []float64{2, 6}, content["x"].([]float64), 1e-12)
+
+	// The value becomes the latest answer
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	assert.Equal(t, "36", resultText(result))
+
+	// Free variables, an equality and a constant in the objective
+	result = callTool(t, r, ctx, "linear_program", map[string]any{
+		"objective":   "x + 2y + 10",
+		"variables":   []any{"x", "y"},
+		"constraints": []any{"x + y = 1", "x >= -3", "y >= x - 5"},
+		"nonnegative": false,
+	})
+	require.False(t, result.IsError, resultText(result))
+	content = result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{3, -2}, content["x"].([]float64), 1e-12)
+	assert.InDelta(t, 9, content["value"], 1e-12)
+}
+
+func TestLinearProgramStatus(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "linear_program", map[string]any{
+		"objective":   "x + y",
+		"variables":   []any{"x", "y"},
+		"constraints": []any{"x + y <= 1", "x + y >= 2"},
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "status: infeasible")
+	assert.Equal(t, "infeasible", result.StructuredContent.(map[string]any)["status"])
+
+	result = callTool(t, NewRegistry(), sessionContext("s1"), "linear_program", map[string]any{
+		"objective":   "x + y",
+		"variables":   []any{"x", "y"},
+		"constraints": []any{"x - y <= 1"},
+		"maximize":    true,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "status: unbounded\nthe objective can be increased without limit")
+	assert.Equal(t, "unbounded", result.StructuredContent.(map[string]any)["status"])
+}
+
+func TestLinearProgramErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		objective   string
+		constraints []any
+		msg         string
+	}{
+		{"nonlinear objective", "x * y", []any{"x <= 1"}, "objective: is not linear in the variables"},
+		{"nonlinear constraint", "x", []any{"x <= 1", "x^2 + y <= 4"}, "constraint 2: is not linear in the variables"},
+		{"no comparison", "x", []any{"x + y"}, `constraint 1: "x + y" has no comparison; use <=, >= or =`},
+		{"strict", "x", []any{"x <= 1", "x + y > 2"}, `constraint 2: "x + y > 2" is a strict inequality, which linear programs do not support; use <= or >=`},
+		{"strict lower bound", "x", []any{"2 < x"}, `constraint 1: "2 < x" is a strict inequality`},
+		{"two comparisons", "x", []any{"0 <= x <= 1"}, `constraint 1: "0 <= x <= 1" has more than one comparison`},
+		{"empty side", "x", []any{"<= 1"}, `constraint 1: "<= 1" needs an expression on each side of "<="`},
+		{"unknown name", "x + z", []any{"x <= 1"}, "objective: "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "linear_program", map[string]any{
+				"objective":   tt.objective,
+				"variables":   []any{"x", "y"},
+				"constraints": tt.constraints,
+			})
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
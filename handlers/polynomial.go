@@ -0,0 +1,420 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/poly"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultGCDTolerance is the default relative tolerance of polynomial_gcd.
+	defaultGCDTolerance = 1e-10
+	// maxRootsDegree caps the degree accepted by polynomial_roots, whose
+	// iteration costs time quadratic in the degree per step.
+	maxRootsDegree = 500
+)
+
+// registerPolynomial registers polynomial arithmetic tools.
+func (r *Registry) registerPolynomial() {
+	cat := config.CategoryPolynomial
+
+	// Evaluate
+	r.addTool(
+		mcp.NewTool("polynomial_evaluate",
+			mcp.WithDescription("Evaluate a polynomial at x by Horner's method; give x_imag to evaluate at the complex point x + x_imag·i"),
+			polynomialParam("p", "Polynomial"),
+			mcp.WithNumber("x", mcp.Required(), mcp.Description("Point to evaluate at (real part when x_imag is given)")),
+			mcp.WithNumber("x_imag", mcp.Description("Imaginary part of the point")),
+		),
+		polynomialEvaluateHandler,
+		cat,
+	)
+
+	// Add
+	r.addTool(
+		mcp.NewTool("polynomial_add",
+			mcp.WithDescription("Sum p + q of two polynomials"),
+			polynomialParam("p", "First polynomial"),
+			polynomialParam("q", "Second polynomial"),
+		),
+		polynomialAddHandler,
+		cat,
+	)
+
+	// Subtract
+	r.addTool(
+		mcp.NewTool("polynomial_subtract",
+			mcp.WithDescription("Difference p − q of two polynomials"),
+			polynomialParam("p", "Polynomial to subtract from"),
+			polynomialParam("q", "Polynomial to subtract"),
+		),
+		polynomialSubtractHandler,
+		cat,
+	)
+
+	// Multiply
+	r.addTool(
+		mcp.NewTool("polynomial_multiply",
+			mcp.WithDescription("Product p·q of two polynomials"),
+			polynomialParam("p", "First polynomial"),
+			polynomialParam("q", "Second polynomial"),
+		),
+		polynomialMultiplyHandler,
+		cat,
+	)
+
+	// Divide
+	r.addTool(
+		mcp.NewTool("polynomial_divide",
+			mcp.WithDescription("Polynomial long division: quotient and remainder of p / q, with p = quotient·q + remainder"),
+			polynomialParam("p", "Dividend"),
+			polynomialParam("q", "Nonzero divisor"),
+		),
+		polynomialDivideHandler,
+		cat,
+	)
+
+	// GCD
+	r.addTool(
+		mcp.NewTool("polynomial_gcd",
+			mcp.WithDescription("Monic greatest common divisor of two polynomials by Euclid's algorithm"),
+			polynomialParam("p", "First polynomial"),
+			polynomialParam("q", "Second polynomial"),
+			mcp.WithNumber("tolerance", mcp.Description("Remainder coefficients this small relative to the polynomials are treated as zero (default 1e-10)")),
+		),
+		polynomialGCDHandler,
+		cat,
+	)
+
+	// Compose
+	r.addTool(
+		mcp.NewTool("polynomial_compose",
+			mcp.WithDescription("Composition p(q(x)) of two polynomials"),
+			polynomialParam("p", "Outer polynomial"),
+			polynomialParam("q", "Inner polynomial"),
+		),
+		polynomialComposeHandler,
+		cat,
+	)
+
+	// Derivative
+	r.addTool(
+		mcp.NewTool("polynomial_derivative",
+			mcp.WithDescription("Derivative of a polynomial"),
+			polynomialParam("p", "Polynomial"),
+			mcp.WithNumber("order", mcp.Description("Order of the derivative (default 1)")),
+		),
+		polynomialDerivativeHandler,
+		cat,
+	)
+
+	// Roots
+	r.addTool(
+		mcp.NewTool("polynomial_roots",
+			mcp.WithDescription("All real and complex roots of a polynomial with their multiplicities: closed form up to degree 4, Aberth–Ehrlich iteration beyond"),
+			polynomialParam("p", "Polynomial of degree at least 1"),
+		),
+		polynomialRootsHandler,
+		cat,
+	)
+
+	// Antiderivative
+	r.addTool(
+		mcp.NewTool("polynomial_antiderivative",
+			mcp.WithDescription("Antiderivative (indefinite integral) of a polynomial"),
+			polynomialParam("p", "Polynomial"),
+			mcp.WithNumber("constant", mcp.Description("Constant of integration (default 0)")),
+		),
+		polynomialAntiderivativeHandler,
+		cat,
+	)
+}
+
+// polynomialParam declares a polynomial argument, given as coefficients or as
+// a string.
+func polynomialParam(name, description string) mcp.ToolOption {
+	return mcp.WithAny(name,
+		mcp.Required(),
+		mcp.Description(description+`: an array of coefficients, highest degree first ([3, 0, -2, 1] is 3x^3 - 2x + 1), `+
+			`or a string such as "3x^3 - 2x + 1" or "(x + 1)^2 (x - 2)" in one variable`),
+		func(schema map[string]any) {
+			schema["anyOf"] = []any{
+				map[string]any{"type": "array", "items": map[string]any{"type": "number"}},
+				map[string]any{"type": "string"},
+			}
+		},
+	)
+}
+
+// polynomialArg reads a polynomial argument and the variable it is written
+// in, which is empty for coefficient arrays and constants.
+func polynomialArg(req mcp.CallToolRequest, name string) (poly.Poly, string, error) {
+	raw, ok := req.GetArguments()[name]
+	if !ok {
+		return nil, "", fmt.Errorf("required argument %q not found", name)
+	}
+	var (
+		p        poly.Poly
+		variable string
+	)
+	switch v := raw.(type) {
+	case float64:
+		// A bare number, such as a resolved session reference, is a constant
+		p = poly.Poly{v}.Trim()
+	case string:
+		var err error
+		p, variable, err = poly.Parse(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", name, err)
+		}
+	case []any:
+		if len(v) == 0 {
+			return nil, "", fmt.Errorf("%s must have at least one coefficient", name)
+		}
+		coef := make([]float64, len(v))
+		for i, item := range v {
+			c, ok := item.(float64)
+			if !ok {
+				return nil, "", fmt.Errorf("%s[%d] must be a number", name, i)
+			}
+			coef[i] = c
+		}
+		if len(coef) > poly.MaxDegree+1 {
+			return nil, "", fmt.Errorf("%s has degree above %d", name, poly.MaxDegree)
+		}
+		p = poly.FromDescending(coef)
+	default:
+		return nil, "", fmt.Errorf(`%s must be an array of coefficients or a string such as "3x^3 - 2x + 1"`, name)
+	}
+	if !allFinite(p) {
+		return nil, "", fmt.Errorf("coefficients of %s must be finite", name)
+	}
+	return p, variable, nil
+}
+
+// polynomialPairArgs reads the polynomials p and q and the variable to write
+// results in, which is x unless the strings use another.
+func polynomialPairArgs(req mcp.CallToolRequest) (poly.Poly, poly.Poly, string, error) {
+	p, pv, err := polynomialArg(req, "p")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	q, qv, err := polynomialArg(req, "q")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if pv != "" && qv != "" && pv != qv {
+		return nil, nil, "", fmt.Errorf("p is in %s but q is in %s; use the same variable", pv, qv)
+	}
+	return p, q, variableOr(pv, qv), nil
+}
+
+// variableOr returns the first nonempty variable name, or x.
+func variableOr(names ...string) string {
+	for _, name := range names {
+		if name != "" {
+			return name
+		}
+	}
+	return "x"
+}
+
+// polynomialText formats p in variable.
+func polynomialText(p poly.Poly, variable string) string {
+	return formatPolynomial(p.Descending(), variable)
+}
+
+// polynomialResult formats a polynomial result, with its coefficients
+// highest degree first as structured content.
+func polynomialResult(ctx context.Context, p poly.Poly, variable string, inputs ...float64) *mcp.CallToolResult {
+	for _, c := range p {
+		if err := checkIEEE(ctx, c, inputs...); err != nil {
+			return ieeeErrorResult(err)
+		}
+	}
+	result := mcp.NewToolResultText(polynomialText(p, variable))
+	if allFinite(p) {
+		result.StructuredContent = map[string]any{"coefficients": p.Descending()}
+	}
+	return result
+}
+
+func polynomialEvaluateHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, _, err := polynomialArg(req, "p")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	x, err := req.RequireFloat("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if _, ok := req.GetArguments()["x_imag"]; !ok {
+		return floatResult(ctx, p.Eval(x), append([]float64{x}, p...)...), nil
+	}
+	z := complex(x, req.GetFloat("x_imag", 0))
+	inputs := []complex128{z}
+	for _, c := range p {
+		inputs = append(inputs, complex(c, 0))
+	}
+	return complexResult(ctx, p.EvalComplex(z), inputs...), nil
+}
+
+func polynomialAddHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return polynomialResult(ctx, p.Add(q), variable, concatFloats(p, q)...), nil
+}
+
+func polynomialSubtractHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return polynomialResult(ctx, p.Sub(q), variable, concatFloats(p, q)...), nil
+}
+
+func polynomialMultiplyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if deg, ok := poly.MulDegree(p.Degree(), q.Degree()); !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("the product has degree %d, above the limit of %d", deg, poly.MaxDegree)), nil
+	}
+	return polynomialResult(ctx, p.Mul(q), variable, concatFloats(p, q)...), nil
+}
+
+func polynomialDivideHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	quot, rem, err := p.DivMod(q)
+	if errors.Is(err, poly.ErrDivisionByZero) {
+		return mcp.NewToolResultError("cannot divide by the zero polynomial"), nil
+	}
+	inputs := concatFloats(p, q)
+	for _, c := range concatFloats(quot, rem) {
+		if err := checkIEEE(ctx, c, inputs...); err != nil {
+			return ieeeErrorResult(err), nil
+		}
+	}
+	result := mcp.NewToolResultText(fmt.Sprintf("quotient: %s\nremainder: %s", polynomialText(quot, variable), polynomialText(rem, variable)))
+	if allFinite(quot) && allFinite(rem) {
+		result.StructuredContent = map[string]any{
+			"quotient":  quot.Descending(),
+			"remainder": rem.Descending(),
+		}
+	}
+	return result, nil
+}
+
+func polynomialGCDHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	tol := req.GetFloat("tolerance", defaultGCDTolerance)
+	if !(tol >= 0) {
+		return mcp.NewToolResultError("tolerance must be non-negative"), nil
+	}
+	return polynomialResult(ctx, poly.GCD(p, q, tol), variable, concatFloats(p, q)...), nil
+}
+
+func polynomialComposeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, q, variable, err := polynomialPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if deg := p.Degree() * q.Degree(); deg > poly.MaxDegree {
+		return mcp.NewToolResultError(fmt.Sprintf("the composition has degree %d, above the limit of %d", deg, poly.MaxDegree)), nil
+	}
+	return polynomialResult(ctx, p.Compose(q), variable, concatFloats(p, q)...), nil
+}
+
+func polynomialDerivativeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, variable, err := polynomialArg(req, "p")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	order := req.GetInt("order", 1)
+	if order < 1 {
+		return mcp.NewToolResultError("order must be at least 1"), nil
+	}
+	d := p
+	for range min(order, len(p)) {
+		d = d.Derivative()
+	}
+	return polynomialResult(ctx, d, variableOr(variable), p...), nil
+}
+
+func polynomialRootsHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, variable, err := polynomialArg(req, "p")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	switch deg := p.Degree(); {
+	case deg < 0:
+		return mcp.NewToolResultError("every number is a root of the zero polynomial"), nil
+	case deg == 0:
+		return mcp.NewToolResultError("a nonzero constant polynomial has no roots"), nil
+	case deg > maxRootsDegree:
+		return mcp.NewToolResultError(fmt.Sprintf("p has degree %d; polynomial_roots is limited to degree %d", deg, maxRootsDegree)), nil
+	}
+
+	roots, err := p.Roots()
+	variable = variableOr(variable)
+	lines := make([]string, 0, len(roots)+1)
+	items := make([]any, len(roots))
+	finite := true
+	for i, root := range roots {
+		for _, v := range []float64{real(root.Value), imag(root.Value)} {
+			if err := checkIEEE(ctx, v, p...); err != nil {
+				return ieeeErrorResult(err), nil
+			}
+			finite = finite && allFinite([]float64{v})
+		}
+		line := fmt.Sprintf("%s = %s", variable, formatRealOrComplex(root.Value))
+		if root.Multiplicity > 1 {
+			line += fmt.Sprintf(" (multiplicity %d)", root.Multiplicity)
+		}
+		lines = append(lines, line)
+		item := complexJSON(root.Value)
+		item["multiplicity"] = root.Multiplicity
+		items[i] = item
+	}
+	converged := !errors.Is(err, poly.ErrNoConvergence)
+	if !converged {
+		lines = append(lines, "warning: the iteration did not fully converge, so the roots may be inaccurate")
+	}
+	result := mcp.NewToolResultText(strings.Join(lines, "\n"))
+	if finite {
+		result.StructuredContent = map[string]any{"roots": items, "converged": converged}
+	}
+	return result, nil
+}
+
+func polynomialAntiderivativeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	p, variable, err := polynomialArg(req, "p")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	c := req.GetFloat("constant", 0)
+	if !allFinite([]float64{c}) {
+		return mcp.NewToolResultError("constant must be finite"), nil
+	}
+	return polynomialResult(ctx, p.Antiderivative(c), variableOr(variable), append([]float64{c}, p...)...), nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolynomialTools(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"evaluate array", polynomialEvaluateHandler, map[string]any{"p": vectorJSON(3, 0, -2, 1), "x": 2.0}, "21"},
+		{"evaluate string", polynomialEvaluateHandler, map[string]any{"p": "3x^3 - 2x + 1", "x": -1.0}, "0"},
+		{"evaluate complex", polynomialEvaluateHandler, map[string]any{"p": "x^2 + 1", "x": 0.0, "x_imag": 1.0}, "0 + 0i"},
+		{"evaluate complex general", polynomialEvaluateHandler, map[string]any{"p": vectorJSON(1, 0, 2), "x": 1.0, "x_imag": 2.0}, "-1 + 4i"},
+		{"add", polynomialAddHandler, map[string]any{"p": "x^2 + 2x + 3", "q": vectorJSON(-1, 0, 1)}, "2x + 4"},
+		{"subtract", polynomialSubtractHandler, map[string]any{"p": "x^2", "q": "x^2"}, "0"},
+		{"multiply", polynomialMultiplyHandler, map[string]any{"p": "t + 1", "q": "t - 1"}, "t^2 - 1"},
+		{"multiply mixed", polynomialMultiplyHandler, map[string]any{"p": "y + 1", "q": vectorJSON(1, 1)}, "y^2 + 2y + 1"},
+		{"divide", polynomialDivideHandler, map[string]any{"p": "x^3 + 1", "q": "x^2 + x"}, "quotient: x - 1\nremainder: x + 1"},
+		{"divide exact", polynomialDivideHandler, map[string]any{"p": "x^2 - 3x + 2", "q": "x - 1"}, "quotient: x - 2\nremainder: 0"},
+		{"gcd", polynomialGCDHandler, map[string]any{"p": "(x - 1)(x - 2)", "q": "(x - 1)(x - 3)"}, "x - 1"},
+		{"gcd coprime", polynomialGCDHandler, map[string]any{"p": "x^2 + 1", "q": "x - 1"}, "1"},
+		{"compose", polynomialComposeHandler, map[string]any{"p": "x^2 + 1", "q": "2x + 1"}, "4x^2 + 4x + 2"},
+		{"derivative", polynomialDerivativeHandler, map[string]any{"p": "3x^3 - 2x + 1"}, "9x^2 - 2"},
+		{"second derivative", polynomialDerivativeHandler, map[string]any{"p": "3x^3 - 2x + 1", "order": 2.0}, "18x"},
+		{"derivative beyond degree", polynomialDerivativeHandler, map[string]any{"p": "x^2", "order": 5.0}, "0"},
+		{"antiderivative", polynomialAntiderivativeHandler, map[string]any{"p": "3x^2 + 1", "constant": 4.0}, "x^3 + x + 4"},
+		{"roots quadratic", polynomialRootsHandler, map[string]any{"p": "x^2 - 3x + 2"}, "x = 1\nx = 2"},
+		{"roots complex", polynomialRootsHandler, map[string]any{"p": vectorJSON(1, -2, 5)}, "x = 1 - 2i\nx = 1 + 2i"},
+		{"roots multiplicity", polynomialRootsHandler, map[string]any{"p": "(t - 3)^2 (t + 1)"}, "t = -1\nt = 3 (multiplicity 2)"},
+		{"roots close pair", polynomialRootsHandler, map[string]any{"p": vectorJSON(1, -2.000001, 1.000001)}, "x = 0.9999999997780047\nx = 1.0000010002219955"},
+		{"roots zero", polynomialRootsHandler, map[string]any{"p": "x^3 - 4x"}, "x = -2\nx = 0\nx = 2"},
+		{"roots cubic", polynomialRootsHandler, map[string]any{"p": "x^3 - 8"}, "x = -1 - 1.7320508075688772i\nx = -1 + 1.7320508075688772i\nx = 2"},
+		{"roots quintic", polynomialRootsHandler, map[string]any{"p": "(x^2 - 1)(x^2 - 4)(x - 3)"}, "x = -2\nx = -1\nx = 1\nx = 2\nx = 3"},
+		{"antiderivative of zero", polynomialAntiderivativeHandler, map[string]any{"p": vectorJSON(0)}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestPolynomialStructuredContent(t *testing.T) {
+	result, err := polynomialMultiplyHandler(context.Background(), makeRequest(map[string]any{
+		"p": "x + 1",
+		"q": "x - 1",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"coefficients": []float64{1, 0, -1}}, result.StructuredContent)
+
+	result, err = polynomialDivideHandler(context.Background(), makeRequest(map[string]any{
+		"p": "x^3 + 1",
+		"q": "x^2 + x",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"quotient": []float64{1, -1}, "remainder": []float64{1, 1}}, result.StructuredContent)
+}
+
+func TestPolynomialRootsStructuredContent(t *testing.T) {
+	result, err := polynomialRootsHandler(context.Background(), makeRequest(map[string]any{"p": "x^3 + x^2"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"roots": []any{
+			map[string]any{"real": -1.0, "imag": 0.0, "multiplicity": 1},
+			map[string]any{"real": 0.0, "imag": 0.0, "multiplicity": 2},
+		},
+		"converged": true,
+	}, result.StructuredContent)
+}
+
+func TestPolynomialErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"empty", polynomialDerivativeHandler, map[string]any{"p": vectorJSON()}, "p must have at least one coefficient"},
+		{"not a number", polynomialDerivativeHandler, map[string]any{"p": []any{1.0, true}}, "p[1] must be a number"},
+		{"wrong type", polynomialDerivativeHandler, map[string]any{"p": true}, `p must be an array of coefficients or a string such as "3x^3 - 2x + 1"`},
+		{"not a polynomial", polynomialDerivativeHandler, map[string]any{"p": "1/x"}, "p: 1 / x divides by a non-constant, so it is not a polynomial"},
+		{"two variables", polynomialAddHandler, map[string]any{"p": "x", "q": "x y"}, "q: a polynomial must have a single variable, found x, y"},
+		{"different variables", polynomialAddHandler, map[string]any{"p": "x", "q": "t"}, "p is in x but q is in t; use the same variable"},
+		{"not finite", polynomialAddHandler, map[string]any{"p": "1e308 x * 10", "q": "1"}, "coefficients of p must be finite"},
+		{"divide by zero", polynomialDivideHandler, map[string]any{"p": "x", "q": "x - x"}, "cannot divide by the zero polynomial"},
+		{"order", polynomialDerivativeHandler, map[string]any{"p": "x", "order": 0.0}, "order must be at least 1"},
+		{"tolerance", polynomialGCDHandler, map[string]any{"p": "x", "q": "x", "tolerance": -1.0}, "tolerance must be non-negative"},
+		{"roots of zero", polynomialRootsHandler, map[string]any{"p": vectorJSON(0, 0)}, "every number is a root of the zero polynomial"},
+		{"roots of constant", polynomialRootsHandler, map[string]any{"p": "5"}, "a nonzero constant polynomial has no roots"},
+		{"roots degree", polynomialRootsHandler, map[string]any{"p": "x^501 + 1"}, "p has degree 501; polynomial_roots is limited to degree 500"},
+		{"compose degree", polynomialComposeHandler, map[string]any{"p": "x^200", "q": "x^100"}, "the composition has degree 20000, above the limit of 10000"},
+		{"product degree", polynomialMultiplyHandler, map[string]any{"p": "x^6000", "q": "x^6000"}, "the product has degree 12000, above the limit of 10000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestPolynomialIEEEPolicy(t *testing.T) {
+	ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+
+	result, err := polynomialEvaluateHandler(ctx, makeRequest(map[string]any{
+		"p": vectorJSON(1, 0, 0),
+		"x": 1e200,
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestPolynomialSessionReferences(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "k", "value": 2.0})
+	result := callTool(t, r, ctx, "polynomial_evaluate", map[string]any{"p": []any{"$k", 1.0}, "x": "$k"})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "5", resultText(result))
+
+	result = callTool(t, r, ctx, "polynomial_multiply", map[string]any{"p": "x + 1", "q": "$k"})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "2x + 2", resultText(result))
+}
@@ -7,7 +7,6 @@ package handlers
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/sagacient/math-mcp-server/config"
@@ -34,7 +33,7 @@ func (r *Registry) registerPower() {
 	r.addTool(
 		mcp.NewTool("pow10",
 			mcp.WithDescription("10 raised to the power n (10^n)"),
-			mcp.WithNumber("n", mcp.Required(), mcp.Description("Exponent (integer)")),
+			mcp.WithNumber("n", mcp.Required(), mcp.Description("Exponent (integer)"), integerType),
 		),
 		pow10Handler,
 		cat,
@@ -112,7 +111,13 @@ func powHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Pow(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := firstError(
+		checkPole(ctx, result, x == 0 && y < 0),
+		checkUnderflow(ctx, result, x != 0 && !math.IsInf(y, 0)),
+	); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, x, y), nil
 }
 
 func pow10Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -121,7 +126,10 @@ func pow10Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Pow10(n)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, true); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result), nil
 }
 
 func sqrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -133,7 +141,7 @@ func sqrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError("cannot compute square root of negative number"), nil
 	}
 	result := math.Sqrt(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func cbrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -142,7 +150,7 @@ func cbrtHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Cbrt(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func expHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -151,7 +159,10 @@ func expHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Exp(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, !math.IsInf(x, -1)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, x), nil
 }
 
 func exp2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -160,7 +171,10 @@ func exp2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Exp2(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if err := checkUnderflow(ctx, result, !math.IsInf(x, -1)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, x), nil
 }
 
 func expm1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -169,7 +183,7 @@ func expm1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Expm1(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func hypotHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -182,5 +196,5 @@ func hypotHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Hypot(x, y)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x, y), nil
 }
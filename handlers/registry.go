@@ -7,6 +7,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/sagacient/math-mcp-server/config"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -22,13 +24,15 @@ type ToolDefinition struct {
 
 // Registry holds all tool definitions organized by category.
 type Registry struct {
-	tools []ToolDefinition
+	tools    []ToolDefinition
+	sessions *sessionStore
 }
 
 // NewRegistry creates a new tool registry with all available tools.
 func NewRegistry() *Registry {
 	r := &Registry{
-		tools: make([]ToolDefinition, 0),
+		tools:    make([]ToolDefinition, 0),
+		sessions: newSessionStore(),
 	}
 
 	// Register all categories
@@ -46,17 +50,77 @@ func NewRegistry() *Registry {
 	r.registerStatistics()
 	r.registerBitwise()
 	r.registerComplex()
+	r.registerInterval()
+	r.registerLinearAlgebra()
+	r.registerVector()
+	r.registerPolynomial()
+	r.registerCalculus()
+	r.registerSymbolic()
+	r.registerOptimization()
+	r.registerInterpolation()
+	r.registerVariables()
+	r.registerFunctions()
+	r.registerDiscovery()
 
 	return r
 }
 
-// RegisterTools registers all enabled tools with the MCP server.
+// RegisterTools registers all enabled tools with the MCP server, or only the
+// discovery tools in lazy mode.
 func (r *Registry) RegisterTools(s *server.MCPServer, cfg *config.Config) {
 	for _, td := range r.tools {
-		if cfg.IsEnabled(td.Category) {
-			s.AddTool(td.Tool, td.Handler)
+		if isToolServed(td, cfg) {
+			s.AddTool(td.Tool, r.wrap(td, cfg))
+		}
+	}
+}
+
+// EndSession discards the variables and history of an MCP session.
+func (r *Registry) EndSession(id string) {
+	r.sessions.end(id)
+}
+
+// wrap returns the handler of td as served under cfg: variable references
+// are resolved, results recorded in the session history, uncertainties
+// propagated, and the IEEE special-value policy applied. The handler sees
+// cfg in its context.
+func (r *Registry) wrap(td ToolDefinition, cfg *config.Config) server.ToolHandlerFunc {
+	policy := ieeePolicyMiddleware(cfg.IEEEPolicy)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return td.Handler(withConfig(ctx, cfg), req)
+	}
+	return r.sessions.sessionMiddleware(td.Tool)(uncertaintyMiddleware(td)(policy(handler)))
+}
+
+// SyncTools moves the tools registered on s from the old configuration to
+// cfg: disabled tools are removed and newly enabled ones added. If the IEEE
+// policy or function library changed every enabled tool is re-registered so
+// that it takes effect.
+// The discovery tools are always re-registered since they depend on the whole
+// configuration. The server sends tools/list_changed to connected clients for
+// each update.
+func (r *Registry) SyncTools(s *server.MCPServer, old, cfg *config.Config) {
+	rewrap := old.IEEEPolicy != cfg.IEEEPolicy ||
+		old.FunctionsFile != cfg.FunctionsFile || old.FunctionsReadOnly != cfg.FunctionsReadOnly
+
+	var removed []string
+	var added []server.ServerTool
+	for _, td := range r.tools {
+		was, now := isToolServed(td, old), isToolServed(td, cfg)
+		switch {
+		case was && !now:
+			removed = append(removed, td.Tool.Name)
+		case now && (!was || rewrap || td.Category == config.CategoryDiscovery):
+			added = append(added, server.ServerTool{Tool: td.Tool, Handler: r.wrap(td, cfg)})
 		}
 	}
+
+	if len(removed) > 0 {
+		s.DeleteTools(removed...)
+	}
+	if len(added) > 0 {
+		s.AddTools(added...)
+	}
 }
 
 // RegisterConstants registers the constants resource if enabled.
@@ -66,11 +130,35 @@ func RegisterConstants(s *server.MCPServer, cfg *config.Config) {
 	}
 }
 
+// SyncConstants adds or removes the constants resource to match cfg.
+func SyncConstants(s *server.MCPServer, old, cfg *config.Config) {
+	was, now := old.IsEnabled(config.CategoryConstants), cfg.IsEnabled(config.CategoryConstants)
+	switch {
+	case now && !was:
+		registerConstantsResource(s)
+	case was && !now:
+		s.DeleteResources(constantsURI)
+	}
+}
+
+// isToolEnabled reports whether cfg enables the tool's category and allows
+// the tool by name.
+func isToolEnabled(td ToolDefinition, cfg *config.Config) bool {
+	return cfg.IsEnabled(td.Category) && cfg.IsToolAllowed(td.Tool.Name)
+}
+
 // addTool adds a tool definition to the registry.
+// Every tool accepts the optional per-call IEEE policy override, and its
+// numeric parameters document session variable references and, where
+// accepted, values with uncertainty.
 func (r *Registry) addTool(tool mcp.Tool, handler server.ToolHandlerFunc, category config.Category) {
-	r.tools = append(r.tools, ToolDefinition{
+	ieeePolicyOption(&tool)
+	variableReferenceOption(&tool)
+	td := ToolDefinition{
 		Tool:     tool,
 		Handler:  handler,
 		Category: category,
-	})
+	}
+	uncertaintyOption(&td)
+	r.tools = append(r.tools, td)
 }
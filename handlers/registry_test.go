@@ -6,12 +6,14 @@
 package handlers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/sagacient/math-mcp-server/config"
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewRegistry(t *testing.T) {
@@ -46,6 +48,16 @@ func TestRegistryToolCategories(t *testing.T) {
 	assert.Greater(t, categoryCounts[config.CategoryStatistics], 0, "statistics should have tools")
 	assert.Greater(t, categoryCounts[config.CategoryBitwise], 0, "bitwise should have tools")
 	assert.Greater(t, categoryCounts[config.CategoryComplex], 0, "complex should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryInterval], 0, "interval should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryLinearAlgebra], 0, "linear_algebra should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryVector], 0, "vector should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryPolynomial], 0, "polynomial should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryCalculus], 0, "calculus should have tools")
+	assert.Greater(t, categoryCounts[config.CategorySymbolic], 0, "symbolic should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryOptimization], 0, "optimization should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryInterpolation], 0, "interpolation should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryVariables], 0, "variables should have tools")
+	assert.Greater(t, categoryCounts[config.CategoryFunctions], 0, "functions should have tools")
 }
 
 func TestRegisterToolsWithAllEnabled(t *testing.T) {
@@ -115,3 +127,83 @@ func TestRegisterConstantsDisabled(t *testing.T) {
 
 	// Should work without error (just not register anything)
 }
+
+func TestRegisterToolsWithAllowList(t *testing.T) {
+	registry := NewRegistry()
+
+	cfg := &config.Config{
+		Categories: map[config.Category]bool{
+			config.CategoryArithmetic: true,
+		},
+		Tools: map[string]bool{"add": true, "sqrt": true},
+	}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	registry.RegisterTools(mcpServer, cfg)
+
+	tools := mcpServer.ListTools()
+	assert.Len(t, tools, 1)
+	assert.Contains(t, tools, "add")
+}
+
+func TestSyncTools(t *testing.T) {
+	registry := NewRegistry()
+
+	old := &config.Config{
+		Categories: map[config.Category]bool{
+			config.CategoryArithmetic: true,
+			config.CategoryTrig:       true,
+		},
+	}
+	next := &config.Config{
+		Categories: map[config.Category]bool{
+			config.CategoryArithmetic: true,
+			config.CategoryComplex:    true,
+		},
+	}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	registry.RegisterTools(mcpServer, old)
+	require.NotNil(t, mcpServer.GetTool("sin"))
+	require.Nil(t, mcpServer.GetTool("complex_abs"))
+
+	registry.SyncTools(mcpServer, old, next)
+
+	assert.NotNil(t, mcpServer.GetTool("add"))
+	assert.NotNil(t, mcpServer.GetTool("complex_abs"))
+	assert.Nil(t, mcpServer.GetTool("sin"))
+}
+
+func TestSyncToolsAppliesNewIEEEPolicy(t *testing.T) {
+	registry := NewRegistry()
+
+	old := &config.Config{
+		Categories: map[config.Category]bool{config.CategoryPower: true},
+		IEEEPolicy: config.IEEEPermissive,
+	}
+	next := &config.Config{
+		Categories: map[config.Category]bool{config.CategoryPower: true},
+		IEEEPolicy: config.IEEEStrict,
+	}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	registry.RegisterTools(mcpServer, old)
+	registry.SyncTools(mcpServer, old, next)
+
+	tool := mcpServer.GetTool("exp")
+	require.NotNil(t, tool)
+	result, err := tool.Handler(context.Background(), makeRequest(map[string]any{"x": 1000.0}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestSyncConstants(t *testing.T) {
+	old := &config.Config{Categories: map[config.Category]bool{}}
+	next := &config.Config{Categories: map[config.Category]bool{config.CategoryConstants: true}}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithResourceCapabilities(true, false))
+	SyncConstants(mcpServer, old, next)
+	SyncConstants(mcpServer, next, old)
+
+	// Should work without error in both directions
+}
@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/fit"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxObservations caps the observations of a fit.
+	maxObservations = 10000
+	// maxPredictors caps the predictors of a regression and the parameters
+	// of a nonlinear fit.
+	maxPredictors = 20
+	// defaultFitTolerance, defaultFitIterations and maxFitIterations are the
+	// defaults and limits of nonlinear_fit.
+	defaultFitTolerance  = 1e-10
+	defaultFitIterations = 200
+	maxFitIterations     = 10000
+)
+
+// observationsParam declares predictors given as one number per
+// observation, or as one row of numbers per observation.
+func observationsParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name,
+		mcp.Required(),
+		mcp.Description(description+": one number per observation, e.g. [1, 2, 3], or for several predictors one row per observation, e.g. [[1, 5], [2, 3], [3, 8]]"),
+		mcp.Items(map[string]any{
+			"anyOf": []any{
+				map[string]any{"type": "number"},
+				map[string]any{"type": "array", "items": map[string]any{"type": "number"}},
+			},
+		}),
+	)
+}
+
+// observationsArg reads predictors declared by observationsParam as rows.
+func observationsArg(req mcp.CallToolRequest, name string) ([][]float64, error) {
+	raw, ok := req.GetArguments()[name].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of numbers or of rows of numbers", name)
+	}
+	if len(raw) > maxObservations {
+		return nil, fmt.Errorf("%s may have at most %d observations", name, maxObservations)
+	}
+	rows := make([][]float64, len(raw))
+	for i, item := range raw {
+		switch v := item.(type) {
+		case float64:
+			rows[i] = []float64{v}
+		case []any:
+			if len(v) == 0 || len(v) > maxPredictors {
+				return nil, fmt.Errorf("%s[%d] must have between 1 and %d predictors", name, i, maxPredictors)
+			}
+			rows[i] = make([]float64, len(v))
+			for j, x := range v {
+				f, ok := x.(float64)
+				if !ok {
+					return nil, fmt.Errorf("%s[%d][%d] must be a number", name, i, j)
+				}
+				rows[i][j] = f
+			}
+		default:
+			return nil, fmt.Errorf("%s[%d] must be a number or an array of numbers", name, i)
+		}
+	}
+	return rows, nil
+}
+
+// responseArg reads the observations y, one per row of predictors.
+func responseArg(req mcp.CallToolRequest, rows int) ([]float64, error) {
+	y, err := req.RequireFloatSlice("y")
+	if err != nil {
+		return nil, err
+	}
+	if len(y) != rows {
+		return nil, fmt.Errorf("y has %d values but x has %d", len(y), rows)
+	}
+	return y, nil
+}
+
+// formatEquation formats y = c₀t₀ + c₁t₁ + … to six significant digits,
+// with terms such as "x" or "x^2" and no term for a constant, whose name is
+// empty; the coefficient lines that follow give full precision.
+func formatEquation(coefficients []float64, terms []string) string {
+	var b strings.Builder
+	b.WriteString("y =")
+	for i, c := range coefficients {
+		switch {
+		case i == 0 && c < 0:
+			b.WriteString(" -")
+		case i > 0 && c < 0:
+			b.WriteString(" - ")
+		case i > 0:
+			b.WriteString(" + ")
+		default:
+			b.WriteString(" ")
+		}
+		fmt.Fprintf(&b, "%.6g", math.Abs(c))
+		if terms[i] != "" {
+			b.WriteString(" " + terms[i])
+		}
+	}
+	return b.String()
+}
+
+// regressionResult reports a fit with one line per coefficient, headed by
+// the fitted equation. Structured content is given only when every value is
+// finite, since JSON cannot represent the others.
+func regressionResult(ctx context.Context, equation string, names []string, r fit.Regression, inputs []float64, extra map[string]any) *mcp.CallToolResult {
+	values := concatFloats(r.Coefficients, r.StandardErrors,
+		[]float64{r.RSquared, r.AdjustedRSquared, r.ResidualStdError}, r.Fitted, r.Residuals)
+	for _, v := range values {
+		if err := checkIEEE(ctx, v, inputs...); err != nil {
+			return ieeeErrorResult(err)
+		}
+	}
+	var b strings.Builder
+	b.WriteString(equation)
+	for i, name := range names {
+		fmt.Fprintf(&b, "\n%s: %g (std error %.3g)", name, r.Coefficients[i], r.StandardErrors[i])
+	}
+	fmt.Fprintf(&b, "\nR²: %.6g, adjusted R²: %.6g", r.RSquared, r.AdjustedRSquared)
+	fmt.Fprintf(&b, "\nresidual standard error: %.6g on %d degrees of freedom", r.ResidualStdError, r.DegreesOfFreedom)
+	fmt.Fprintf(&b, "\nresiduals: %s", formatFloats(r.Residuals))
+	result := mcp.NewToolResultText(b.String())
+	content := map[string]any{
+		"names":              names,
+		"coefficients":       r.Coefficients,
+		"standard_errors":    r.StandardErrors,
+		"r_squared":          r.RSquared,
+		"adjusted_r_squared": r.AdjustedRSquared,
+		"residual_std_error": r.ResidualStdError,
+		"degrees_of_freedom": r.DegreesOfFreedom,
+		"fitted":             r.Fitted,
+		"residuals":          r.Residuals,
+	}
+	for k, v := range extra {
+		content[k] = v
+	}
+	if allFinite(values) {
+		result.StructuredContent = content
+	}
+	return result
+}
+
+func linearRegressionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	rows, err := observationsArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	y, err := responseArg(req, len(rows))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	intercept := req.GetBool("intercept", true)
+	var predictors []string
+	if len(rows) > 0 {
+		predictors = make([]string, len(rows[0]))
+		if len(predictors) == 1 {
+			predictors[0] = "x"
+		} else {
+			for i := range predictors {
+				predictors[i] = fmt.Sprintf("x%d", i+1)
+			}
+		}
+	}
+	if names := req.GetStringSlice("names", nil); names != nil {
+		if len(names) != len(predictors) {
+			return mcp.NewToolResultError(fmt.Sprintf("names has %d entries but there are %d predictors", len(names), len(predictors))), nil
+		}
+		predictors = names
+	}
+
+	r, err := fit.Linear(rows, y, intercept)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	names, terms := predictors, predictors
+	if intercept {
+		names = append([]string{"intercept"}, predictors...)
+		terms = append([]string{""}, predictors...)
+	}
+	var inputs []float64
+	for _, row := range rows {
+		inputs = append(inputs, row...)
+	}
+	return regressionResult(ctx, formatEquation(r.Coefficients, terms), names, r, concatFloats(inputs, y), nil), nil
+}
+
+func polynomialRegressionHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := req.RequireFloatSlice("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(x) > maxObservations {
+		return mcp.NewToolResultError(fmt.Sprintf("x may have at most %d observations", maxObservations)), nil
+	}
+	y, err := responseArg(req, len(x))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	d, err := req.RequireFloat("degree")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if d != math.Trunc(d) || math.Abs(d) > fit.MaxPolynomialDegree {
+		return mcp.NewToolResultError(fmt.Sprintf("degree must be an integer between 1 and %d", fit.MaxPolynomialDegree)), nil
+	}
+	degree := int(d)
+
+	r, err := fit.Polynomial(x, y, degree)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	names := make([]string, degree+1)
+	terms := make([]string, degree+1)
+	names[0] = "intercept"
+	for k := 1; k <= degree; k++ {
+		terms[k] = "x"
+		if k > 1 {
+			terms[k] = fmt.Sprintf("x^%d", k)
+		}
+		names[k] = terms[k]
+	}
+	return regressionResult(ctx, formatEquation(r.Coefficients, terms), names, r, concatFloats(x, y), nil), nil
+}
+
+func fitCurveHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	x, err := req.RequireFloatSlice("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(x) > maxObservations {
+		return mcp.NewToolResultError(fmt.Sprintf("x may have at most %d observations", maxObservations)), nil
+	}
+	y, err := responseArg(req, len(x))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	model, err := req.RequireString("model")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	curve := fit.Curve(model)
+	r, err := fit.FitCurve(curve, x, y)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	a, b := r.Coefficients[0], r.Coefficients[1]
+	var equation string
+	switch curve {
+	case fit.Exponential:
+		equation = fmt.Sprintf("y = %.6g e^(%.6g x)", a, b)
+	case fit.Power:
+		equation = fmt.Sprintf("y = %.6g x^%.6g", a, b)
+	default:
+		equation = formatEquation(r.Coefficients, []string{"", "ln(x)"})
+	}
+	return regressionResult(ctx, equation, []string{"a", "b"}, r, concatFloats(x, y), map[string]any{"model": model}), nil
+}
+
+func (s *sessionStore) nonlinearFitHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	model, err := req.RequireString("model")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	parameters, err := req.RequireStringSlice("parameters")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(parameters) == 0 || len(parameters) > maxPredictors {
+		return mcp.NewToolResultError(fmt.Sprintf("parameters must list between 1 and %d names", maxPredictors)), nil
+	}
+	guess, err := req.RequireFloatSlice("guess")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(guess) != len(parameters) {
+		return mcp.NewToolResultError(fmt.Sprintf("guess has %d values but there are %d parameters", len(guess), len(parameters))), nil
+	}
+	rows, err := observationsArg(req, "x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	y, err := responseArg(req, len(rows))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables := req.GetStringSlice("variables", []string{"x"})
+	if len(rows) > 0 && len(rows[0]) != len(variables) {
+		return mcp.NewToolResultError(fmt.Sprintf("x has %d predictors per observation but there are %d variables", len(rows[0]), len(variables))), nil
+	}
+	for _, v := range variables {
+		if slices.Contains(parameters, v) {
+			return mcp.NewToolResultError(fmt.Sprintf("%q is both a parameter and a variable", v)), nil
+		}
+	}
+	tol, maxIter, err := fitOptions(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	compiled, err := s.compileFunction(ctx, model, append(slices.Clone(parameters), variables...))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	args := make([]float64, len(parameters)+len(variables))
+	f := func(p, x []float64) (float64, error) {
+		copy(args, p)
+		copy(args[len(p):], x)
+		return compiled(args)
+	}
+	r, err := fit.Nonlinear(f, rows, y, guess, tol, maxIter)
+	switch {
+	case errors.Is(err, fit.ErrNotFiniteStart):
+		return mcp.NewToolResultError("the model is not finite at every observation for the starting guess; try another guess"), nil
+	case errors.Is(err, fit.ErrNotIdentifiable):
+		return mcp.NewToolResultError("the parameters are not all determined by the data, as when two only appear as a product; remove or fix one of them"), nil
+	case err != nil:
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var inputs []float64
+	for _, row := range rows {
+		inputs = append(inputs, row...)
+	}
+	result := regressionResult(ctx, "y = "+model, parameters, r.Regression, concatFloats(inputs, y, guess), map[string]any{
+		"iterations":  r.Iterations,
+		"evaluations": r.Evaluations,
+		"converged":   r.Converged,
+	})
+	if result.IsError {
+		return result, nil
+	}
+	text := result.Content[0].(mcp.TextContent)
+	text.Text += fmt.Sprintf("\niterations: %d, evaluations: %d", r.Iterations, r.Evaluations)
+	if !r.Converged {
+		text.Text += notConvergedWarning
+	}
+	result.Content[0] = text
+	return result, nil
+}
+
+// fitOptions reads the tolerance and iteration limit of nonlinear_fit.
+func fitOptions(req mcp.CallToolRequest) (float64, int, error) {
+	tol := req.GetFloat("tolerance", defaultFitTolerance)
+	if !(tol >= 0) || math.IsInf(tol, 0) {
+		return 0, 0, errors.New("tolerance must be a non-negative number")
+	}
+	maxIter := req.GetInt("max_iterations", defaultFitIterations)
+	if maxIter < 1 || maxIter > maxFitIterations {
+		return 0, 0, fmt.Errorf("max_iterations must be between 1 and %d", maxFitIterations)
+	}
+	return tol, maxIter, nil
+}
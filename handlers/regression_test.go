@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinearRegressionTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "linear_regression", map[string]any{
+		"x": []any{1.0, 2.0, 3.0, 4.0, 5.0},
+		"y": []any{2.1, 3.9, 6.2, 7.8, 10.1},
+	})
+	require.False(t, result.IsError, resultText(result))
+	text := resultText(result)
+	assert.Contains(t, text, "y = 0.05 + 1.99 x\n")
+	assert.Regexp(t, `\nintercept: 0\.0\d+ \(std error 0\.198\)\n`, text)
+	assert.Regexp(t, `\nx: 1\.9\d+ \(std error 0\.0597\)\n`, text)
+	assert.Contains(t, text, "\nR²: 0.997305, adjusted R²: 0.996407")
+	assert.Contains(t, text, "\nresidual standard error: 0.188856 on 3 degrees of freedom")
+
+	content := result.StructuredContent.(map[string]any)
+	assert.Equal(t, []string{"intercept", "x"}, content["names"])
+	assert.InDeltaSlice(t, []float64{0.05, 1.99}, content["coefficients"], 1e-12)
+	assert.InDeltaSlice(t, []float64{0.1980741, 0.0597216}, content["standard_errors"], 1e-7)
+	assert.InDeltaSlice(t, []float64{0.06, -0.13, 0.18, -0.21, 0.1}, content["residuals"], 1e-12)
+	assert.Equal(t, 3, content["degrees_of_freedom"])
+}
+
+func TestLinearRegressionMultiple(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "linear_regression", map[string]any{
+		"x":     []any{[]any{0.0, 0.0}, []any{1.0, 0.0}, []any{0.0, 1.0}, []any{1.0, 1.0}, []any{2.0, 1.0}, []any{1.0, 3.0}},
+		"y":     []any{1.0, 3.0, -2.0, 0.0, 2.0, -6.0},
+		"names": []any{"price", "rain"},
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "y = 1 + 2 price - 3 rain\n")
+	content := result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{1, 2, -3}, content["coefficients"], 1e-12)
+	assert.InDelta(t, 1, content["r_squared"], 1e-12)
+
+	// Without an intercept the line passes through the origin
+	result = callTool(t, NewRegistry(), sessionContext("s1"), "linear_regression", map[string]any{
+		"x":         []any{1.0, 2.0, 3.0},
+		"y":         []any{2.0, 4.0, 6.0},
+		"intercept": false,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "y = 2 x\n")
+	assert.Equal(t, []string{"x"}, result.StructuredContent.(map[string]any)["names"])
+}
+
+func TestPolynomialRegressionTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "polynomial_regression", map[string]any{
+		"x":      []any{0.0, 1.0, 2.0, 3.0, 4.0, 5.0},
+		"y":      []any{1.1, 1.9, 5.2, 9.8, 17.1, 26.2},
+		"degree": 2.0,
+	})
+	require.False(t, result.IsError, resultText(result))
+	text := resultText(result)
+	assert.Regexp(t, `^y = 1\.089\d* - 0\.1139\d* x \+ 1\.0267\d* x\^2\n`, text)
+	assert.Contains(t, text, "\nx^2: ")
+	content := result.StructuredContent.(map[string]any)
+	assert.Equal(t, []string{"intercept", "x", "x^2"}, content["names"])
+	assert.InDeltaSlice(t, []float64{1.0892857, -0.1139286, 1.0267857}, content["coefficients"], 1e-7)
+	assert.InDeltaSlice(t, []float64{0.1664735, 0.1565898, 0.0300616}, content["standard_errors"], 1e-7)
+}
+
+func TestFitCurveTool(t *testing.T) {
+	tests := []struct {
+		model    string
+		y        []any
+		a, b     float64
+		equation string
+	}{
+		{"exponential", []any{2 * math.Exp(0.5), 2 * math.Exp(1), 2 * math.Exp(1.5), 2 * math.Exp(2)}, 2, 0.5, "y = 2 e^(0.5 x)"},
+		{"power", []any{3.0, 12.0, 27.0, 48.0}, 3, 2, "y = 3 x^2"},
+		{"logarithmic", []any{1.0, 1 - 2*math.Log(2), 1 - 2*math.Log(3), 1 - 2*math.Log(4)}, 1, -2, "y = 1 - 2 ln(x)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "fit_curve", map[string]any{
+				"model": tt.model,
+				"x":     []any{1.0, 2.0, 3.0, 4.0},
+				"y":     tt.y,
+			})
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), tt.equation+"\n")
+			content := result.StructuredContent.(map[string]any)
+			assert.InDeltaSlice(t, []float64{tt.a, tt.b}, content["coefficients"], 1e-12)
+			assert.Equal(t, tt.model, content["model"])
+			assert.InDelta(t, 1, content["r_squared"], 1e-12)
+		})
+	}
+
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "fit_curve", map[string]any{
+		"model": "exponential",
+		"x":     []any{1.0, 2.0, 3.0, 4.0},
+		"y":     []any{3.0, 7.0, 11.0, 25.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Regexp(t, `^y = 1\.58745\d* e\^\(0\.68127\d* x\)\n`, resultText(result))
+}
+
+func TestNonlinearFitTool(t *testing.T) {
+	// NIST StRD Misra1a, from its first starting point
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "nonlinear_fit", map[string]any{
+		"model":      "b1 * (1 - exp(-b2 * x))",
+		"parameters": []any{"b1", "b2"},
+		"guess":      []any{500.0, 1e-4},
+		"x":          []any{77.6, 114.9, 141.1, 190.8, 239.9, 289.0, 332.8, 378.4, 434.8, 477.3, 536.8, 593.1, 689.1, 760.0},
+		"y":          []any{10.07, 14.73, 17.94, 23.93, 29.61, 35.18, 40.02, 44.82, 50.76, 55.05, 61.01, 66.40, 75.47, 81.78},
+	})
+	require.False(t, result.IsError, resultText(result))
+	text := resultText(result)
+	assert.Contains(t, text, "y = b1 * (1 - exp(-b2 * x))\n")
+	assert.Contains(t, text, "\nb1: 238.94")
+	assert.Contains(t, text, "on 12 degrees of freedom")
+	assert.Contains(t, text, "\niterations: ")
+	assert.NotContains(t, text, "warning")
+
+	content := result.StructuredContent.(map[string]any)
+	coefficients := content["coefficients"].([]float64)
+	assert.InDelta(t, 2.3894212918e+02, coefficients[0], 1e-5)
+	assert.InDelta(t, 5.5015643181e-04, coefficients[1], 1e-11)
+	standardErrors := content["standard_errors"].([]float64)
+	assert.InDelta(t, 2.7070075241e+00, standardErrors[0], 1e-5)
+	assert.InDelta(t, 7.2668688436e-06, standardErrors[1], 1e-11)
+	assert.Equal(t, true, content["converged"])
+}
+
+func TestNonlinearFitSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// A session function in the model, fitted over two variables
+	result := callTool(t, r, ctx, "define_function", map[string]any{"name": "g", "params": []any{"u"}, "body": "u^2"})
+	require.False(t, result.IsError, resultText(result))
+	var x, y []any
+	for _, p := range [][2]float64{{0, 1}, {1, 0}, {1, 1}, {2, 1}, {0, 2}, {2, 3}} {
+		x = append(x, []any{p[0], p[1]})
+		y = append(y, 3*p[0]*p[0]-0.5*p[1]+1)
+	}
+	result = callTool(t, r, ctx, "nonlinear_fit", map[string]any{
+		"model":      "a * g(s) + b * t + c",
+		"parameters": []any{"a", "b", "c"},
+		"guess":      []any{1.0, 1.0, 0.0},
+		"variables":  []any{"s", "t"},
+		"x":          x,
+		"y":          y,
+	})
+	require.False(t, result.IsError, resultText(result))
+	content := result.StructuredContent.(map[string]any)
+	assert.InDeltaSlice(t, []float64{3, -0.5, 1}, content["coefficients"], 1e-8)
+
+	// Running out of iterations warns
+	result = callTool(t, r, ctx, "nonlinear_fit", map[string]any{
+		"model":          "a * exp(-b * x)",
+		"parameters":     []any{"a", "b"},
+		"guess":          []any{10.0, 5.0},
+		"x":              []any{1.0, 2.0, 3.0, 4.0, 5.0},
+		"y":              []any{1.0, 0.5, 0.3, 0.2, 0.1},
+		"max_iterations": 1.0,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "warning: the tolerance was not met")
+	assert.Equal(t, false, result.StructuredContent.(map[string]any)["converged"])
+}
+
+func TestRegressionNotFinite(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		kind string
+	}{
+		{"fitted overflows", "fit_curve", map[string]any{"model": "exponential", "x": []any{0.0, 1.0, 2.0}, "y": []any{1e-300, 1.0, 1e300}}, "overflow"},
+		{"sums of squares overflow", "linear_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1e308, -1e308, 1e308}}, "overflow"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.False(t, result.IsError, resultText(result))
+			assert.Nil(t, result.StructuredContent)
+
+			cfg := allCategoriesConfig()
+			cfg.IEEEPolicy = config.IEEEStrict
+			result = callToolWithConfig(t, NewRegistry(), sessionContext("s1"), cfg, tt.tool, tt.args)
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.kind, result.StructuredContent.(map[string]any)["kind"])
+		})
+	}
+}
+
+func TestRegressionToolErrors(t *testing.T) {
+	tests := []struct {
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"linear_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0}}, "y has 2 values but x has 3"},
+		{"linear_regression", map[string]any{"x": []any{1.0, "a"}, "y": []any{1.0, 2.0}}, "x[1] must be a number or an array of numbers"},
+		{"linear_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0, 3.0}, "names": []any{"a", "b"}}, "names has 2 entries but there are 1 predictors"},
+		{"linear_regression", map[string]any{"x": []any{[]any{1.0, 2.0}, []any{2.0, 4.0}, []any{3.0, 6.0}, []any{4.0, 8.0}}, "y": []any{1.0, 2.0, 3.0, 4.0}}, "the predictors are linearly dependent"},
+		{"polynomial_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0, 3.0}, "degree": 0.0}, "degree must be between 1 and 20"},
+		{"polynomial_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0, 3.0}, "degree": 2.5}, "degree must be an integer between 1 and 20"},
+		{"polynomial_regression", map[string]any{"x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0, 3.0}, "degree": 1e300}, "degree must be an integer between 1 and 20"},
+		{"fit_curve", map[string]any{"model": "power", "x": []any{0.0, 1.0, 2.0}, "y": []any{1.0, 2.0, 3.0}}, "the power curve needs positive x, but x = 0"},
+		{"nonlinear_fit", map[string]any{"model": "a * x", "parameters": []any{"a"}, "guess": []any{1.0, 2.0}, "x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}}, "guess has 2 values but there are 1 parameters"},
+		{"nonlinear_fit", map[string]any{"model": "x * x", "parameters": []any{"x"}, "guess": []any{1.0}, "x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}}, `"x" is both a parameter and a variable`},
+		{"nonlinear_fit", map[string]any{"model": "a * b * x", "parameters": []any{"a", "b"}, "guess": []any{1.0, 1.0}, "x": []any{1.0, 2.0, 3.0, 4.0}, "y": []any{1.0, 2.0, 3.0, 4.0}}, "not all determined by the data"},
+		{"nonlinear_fit", map[string]any{"model": "log(a * x)", "parameters": []any{"a"}, "guess": []any{-1.0}, "x": []any{1.0, 2.0, 3.0}, "y": []any{1.0, 2.0, 3.0}}, "not finite at every observation"},
+		{"nonlinear_fit", map[string]any{"model": "a * x", "parameters": []any{"a"}, "guess": []any{1.0}, "x": []any{1.0, 2.0}, "y": []any{1.0, 2.0}, "max_iterations": 0.0}, "max_iterations must be between 1 and 10000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.want)
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/calculus"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultRootTolerance is the default absolute accuracy of a root.
+	defaultRootTolerance = 1e-12
+	// defaultRootIterations and maxRootIterations are the default and the
+	// largest iteration limits of root finding.
+	defaultRootIterations = 100
+	maxRootIterations     = 10000
+)
+
+// pointParam declares an optional point given as a number or an expression.
+func pointParam(name, description string) mcp.ToolOption {
+	return mcp.WithAny(name,
+		mcp.Description(description+`: a number or an expression such as "pi/2"`),
+		func(schema map[string]any) { maps.Copy(schema, boundSchema) },
+	)
+}
+
+// pointValue reads the optional point name, reporting whether it was given.
+func (s *sessionStore) pointValue(ctx context.Context, req mcp.CallToolRequest, name string) (float64, bool, error) {
+	raw, ok := req.GetArguments()[name]
+	if !ok {
+		return 0, false, nil
+	}
+	v, err := s.boundValue(ctx, name, raw)
+	if err != nil {
+		return 0, false, err
+	}
+	if math.IsInf(v, 0) {
+		return 0, false, fmt.Errorf("%s must be finite", name)
+	}
+	return v, true, nil
+}
+
+// equationExpression turns an equation lhs = rhs into the expression
+// (lhs) - (rhs), whose roots are its solutions, and leaves expressions as
+// they are.
+func equationExpression(equation string) (string, error) {
+	sides := strings.Split(equation, "=")
+	switch len(sides) {
+	case 1:
+		return equation, nil
+	case 2:
+		if strings.TrimSpace(sides[0]) == "" || strings.TrimSpace(sides[1]) == "" {
+			return "", fmt.Errorf("equation %q needs an expression on each side of \"=\"", equation)
+		}
+		return fmt.Sprintf("(%s) - (%s)", sides[0], sides[1]), nil
+	}
+	return "", fmt.Errorf("equation %q has more than one \"=\"", equation)
+}
+
+// rootOptions reads the tolerance and iteration limit of root finding.
+func rootOptions(req mcp.CallToolRequest) (float64, int, error) {
+	tol := req.GetFloat("tolerance", defaultRootTolerance)
+	if !(tol >= 0) || math.IsInf(tol, 0) {
+		return 0, 0, errors.New("tolerance must be a non-negative number")
+	}
+	maxIter := req.GetInt("max_iterations", defaultRootIterations)
+	if maxIter < 1 || maxIter > maxRootIterations {
+		return 0, 0, fmt.Errorf("max_iterations must be between 1 and %d", maxRootIterations)
+	}
+	return tol, maxIter, nil
+}
+
+// rootError converts an error from a scalar root search that stopped at r
+// into a message.
+func rootError(err error, r calculus.Root, variable string) string {
+	var bracketErr *calculus.BracketError
+	switch {
+	case errors.As(err, &bracketErr):
+		return fmt.Sprintf("the expression does not change sign between %s = %g and %s = %g, where it is %g and %g; choose a bracket at whose ends it has opposite signs",
+			variable, bracketErr.A, variable, bracketErr.B, bracketErr.FA, bracketErr.FB)
+	case errors.Is(err, calculus.ErrNoSignChange):
+		return fmt.Sprintf("the expression changes sign at %s = %g without vanishing, as at a pole or jump; its value there is %g", variable, r.X, r.Residual)
+	case errors.Is(err, calculus.ErrZeroSlope):
+		return fmt.Sprintf("the expression is flat at %s = %g, so no step can be taken; try another starting guess or a bracket", variable, r.X)
+	case errors.Is(err, calculus.ErrNotFinite) && r.Iterations == 0:
+		return "the expression is not finite at the starting points"
+	case errors.Is(err, calculus.ErrNotFinite):
+		return fmt.Sprintf("the expression is not finite beyond %s = %g; try another starting guess or a bracket", variable, r.X)
+	}
+	return err.Error()
+}
+
+// notConvergedWarning is appended to the results of root searches that ran
+// out of iterations.
+const notConvergedWarning = "\nwarning: the tolerance was not met within max_iterations, so the result may be inaccurate; raise max_iterations or try another starting point"
+
+func (s *sessionStore) findRootHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if expression, err = equationExpression(expression); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variable := req.GetString("variable", "x")
+	points := make(map[string]float64)
+	for _, name := range []string{"lower", "upper", "guess", "second_guess"} {
+		v, ok, err := s.pointValue(ctx, req, name)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if ok {
+			points[name] = v
+		}
+	}
+	_, hasLower := points["lower"]
+	_, hasUpper := points["upper"]
+	_, hasGuess := points["guess"]
+	method := req.GetString("method", "")
+	if method == "" {
+		switch {
+		case hasLower && hasUpper:
+			method = "brent"
+		case hasGuess:
+			method = "newton"
+		default:
+			return mcp.NewToolResultError("give a bracket with lower and upper, or a starting guess"), nil
+		}
+	}
+	tol, maxIter, err := rootOptions(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	mf, err := s.compileFunction(ctx, expression, []string{variable})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	f := func(x float64) (float64, error) { return mf([]float64{x}) }
+	var root calculus.Root
+	switch method {
+	case "brent", "bisection":
+		if !hasLower || !hasUpper {
+			return mcp.NewToolResultError(fmt.Sprintf("%s needs a bracket with lower and upper", method)), nil
+		}
+		solve := calculus.Brent
+		if method == "bisection" {
+			solve = calculus.Bisect
+		}
+		root, err = solve(f, points["lower"], points["upper"], tol, maxIter)
+	case "newton", "secant":
+		if !hasGuess {
+			return mcp.NewToolResultError(fmt.Sprintf("%s needs a starting guess", method)), nil
+		}
+		x0 := points["guess"]
+		if method == "newton" {
+			root, err = calculus.Newton(f, x0, tol, maxIter)
+			break
+		}
+		x1, ok := points["second_guess"]
+		if !ok {
+			x1 = x0 + 1e-3*math.Max(math.Abs(x0), 1)
+		}
+		root, err = calculus.Secant(f, x0, x1, tol, maxIter)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown method %q", method)), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(rootError(err, root, variable)), nil
+	}
+
+	recordResult(ctx, root.X)
+	text := fmt.Sprintf("%s = %g\nresidual: %.2g\niterations: %d", variable, root.X, root.Residual, root.Iterations)
+	if !root.Converged {
+		text += notConvergedWarning
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{
+		"root":       root.X,
+		"residual":   root.Residual,
+		"iterations": root.Iterations,
+		"converged":  root.Converged,
+		"method":     method,
+	}
+	return result, nil
+}
+
+func (s *sessionStore) solveSystemHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	equations, err := req.RequireStringSlice("equations")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variables, guess, err := pointArgs(req, "guess")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(equations) != len(variables) {
+		return mcp.NewToolResultError(fmt.Sprintf("there are %d equations but %d variables; the system must be square", len(equations), len(variables))), nil
+	}
+	tol, maxIter, err := rootOptions(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	fs := make([]calculus.MultiFunc, len(equations))
+	for i, eq := range equations {
+		expression, err := equationExpression(eq)
+		if err == nil {
+			fs[i], err = s.compileFunction(ctx, expression, variables)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("equation %d: %v", i+1, err)), nil
+		}
+	}
+	root, err := calculus.NewtonSystem(fs, guess, tol, maxIter)
+	if err != nil {
+		return mcp.NewToolResultError(systemError(err, root, variables)), nil
+	}
+
+	text := fmt.Sprintf("%s\nresidual: %.2g\niterations: %d", formatAssignments(variables, root.X), maxAbs(root.Residuals), root.Iterations)
+	if !root.Converged {
+		text += notConvergedWarning
+	}
+	result := mcp.NewToolResultText(text)
+	result.StructuredContent = map[string]any{
+		"variables":  variables,
+		"root":       root.X,
+		"residuals":  root.Residuals,
+		"iterations": root.Iterations,
+		"converged":  root.Converged,
+	}
+	return result, nil
+}
+
+// systemError converts an error from solving a system that stopped at r
+// into a message.
+func systemError(err error, r calculus.SystemRoot, variables []string) string {
+	switch {
+	case errors.Is(err, calculus.ErrSingularJacobian):
+		return fmt.Sprintf("the Jacobian is singular at %s; try another starting guess", formatAssignments(variables, r.X))
+	case errors.Is(err, calculus.ErrStalled):
+		return fmt.Sprintf("no step reduces the residual at %s, where it is %.2g; the system may have no root nearby, so try another starting guess",
+			formatAssignments(variables, r.X), maxAbs(r.Residuals))
+	case errors.Is(err, calculus.ErrNotFinite):
+		return "the equations are not finite at the starting guess"
+	}
+	return err.Error()
+}
+
+// formatAssignments formats the values of variables as "x = 1, y = 2".
+func formatAssignments(variables []string, values []float64) string {
+	parts := make([]string, len(variables))
+	for i, name := range variables {
+		parts[i] = fmt.Sprintf("%s = %g", name, values[i])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// maxAbs returns the largest magnitude of values.
+func maxAbs(values []float64) float64 {
+	var m float64
+	for _, v := range values {
+		m = math.Max(m, math.Abs(v))
+	}
+	return m
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRootTool(t *testing.T) {
+	// x eˣ = 5 has the root W(5)
+	const lambert5 = 1.3267246652422002
+	tests := []struct {
+		name   string
+		args   map[string]any
+		want   float64
+		method string
+	}{
+		{"brent by default", map[string]any{"lower": 0.0, "upper": 3.0}, lambert5, "brent"},
+		{"bisection", map[string]any{"lower": 0.0, "upper": 3.0, "method": "bisection"}, lambert5, "bisection"},
+		{"newton by default", map[string]any{"guess": 1.0}, lambert5, "newton"},
+		{"secant", map[string]any{"guess": 1.0, "method": "secant"}, lambert5, "secant"},
+		{"secant with two guesses", map[string]any{"guess": 1.0, "second_guess": 2.0, "method": "secant"}, lambert5, "secant"},
+		{"expression bounds", map[string]any{"lower": "1/2", "upper": "e"}, lambert5, "brent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]any{"expression": "x * exp(x) = 5"}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "find_root", args)
+			require.False(t, result.IsError, resultText(result))
+			assert.Contains(t, resultText(result), "x = 1.32672466524")
+			assert.Contains(t, resultText(result), "\niterations: ")
+
+			content := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.want, content["root"], 1e-11)
+			assert.InDelta(t, 0, content["residual"], 1e-9)
+			assert.Equal(t, true, content["converged"])
+			assert.Equal(t, tt.method, content["method"])
+		})
+	}
+}
+
+func TestFindRootSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// The break-even rate of a loan: 1000 now against 12 payments of 90
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "payment", "value": 90.0})
+	result := callTool(t, r, ctx, "find_root", map[string]any{
+		"expression": "payment * (1 - (1 + rate)^-12) / rate = 1000",
+		"variable":   "rate",
+		"lower":      0.001,
+		"upper":      0.1,
+	})
+	require.False(t, result.IsError, resultText(result))
+	rate := result.StructuredContent.(map[string]any)["root"].(float64)
+	assert.InDelta(t, 1000, 90*(1-math.Pow(1+rate, -12))/rate, 1e-8)
+
+	// The root becomes the latest answer
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	ans, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, rate, ans, 0)
+}
+
+func TestFindRootNotConverged(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "find_root", map[string]any{
+		"expression":     "cos(x) - x",
+		"lower":          0.0,
+		"upper":          1.0,
+		"method":         "bisection",
+		"max_iterations": 5.0,
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "warning: the tolerance was not met")
+	assert.Equal(t, false, result.StructuredContent.(map[string]any)["converged"])
+}
+
+func TestFindRootErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		msg  string
+	}{
+		{"no start", map[string]any{"expression": "x - 1"}, "give a bracket with lower and upper, or a starting guess"},
+		{"no sign change", map[string]any{"expression": "x^2 + 1", "lower": -1.0, "upper": 2.0}, "the expression does not change sign between x = -1 and x = 2, where it is 2 and 5"},
+		{"pole", map[string]any{"expression": "1 / x", "lower": -1.0, "upper": 2.0}, "the expression changes sign at x = "},
+		{"jump", map[string]any{"expression": "sign(x)", "lower": -1.0, "upper": 2.0}, "without vanishing, as at a pole or jump"},
+		{"flat", map[string]any{"expression": "x^2 + 1", "guess": 0.0}, "the expression is flat at x = 0"},
+		{"not finite at start", map[string]any{"expression": "log(x)", "guess": -1.0}, "the expression is not finite at the starting points"},
+		{"bracket method without bracket", map[string]any{"expression": "x", "guess": 1.0, "method": "brent"}, "brent needs a bracket with lower and upper"},
+		{"newton without guess", map[string]any{"expression": "x", "lower": -1.0, "upper": 1.0, "method": "newton"}, "newton needs a starting guess"},
+		{"infinite bound", map[string]any{"expression": "x", "lower": "-inf", "upper": 1.0}, "lower must be finite"},
+		{"two equals signs", map[string]any{"expression": "x = 1 = 2", "guess": 1.0}, `has more than one "="`},
+		{"empty side", map[string]any{"expression": "x =", "guess": 1.0}, `needs an expression on each side of "="`},
+		{"iterations", map[string]any{"expression": "x", "guess": 1.0, "max_iterations": 0.0}, "max_iterations must be between 1 and 10000"},
+		{"tolerance", map[string]any{"expression": "x", "guess": 1.0, "tolerance": -1.0}, "tolerance must be a non-negative number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "find_root", tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
+
+func TestSolveSystemTool(t *testing.T) {
+	result := callTool(t, NewRegistry(), sessionContext("s1"), "solve_system", map[string]any{
+		"equations": []any{"x^2 + y^2 = 4", "y = exp(x) - 1"},
+		"variables": []any{"x", "y"},
+		"guess":     []any{-2.0, 1.0},
+	})
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "x = -1.8")
+	assert.Contains(t, resultText(result), "\nresidual: ")
+
+	content := result.StructuredContent.(map[string]any)
+	root := content["root"].([]float64)
+	assert.InDelta(t, 4, root[0]*root[0]+root[1]*root[1], 1e-12)
+	assert.InDelta(t, math.Exp(root[0])-1, root[1], 1e-12)
+	assert.Equal(t, true, content["converged"])
+	assert.Equal(t, []string{"x", "y"}, content["variables"])
+}
+
+func TestSolveSystemErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]any
+		msg  string
+	}{
+		{"not square", map[string]any{"equations": []any{"x + y"}, "variables": []any{"x", "y"}, "guess": []any{0.0, 0.0}},
+			"there are 1 equations but 2 variables; the system must be square"},
+		{"guess length", map[string]any{"equations": []any{"x"}, "variables": []any{"x"}, "guess": []any{0.0, 0.0}},
+			"guess has 2 values but there are 1 variables"},
+		{"singular", map[string]any{"equations": []any{"x + y - 1", "2x + 2y"}, "variables": []any{"x", "y"}, "guess": []any{0.0, 0.0}},
+			"the Jacobian is singular at x = 0, y = 0"},
+		{"no root", map[string]any{"equations": []any{"x^2 + 1"}, "variables": []any{"x"}, "guess": []any{1.0}},
+			"no step reduces the residual at x = "},
+		{"bad equation", map[string]any{"equations": []any{"x", "y +"}, "variables": []any{"x", "y"}, "guess": []any{0.0, 0.0}},
+			"equation 2: "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), "solve_system", tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.msg)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// historySize is the number of results kept as ans1..ansN.
+	historySize = 10
+	// maxVariables limits the variables stored per session.
+	maxVariables = 256
+	// maxSessions limits the sessions kept in memory; the least recently
+	// used session is dropped when it is exceeded.
+	maxSessions = 1024
+)
+
+var (
+	variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	historyNamePattern  = regexp.MustCompile(`^ans[0-9]*$`)
+)
+
+// sessionState holds the variables, answer history and user-defined
+// functions of one MCP session.
+type sessionState struct {
+	vars     map[string]float64
+	history  []float64 // most recent first
+	funcs    map[string]*userFunction
+	lastUsed time.Time
+}
+
+// sessionStore holds per-session state keyed by MCP session ID.
+type sessionStore struct {
+	mu        sync.Mutex
+	sessions  map[string]*sessionState
+	libraries map[string]*functionLibrary // keyed by file
+}
+
+func newSessionStore() *sessionStore {
+	return &sessionStore{sessions: make(map[string]*sessionState), libraries: make(map[string]*functionLibrary)}
+}
+
+// sessionID returns the ID of the MCP session in ctx, or "" outside a session.
+func sessionID(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return session.SessionID()
+	}
+	return ""
+}
+
+// noSessionError reports that tool only changes session state, which calls
+// outside an MCP session, such as REST API requests, do not keep.
+func noSessionError(tool string) *mcp.CallToolResult {
+	return mcp.NewToolResultError(fmt.Sprintf("%s needs an MCP session: calls without one, such as REST API requests, keep no state", tool))
+}
+
+// state returns the state for id, creating it if needed. Calls made outside
+// an MCP session, such as REST API requests, get fresh state that is not
+// kept. The caller must hold s.mu.
+func (s *sessionStore) state(id string) *sessionState {
+	if id == "" {
+		return &sessionState{vars: make(map[string]float64), funcs: make(map[string]*userFunction)}
+	}
+	st, ok := s.sessions[id]
+	if !ok {
+		if len(s.sessions) >= maxSessions {
+			s.evictOldest()
+		}
+		st = &sessionState{vars: make(map[string]float64), funcs: make(map[string]*userFunction)}
+		s.sessions[id] = st
+	}
+	st.lastUsed = time.Now()
+	return st
+}
+
+// evictOldest drops the least recently used session. The caller must hold s.mu.
+func (s *sessionStore) evictOldest() {
+	var oldestID string
+	var oldest time.Time
+	for id, st := range s.sessions {
+		if oldestID == "" || st.lastUsed.Before(oldest) {
+			oldestID, oldest = id, st.lastUsed
+		}
+	}
+	delete(s.sessions, oldestID)
+}
+
+// end drops all state for a session.
+func (s *sessionStore) end(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, id)
+}
+
+// set stores a variable.
+func (s *sessionStore) set(id, name string, value float64) error {
+	if err := validateVariableName(name); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.state(id)
+	if _, exists := st.vars[name]; !exists && len(st.vars) >= maxVariables {
+		return fmt.Errorf("too many variables (max %d per session)", maxVariables)
+	}
+	st.vars[name] = value
+	return nil
+}
+
+// get looks up a variable or history entry (ans, ans1..ansN).
+func (s *sessionStore) get(id, name string) (float64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.state(id)
+	if historyNamePattern.MatchString(name) {
+		n := 1
+		if name != "ans" {
+			n, _ = strconv.Atoi(strings.TrimPrefix(name, "ans"))
+		}
+		if n < 1 || n > len(st.history) {
+			return 0, false
+		}
+		return st.history[n-1], true
+	}
+	v, ok := st.vars[name]
+	return v, ok
+}
+
+// record pushes a result onto the answer history.
+func (s *sessionStore) record(id string, value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.state(id)
+	st.history = append([]float64{value}, st.history...)
+	if len(st.history) > historySize {
+		st.history = st.history[:historySize]
+	}
+}
+
+// snapshot returns copies of a session's variables and history.
+func (s *sessionStore) snapshot(id string) (map[string]float64, []float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.state(id)
+	vars := make(map[string]float64, len(st.vars))
+	for k, v := range st.vars {
+		vars[k] = v
+	}
+	return vars, append([]float64(nil), st.history...)
+}
+
+// validateVariableName checks that name is an identifier not reserved for
+// the answer history.
+func validateVariableName(name string) error {
+	if !variableNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid variable name %q: use letters, digits and underscores", name)
+	}
+	if historyNamePattern.MatchString(name) {
+		return fmt.Errorf("variable name %q is reserved for the answer history", name)
+	}
+	return nil
+}
+
+// resultRecorder captures the scalar result of a tool call for the history.
+type resultRecorder struct {
+	value float64
+	ok    bool
+}
+
+type resultRecorderKey struct{}
+
+// recordResult notes v as the scalar result of the current call.
+func recordResult(ctx context.Context, v float64) {
+	if rec, ok := ctx.Value(resultRecorderKey{}).(*resultRecorder); ok {
+		rec.value, rec.ok = v, true
+	}
+}
+
+// sessionMiddleware resolves "$name" references in numeric arguments of tool
+// against the session's variables and history, and records scalar results
+// as the new "ans".
+func (s *sessionStore) sessionMiddleware(tool mcp.Tool) server.ToolHandlerMiddleware {
+	numeric := numericParams(tool)
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			id := sessionID(ctx)
+			if args := req.GetArguments(); args != nil && len(numeric) > 0 {
+				resolved, err := s.resolveArguments(id, args, numeric)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				req.Params.Arguments = resolved
+			}
+
+			rec := &resultRecorder{}
+			result, err := next(context.WithValue(ctx, resultRecorderKey{}, rec), req)
+			if err == nil && result != nil && !result.IsError && rec.ok {
+				s.record(id, rec.value)
+			}
+			return result, err
+		}
+	}
+}
+
+// resolveArguments returns a copy of args with variable references replaced
+// by their values.
+func (s *sessionStore) resolveArguments(id string, args map[string]any, numeric map[string]bool) (map[string]any, error) {
+	resolved := make(map[string]any, len(args))
+	for key, val := range args {
+		resolved[key] = val
+		if !numeric[key] {
+			continue
+		}
+		v, err := s.resolveValue(id, val)
+		if err != nil {
+			return nil, err
+		}
+		resolved[key] = v
+	}
+	return resolved, nil
+}
+
+// resolveValue resolves references in a numeric argument, descending into
+// arrays so that matrix entries can be references too.
+func (s *sessionStore) resolveValue(id string, val any) (any, error) {
+	switch v := val.(type) {
+	case string:
+		return s.resolveReference(id, v)
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			r, err := s.resolveValue(id, item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = r
+		}
+		return items, nil
+	}
+	return val, nil
+}
+
+// resolveReference converts "$name" to the variable's value. Other strings
+// are passed through for the handler to parse.
+func (s *sessionStore) resolveReference(id, ref string) (any, error) {
+	name, ok := strings.CutPrefix(strings.TrimSpace(ref), "$")
+	if !ok {
+		return ref, nil
+	}
+	v, found := s.get(id, name)
+	if !found {
+		return nil, fmt.Errorf("unknown variable $%s", name)
+	}
+	return v, nil
+}
+
+// referenceNote is appended to the description of numeric parameters.
+const referenceNote = `Session variables may be used in place of numbers as "$name", e.g. "$ans".`
+
+// variableReferenceOption documents "$name" references in the description
+// of each numeric parameter of tool that does not already mention them.
+func variableReferenceOption(tool *mcp.Tool) {
+	for name := range numericParams(*tool) {
+		schema := tool.InputSchema.Properties[name].(map[string]any)
+		desc, _ := schema["description"].(string)
+		if strings.Contains(desc, `"$`) {
+			continue
+		}
+		if desc != "" {
+			desc = strings.TrimSuffix(desc, ".") + ". "
+		}
+		schema["description"] = desc + referenceNote
+	}
+}
+
+// numericParams returns the names of a tool's number and numeric array
+// parameters, including those that accept a numeric array among other forms.
+func numericParams(tool mcp.Tool) map[string]bool {
+	params := make(map[string]bool)
+	for name, prop := range tool.InputSchema.Properties {
+		if schema, ok := prop.(map[string]any); ok && isNumericSchema(schema) {
+			params[name] = true
+		}
+	}
+	return params
+}
+
+// isNumericSchema reports whether schema describes a number or an array that
+// may hold numbers, or offers one of those through anyOf.
+func isNumericSchema(schema map[string]any) bool {
+	switch schema["type"] {
+	case "number", "integer":
+		return true
+	case "array":
+		// Arrays of strings, such as parameter names, are left as is
+		items, ok := schema["items"].(map[string]any)
+		return !ok || items["type"] != "string"
+	}
+	alternatives, _ := schema["anyOf"].([]any)
+	for _, alt := range alternatives {
+		if alt, ok := alt.(map[string]any); ok && isNumericSchema(alt) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/linalg"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// maxSparseDimension caps the rows and columns of a sparse matrix, which
+	// cost memory even when empty.
+	maxSparseDimension = 1 << 24
+	// maxSparseIterations caps the iterations of sparse_solve.
+	maxSparseIterations = 100000
+	// defaultSparseTolerance is the default relative residual of sparse_solve.
+	defaultSparseTolerance = 1e-10
+	// defaultGMRESRestart is the default Krylov basis size of GMRES.
+	defaultGMRESRestart = 30
+	// maxGMRESRestart caps the Krylov basis size, since GMRES keeps restart
+	// vectors and a (restart+1)×restart Hessenberg matrix.
+	maxGMRESRestart = 1000
+)
+
+// sparseMatrixParam declares a sparse matrix argument in COO or CSR form.
+func sparseMatrixParam(name, description string) mcp.ToolOption {
+	indices := map[string]any{"type": "array", "items": map[string]any{"type": "integer"}}
+	return mcp.WithObject(name,
+		mcp.Required(),
+		mcp.Description(description+`, either in coordinate form {"format": "coo", "shape": [rows, cols], "row": [...], "col": [...], "values": [...]} `+
+			`or compressed sparse row form {"format": "csr", "shape": [rows, cols], "row_ptr": [...], "col_idx": [...], "values": [...]}. `+
+			"Indices start at 0; duplicate entries are summed"),
+		mcp.Properties(map[string]any{
+			"format":  map[string]any{"type": "string", "enum": []string{"coo", "csr"}},
+			"shape":   indices,
+			"row":     indices,
+			"col":     indices,
+			"row_ptr": indices,
+			"col_idx": indices,
+			"values":  map[string]any{"type": "array", "items": map[string]any{"type": "number"}},
+		}),
+	)
+}
+
+// indexField reads a field of a sparse matrix object as non-negative integers.
+func indexField(obj map[string]any, name, field string) ([]int, error) {
+	items, ok := obj[field].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s.%s must be an array of non-negative integers", name, field)
+	}
+	out := make([]int, len(items))
+	for i, item := range items {
+		v, ok := item.(float64)
+		if !ok || v < 0 || v != math.Trunc(v) || v > math.MaxInt32 {
+			return nil, fmt.Errorf("%s.%s[%d] must be a non-negative integer", name, field, i)
+		}
+		out[i] = int(v)
+	}
+	return out, nil
+}
+
+// valuesField reads the values of a sparse matrix object.
+func valuesField(obj map[string]any, name string) ([]float64, error) {
+	items, ok := obj["values"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s.values must be an array of numbers", name)
+	}
+	out := make([]float64, len(items))
+	for i, item := range items {
+		v, ok := item.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s.values[%d] must be a number", name, i)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// sparseMatrixArg reads a sparse matrix argument in COO or CSR form.
+func sparseMatrixArg(req mcp.CallToolRequest, name string) (*linalg.CSR, error) {
+	raw, ok := req.GetArguments()[name]
+	if !ok {
+		return nil, fmt.Errorf("required argument %q not found", name)
+	}
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object with format, shape and entries", name)
+	}
+	shape, err := indexField(obj, name, "shape")
+	if err != nil {
+		return nil, err
+	}
+	if len(shape) != 2 || shape[0] < 1 || shape[1] < 1 || shape[0] > maxSparseDimension || shape[1] > maxSparseDimension {
+		return nil, fmt.Errorf("%s.shape must be [rows, cols] with each between 1 and %d", name, maxSparseDimension)
+	}
+	values, err := valuesField(obj, name)
+	if err != nil {
+		return nil, err
+	}
+	if !allFinite(values) {
+		return nil, fmt.Errorf("%s.values must be finite", name)
+	}
+
+	var m *linalg.CSR
+	switch format, _ := obj["format"].(string); format {
+	case "coo":
+		row, err := indexField(obj, name, "row")
+		if err != nil {
+			return nil, err
+		}
+		col, err := indexField(obj, name, "col")
+		if err != nil {
+			return nil, err
+		}
+		m, err = linalg.FromCOO(shape[0], shape[1], row, col, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	case "csr":
+		rowPtr, err := indexField(obj, name, "row_ptr")
+		if err != nil {
+			return nil, err
+		}
+		colIdx, err := indexField(obj, name, "col_idx")
+		if err != nil {
+			return nil, err
+		}
+		m, err = linalg.FromCSR(shape[0], shape[1], rowPtr, colIdx, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s.format must be coo or csr", name)
+	}
+	return m, nil
+}
+
+func sparseMatVecHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := sparseMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	x, err := req.RequireFloatSlice("x")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	y, err := m.MulVec(x)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return vectorResult(ctx, y, x...), nil
+}
+
+func sparseSolveHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := sparseMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if m.Rows != m.Cols {
+		return mcp.NewToolResultError(fmt.Sprintf("matrix must be square, got %s", m.Shape())), nil
+	}
+	b, err := req.RequireFloatSlice("b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(b) != m.Rows {
+		return mcp.NewToolResultError(fmt.Sprintf("b has %d entries but the matrix has %d rows", len(b), m.Rows)), nil
+	}
+	if !allFinite(b) {
+		return mcp.NewToolResultError("b must be finite"), nil
+	}
+
+	tol := req.GetFloat("tolerance", defaultSparseTolerance)
+	if !(tol > 0) {
+		return mcp.NewToolResultError("tolerance must be positive"), nil
+	}
+	maxIter := req.GetInt("max_iterations", min(10*m.Rows, maxSparseIterations))
+	if maxIter < 1 || maxIter > maxSparseIterations {
+		return mcp.NewToolResultError(fmt.Sprintf("max_iterations must be between 1 and %d", maxSparseIterations)), nil
+	}
+	restart := req.GetInt("restart", defaultGMRESRestart)
+	if restart < 1 || restart > maxGMRESRestart {
+		return mcp.NewToolResultError(fmt.Sprintf("restart must be between 1 and %d", maxGMRESRestart)), nil
+	}
+
+	method := req.GetString("method", "auto")
+	if method == "auto" {
+		method = "gmres"
+		if m.IsSymmetric() {
+			method = "cg"
+		}
+	}
+	var res *linalg.IterativeResult
+	switch method {
+	case "cg":
+		res, err = linalg.CG(m, b, tol, maxIter)
+	case "gmres":
+		res, err = linalg.GMRES(m, b, tol, restart, maxIter)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown method %q: must be auto, cg or gmres", method)), nil
+	}
+	switch {
+	case errors.Is(err, linalg.ErrNotSymmetric):
+		return mcp.NewToolResultError("conjugate gradient requires a symmetric matrix; use method gmres"), nil
+	case errors.Is(err, linalg.ErrNotPositiveDefinite):
+		return mcp.NewToolResultError("matrix is not positive definite, so conjugate gradient does not apply; use method gmres"), nil
+	case errors.Is(err, linalg.ErrSingular):
+		return mcp.NewToolResultError("GMRES broke down: the matrix appears to be singular"), nil
+	case err != nil:
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	for _, v := range res.X {
+		if err := checkIEEE(ctx, v, b...); err != nil {
+			return ieeeErrorResult(err), nil
+		}
+	}
+
+	text := fmt.Sprintf("x = %s\nmethod: %s\niterations: %d\nrelative residual: %g", formatFloats(res.X), method, res.Iterations, res.Residual)
+	if !res.Converged {
+		text += fmt.Sprintf("\nwarning: did not reach the tolerance %g within %d iterations", tol, maxIter)
+	}
+	result := mcp.NewToolResultText(text)
+	if allFinite(res.X) {
+		result.StructuredContent = map[string]any{
+			"x":                 res.X,
+			"method":            method,
+			"iterations":        res.Iterations,
+			"relative_residual": res.Residual,
+			"converged":         res.Converged,
+		}
+	}
+	return result, nil
+}
+
+func sparseInfoHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	m, err := sparseMatrixArg(req, "matrix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	s := m.Structure()
+	info := map[string]any{
+		"shape":                  []int{m.Rows, m.Cols},
+		"nnz":                    s.NNZ,
+		"density":                s.Density,
+		"min_row_nnz":            s.MinRowNNZ,
+		"max_row_nnz":            s.MaxRowNNZ,
+		"empty_rows":             s.EmptyRows,
+		"empty_cols":             s.EmptyCols,
+		"lower_bandwidth":        s.LowerBandwidth,
+		"upper_bandwidth":        s.UpperBandwidth,
+		"structurally_symmetric": s.StructurallySymmetric,
+		"symmetric":              s.Symmetric,
+	}
+	lines := []string{
+		"shape: " + m.Shape(),
+		fmt.Sprintf("nonzeros: %d (density %.3g%%)", s.NNZ, 100*s.Density),
+		fmt.Sprintf("nonzeros per row: %d to %d", s.MinRowNNZ, s.MaxRowNNZ),
+		fmt.Sprintf("empty rows: %d, empty columns: %d", s.EmptyRows, s.EmptyCols),
+		fmt.Sprintf("bandwidth: %d below, %d above the diagonal", s.LowerBandwidth, s.UpperBandwidth),
+		fmt.Sprintf("structurally symmetric: %t", s.StructurallySymmetric),
+		fmt.Sprintf("symmetric: %t", s.Symmetric),
+	}
+	if m.Rows == m.Cols {
+		info["zero_diagonal"] = s.ZeroDiagonal
+		info["diagonally_dominant"] = s.DiagonallyDominant
+		lines = append(lines,
+			fmt.Sprintf("zero diagonal entries: %d", s.ZeroDiagonal),
+			fmt.Sprintf("strictly diagonally dominant: %t", s.DiagonallyDominant),
+		)
+	}
+	result := mcp.NewToolResultText(strings.Join(lines, "\n"))
+	result.StructuredContent = info
+	return result, nil
+}
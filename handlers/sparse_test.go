@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// intsJSON converts indices to the array of a decoded JSON argument.
+func intsJSON(values ...int) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = float64(v)
+	}
+	return out
+}
+
+// poissonCOO returns the n×n 1-D Poisson matrix tridiag(-1, 2, -1) in
+// coordinate form.
+func poissonCOO(n int) map[string]any {
+	var row, col []int
+	var values []float64
+	for i := range n {
+		for j := max(i-1, 0); j <= min(i+1, n-1); j++ {
+			row, col = append(row, i), append(col, j)
+			if i == j {
+				values = append(values, 2)
+			} else {
+				values = append(values, -1)
+			}
+		}
+	}
+	return map[string]any{
+		"format": "coo",
+		"shape":  intsJSON(n, n),
+		"row":    intsJSON(row...),
+		"col":    intsJSON(col...),
+		"values": vectorJSON(values...),
+	}
+}
+
+func TestSparseMatVec(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix map[string]any
+	}{
+		{"coo", map[string]any{"format": "coo", "shape": intsJSON(2, 3), "row": intsJSON(0, 1, 0), "col": intsJSON(0, 1, 2), "values": vectorJSON(1, 3, 2)}},
+		{"csr", map[string]any{"format": "csr", "shape": intsJSON(2, 3), "row_ptr": intsJSON(0, 2, 3), "col_idx": intsJSON(0, 2, 1), "values": vectorJSON(1, 2, 3)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sparseMatVecHandler(context.Background(), makeRequest(map[string]any{
+				"matrix": tt.matrix,
+				"x":      vectorJSON(1, 1, 1),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, "[3, 3]", resultText(result))
+			assert.Equal(t, map[string]any{"vector": []float64{3, 3}}, result.StructuredContent)
+		})
+	}
+}
+
+func TestSparseSolve(t *testing.T) {
+	n := 500
+	b := make([]float64, n)
+	for i := range b {
+		b[i] = 1
+	}
+
+	for _, method := range []string{"auto", "cg", "gmres"} {
+		t.Run(method, func(t *testing.T) {
+			result, err := sparseSolveHandler(context.Background(), makeRequest(map[string]any{
+				"matrix":    poissonCOO(n),
+				"b":         vectorJSON(b...),
+				"method":    method,
+				"tolerance": 1e-8,
+				"restart":   float64(n),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, resultText(result))
+
+			structured := result.StructuredContent.(map[string]any)
+			assert.True(t, structured["converged"].(bool))
+			assert.LessOrEqual(t, structured["relative_residual"], 1e-8)
+			// x_i = (i+1)(n−i)/2 for the Poisson problem with b = 1
+			x := structured["x"].([]float64)
+			assert.InDelta(t, float64(n)/2, x[0], 1e-3)
+			assert.InDelta(t, float64(250*251)/2, x[249], 1e-2)
+			if method == "auto" {
+				assert.Equal(t, "cg", structured["method"])
+			}
+		})
+	}
+}
+
+func TestSparseSolveNotConverged(t *testing.T) {
+	b := make([]float64, 50)
+	for i := range b {
+		b[i] = 1
+	}
+	result, err := sparseSolveHandler(context.Background(), makeRequest(map[string]any{
+		"matrix":         poissonCOO(50),
+		"b":              vectorJSON(b...),
+		"max_iterations": 3.0,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "iterations: 3\n")
+	assert.Contains(t, resultText(result), "warning: did not reach the tolerance 1e-10 within 3 iterations")
+	assert.False(t, result.StructuredContent.(map[string]any)["converged"].(bool))
+}
+
+func TestSparseSolveOverflow(t *testing.T) {
+	// The solution [1e616, 1] is too large, not a failed positive
+	// definiteness test
+	args := map[string]any{
+		"matrix": map[string]any{
+			"format": "coo",
+			"shape":  intsJSON(2, 2),
+			"row":    intsJSON(0, 1),
+			"col":    intsJSON(0, 1),
+			"values": vectorJSON(1e-308, 1),
+		},
+		"b": vectorJSON(1e308, 1),
+	}
+	result, err := sparseSolveHandler(withIEEEPolicy(context.Background(), config.IEEEStrict), makeRequest(args))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, "overflow", result.StructuredContent.(map[string]any)["kind"])
+
+	result, err = sparseSolveHandler(context.Background(), makeRequest(args))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	assert.Contains(t, resultText(result), "x = [+Inf, ")
+	assert.Nil(t, result.StructuredContent)
+}
+
+func TestSparseInfo(t *testing.T) {
+	result, err := sparseInfoHandler(context.Background(), makeRequest(map[string]any{"matrix": poissonCOO(4)}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+
+	assert.Equal(t, "shape: 4×4\n"+
+		"nonzeros: 10 (density 62.5%)\n"+
+		"nonzeros per row: 2 to 3\n"+
+		"empty rows: 0, empty columns: 0\n"+
+		"bandwidth: 1 below, 1 above the diagonal\n"+
+		"structurally symmetric: true\n"+
+		"symmetric: true\n"+
+		"zero diagonal entries: 0\n"+
+		"strictly diagonally dominant: false", resultText(result))
+	structured := result.StructuredContent.(map[string]any)
+	assert.Equal(t, 10, structured["nnz"])
+	assert.Equal(t, []int{4, 4}, structured["shape"])
+}
+
+func TestSparseErrors(t *testing.T) {
+	coo := func(fields map[string]any) map[string]any {
+		m := map[string]any{"format": "coo", "shape": intsJSON(2, 2), "row": intsJSON(0), "col": intsJSON(0), "values": vectorJSON(1)}
+		for k, v := range fields {
+			m[k] = v
+		}
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"missing", sparseInfoHandler, map[string]any{}, `required argument "matrix" not found`},
+		{"dense", sparseInfoHandler, map[string]any{"matrix": matrixJSON([]float64{1})}, "matrix must be an object with format, shape and entries"},
+		{"format", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"format": "dok"})}, "matrix.format must be coo or csr"},
+		{"shape", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"shape": intsJSON(2)})}, "matrix.shape must be [rows, cols] with each between 1 and 16777216"},
+		{"fractional index", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"row": vectorJSON(0.5)})}, "matrix.row[0] must be a non-negative integer"},
+		{"negative index", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"col": intsJSON(-1)})}, "matrix.col[0] must be a non-negative integer"},
+		{"out of range", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"row": intsJSON(2)})}, "matrix: entry 0 at (2, 0) is outside the 2×2 matrix"},
+		{"missing csr field", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"format": "csr"})}, "matrix.row_ptr must be an array of non-negative integers"},
+		{"non-number value", sparseInfoHandler, map[string]any{"matrix": coo(map[string]any{"values": []any{"x"}})}, "matrix.values[0] must be a number"},
+		{"x length", sparseMatVecHandler, map[string]any{"matrix": coo(nil), "x": vectorJSON(1)}, "vector has 1 entries but the matrix has 2 columns"},
+		{"not square", sparseSolveHandler, map[string]any{"matrix": coo(map[string]any{"shape": intsJSON(2, 3)}), "b": vectorJSON(1, 1)}, "matrix must be square, got 2×3"},
+		{"b length", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(1)}, "b has 1 entries but the matrix has 2 rows"},
+		{"cg nonsymmetric", sparseSolveHandler, map[string]any{"matrix": coo(map[string]any{"row": intsJSON(0, 1, 0), "col": intsJSON(0, 1, 1), "values": vectorJSON(1, 1, 1)}), "b": vectorJSON(1, 1), "method": "cg"}, "conjugate gradient requires a symmetric matrix; use method gmres"},
+		{"cg indefinite", sparseSolveHandler, map[string]any{"matrix": coo(map[string]any{"row": intsJSON(0, 1), "col": intsJSON(0, 1), "values": vectorJSON(1, -1)}), "b": vectorJSON(1, 1)}, "matrix is not positive definite, so conjugate gradient does not apply; use method gmres"},
+		{"gmres singular", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(0, 1), "method": "gmres"}, "GMRES broke down: the matrix appears to be singular"},
+		{"unknown method", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(1, 1), "method": "jacobi"}, `unknown method "jacobi": must be auto, cg or gmres`},
+		{"tolerance", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(1, 1), "tolerance": 0.0}, "tolerance must be positive"},
+		{"restart too large", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(1, 1), "restart": 1e9}, "restart must be between 1 and 1000"},
+		{"max iterations", sparseSolveHandler, map[string]any{"matrix": coo(nil), "b": vectorJSON(1, 1), "max_iterations": 0.0}, "max_iterations must be between 1 and 100000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
@@ -125,11 +125,14 @@ func gammaHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	result := math.Gamma(x)
-	if math.IsInf(result, 0) || math.IsNaN(result) {
+	if x == 0 || (x < 0 && x == math.Trunc(x)) {
 		return mcp.NewToolResultError("gamma undefined for this input"), nil
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	result := math.Gamma(x)
+	if err := checkUnderflow(ctx, result, !math.IsInf(x, 0)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, result, x), nil
 }
 
 func lgammaHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -138,6 +141,12 @@ func lgammaHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result, sign := math.Lgamma(x)
+	if err := firstError(
+		checkPole(ctx, result, x <= 0 && x == math.Trunc(x)),
+		checkIEEE(ctx, result, x),
+	); err != nil {
+		return ieeeErrorResult(err), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("lgamma: %g, sign: %d", result, sign)), nil
 }
 
@@ -147,7 +156,7 @@ func erfHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Erf(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func erfcHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -156,7 +165,7 @@ func erfcHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Erfc(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func erfinvHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -168,7 +177,7 @@ func erfinvHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError("erfinv: input must be in range (-1, 1)"), nil
 	}
 	result := math.Erfinv(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func erfcinvHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -180,7 +189,7 @@ func erfcinvHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTool
 		return mcp.NewToolResultError("erfcinv: input must be in range (0, 2)"), nil
 	}
 	result := math.Erfcinv(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func j0Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -189,7 +198,7 @@ func j0Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResul
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.J0(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func j1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -198,7 +207,7 @@ func j1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResul
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.J1(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func y0Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -210,7 +219,7 @@ func y0Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResul
 		return mcp.NewToolResultError("y0: input must be positive"), nil
 	}
 	result := math.Y0(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func y1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -222,5 +231,5 @@ func y1Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResul
 		return mcp.NewToolResultError("y1: input must be positive"), nil
 	}
 	result := math.Y1(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
@@ -12,6 +12,7 @@ import (
 	"sort"
 
 	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/fit"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -55,6 +56,7 @@ func (r *Registry) registerStatistics() {
 		mcp.NewTool("median",
 			mcp.WithDescription("Median value of numbers"),
 			mcp.WithArray("numbers", mcp.Required(), mcp.Description("Array of numbers"), mcp.WithNumberItems()),
+			explainOption(),
 		),
 		medianHandler,
 		cat,
@@ -75,6 +77,7 @@ func (r *Registry) registerStatistics() {
 		mcp.NewTool("variance",
 			mcp.WithDescription("Population variance of numbers"),
 			mcp.WithArray("numbers", mcp.Required(), mcp.Description("Array of numbers"), mcp.WithNumberItems()),
+			explainOption(),
 		),
 		varianceHandler,
 		cat,
@@ -85,6 +88,7 @@ func (r *Registry) registerStatistics() {
 		mcp.NewTool("std_dev",
 			mcp.WithDescription("Population standard deviation of numbers"),
 			mcp.WithArray("numbers", mcp.Required(), mcp.Description("Array of numbers"), mcp.WithNumberItems()),
+			explainOption(),
 		),
 		stdDevHandler,
 		cat,
@@ -99,6 +103,65 @@ func (r *Registry) registerStatistics() {
 		rangeStatHandler,
 		cat,
 	)
+
+	// Linear regression
+	r.addTool(
+		mcp.NewTool("linear_regression",
+			mcp.WithDescription("Simple or multiple linear regression by least squares. "+
+				"Reports the fitted equation, each coefficient with its standard error, R² and adjusted R², the residual standard error and the residuals"),
+			observationsParam("x", "Predictors"),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Observations, one per entry of x"), mcp.WithNumberItems()),
+			mcp.WithBoolean("intercept", mcp.Description("Fit an intercept (default true)")),
+			mcp.WithArray("names", mcp.Description("Names of the predictors for the report (default x, or x1, x2, …)"), mcp.WithStringItems()),
+		),
+		linearRegressionHandler,
+		cat,
+	)
+
+	// Polynomial regression
+	r.addTool(
+		mcp.NewTool("polynomial_regression",
+			mcp.WithDescription("Polynomial regression y = c0 + c1 x + … + cd x^d by least squares. "+
+				"Reports the coefficients from the constant up with their standard errors, R² and adjusted R², the residual standard error and the residuals"),
+			mcp.WithArray("x", mcp.Required(), mcp.Description("Values of the predictor"), mcp.WithNumberItems()),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Observations, one per value of x"), mcp.WithNumberItems()),
+			mcp.WithNumber("degree", mcp.Required(), mcp.Description(fmt.Sprintf("Degree of the polynomial, an integer from 1 to %d", fit.MaxPolynomialDegree))),
+		),
+		polynomialRegressionHandler,
+		cat,
+	)
+
+	// Curve fit
+	r.addTool(
+		mcp.NewTool("fit_curve",
+			mcp.WithDescription("Fit a trend curve by least squares on its logarithmic form, as spreadsheet trend lines do: "+
+				"exponential y = a e^(b x) (needs y > 0), power y = a x^b (needs x, y > 0) or logarithmic y = a + b ln(x) (needs x > 0). "+
+				"R² is that of the linearised fit; fitted values and residuals are on the original scale"),
+			mcp.WithString("model", mcp.Required(), mcp.Enum(string(fit.Exponential), string(fit.Power), string(fit.Logarithmic)), mcp.Description("Curve to fit")),
+			mcp.WithArray("x", mcp.Required(), mcp.Description("Values of the predictor"), mcp.WithNumberItems()),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Observations, one per value of x"), mcp.WithNumberItems()),
+		),
+		fitCurveHandler,
+		cat,
+	)
+
+	// Nonlinear fit
+	r.addTool(
+		mcp.NewTool("nonlinear_fit",
+			mcp.WithDescription("Fit the parameters of a model expression, such as \"a * exp(-b * x) + c\", by nonlinear least squares with the Levenberg–Marquardt method from a starting guess. "+
+				"Reports the parameters with standard errors from the linearised model, R², the residual standard error, the residuals and whether the tolerance was met"),
+			mcp.WithString("model", mcp.Required(), mcp.Description("Model expression in the parameters and variables, e.g. \"a * exp(-b * x) + c\"")),
+			mcp.WithArray("parameters", mcp.Required(), mcp.Description("Parameters to fit, e.g. [\"a\", \"b\", \"c\"]"), mcp.WithStringItems()),
+			mcp.WithArray("guess", mcp.Required(), mcp.Description("Starting values of the parameters, in the same order"), mcp.WithNumberItems()),
+			observationsParam("x", "Values of the variables"),
+			mcp.WithArray("y", mcp.Required(), mcp.Description("Observations, one per entry of x"), mcp.WithNumberItems()),
+			mcp.WithArray("variables", mcp.Description("Variables of the model, one per column of x (default [\"x\"])"), mcp.WithStringItems()),
+			mcp.WithNumber("tolerance", mcp.Description(fmt.Sprintf("Relative change of the parameters or sum of squares at which to stop (default %g)", defaultFitTolerance))),
+			mcp.WithNumber("max_iterations", mcp.Description(fmt.Sprintf("Iteration limit, 1 to %d (default %d)", maxFitIterations, defaultFitIterations))),
+		),
+		r.sessions.nonlinearFitHandler,
+		cat,
+	)
 }
 
 func sumHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -113,7 +176,7 @@ func sumHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 	for _, n := range numbers {
 		sum += n
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("%g", sum)), nil
+	return floatResult(ctx, sum, numbers...), nil
 }
 
 func productHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -125,10 +188,17 @@ func productHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTool
 		return mcp.NewToolResultError("array must not be empty"), nil
 	}
 	product := 1.0
+	hasZero := false
 	for _, n := range numbers {
 		product *= n
+		if n == 0 {
+			hasZero = true
+		}
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("%g", product)), nil
+	if err := checkUnderflow(ctx, product, !hasZero); err != nil {
+		return ieeeErrorResult(err), nil
+	}
+	return floatResult(ctx, product, numbers...), nil
 }
 
 func meanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -144,7 +214,7 @@ func meanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		sum += n
 	}
 	mean := sum / float64(len(numbers))
-	return mcp.NewToolResultText(fmt.Sprintf("%g", mean)), nil
+	return floatResult(ctx, mean, numbers...), nil
 }
 
 func medianHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -168,7 +238,10 @@ func medianHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 	} else {
 		median = sorted[n/2]
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("%g", median)), nil
+	if wantExplain(req) {
+		return explained(floatResult(ctx, median, numbers...), medianSteps(sorted, median)), nil
+	}
+	return floatResult(ctx, median, numbers...), nil
 }
 
 func modeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -201,6 +274,7 @@ func modeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 
 	// Format output
 	if len(modes) == 1 {
+		recordResult(ctx, modes[0])
 		return mcp.NewToolResultText(fmt.Sprintf("%g", modes[0])), nil
 	}
 
@@ -235,7 +309,10 @@ func varianceHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToo
 	}
 	variance /= float64(len(numbers))
 
-	return mcp.NewToolResultText(fmt.Sprintf("%g", variance)), nil
+	if wantExplain(req) {
+		return explained(floatResult(ctx, variance, numbers...), varianceSteps(numbers, sum, mean, variance)), nil
+	}
+	return floatResult(ctx, variance, numbers...), nil
 }
 
 func stdDevHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -263,7 +340,12 @@ func stdDevHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 	variance /= float64(len(numbers))
 
 	stdDev := math.Sqrt(variance)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", stdDev)), nil
+	if wantExplain(req) {
+		steps := append(varianceSteps(numbers, sum, mean, variance),
+			stepf("Standard deviation: σ = √%g = %g", variance, stdDev))
+		return explained(floatResult(ctx, stdDev, numbers...), steps), nil
+	}
+	return floatResult(ctx, stdDev, numbers...), nil
 }
 
 func rangeStatHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -285,7 +367,49 @@ func rangeStatHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallTo
 			maxVal = n
 		}
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("%g", maxVal-minVal)), nil
+	return floatResult(ctx, maxVal-minVal, numbers...), nil
+}
+
+// medianSteps explains how the median is read from the sorted values.
+func medianSteps(sorted []float64, median float64) []explainStep {
+	n := len(sorted)
+	steps := []explainStep{stepf("Sort the values: %s", formatFloats(sorted))}
+	if n%2 == 1 {
+		return append(steps, stepf("n = %d is odd, so the median is the middle value at position %d: %g", n, n/2+1, median))
+	}
+	return append(steps, stepf("n = %d is even, so the median is the mean of the values at positions %d and %d: (%g + %g) / 2 = %g",
+		n, n/2, n/2+1, sorted[n/2-1], sorted[n/2], median))
+}
+
+// varianceSteps explains the population variance through a table of
+// deviations from the mean.
+func varianceSteps(numbers []float64, sum, mean, variance float64) []explainStep {
+	n := len(numbers)
+	rows := make([][]string, n)
+	var sumSq float64
+	for i, x := range numbers {
+		d := x - mean
+		sumSq += d * d
+		rows[i] = []string{fmt.Sprintf("%g", x), fmt.Sprintf("%g", d), fmt.Sprintf("%g", d*d)}
+	}
+	return []explainStep{
+		stepf("Mean: μ = %g / %d = %g", sum, n, mean),
+		{Text: "Deviations from the mean and their squares", Table: &stepTable{
+			Columns: []string{"x", "x − μ", "(x − μ)²"},
+			Rows:    rows,
+		}},
+		stepf("Sum of squared deviations: %g", sumSq),
+		stepf("Variance: σ² = %g / %d = %g", sumSq, n, variance),
+	}
+}
+
+// formatFloats formats values as a bracketed list.
+func formatFloats(values []float64) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = fmt.Sprintf("%g", v)
+	}
+	return "[" + joinStrings(strs, ", ") + "]"
 }
 
 func joinStrings(strs []string, sep string) string {
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/sagacient/math-mcp-server/config"
+	"github.com/sagacient/math-mcp-server/expr"
+	"github.com/sagacient/math-mcp-server/symbolic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxSymbolicOrder caps the order of symbolic derivatives.
+const maxSymbolicOrder = 10
+
+// registerSymbolic registers symbolic algebra tools.
+func (r *Registry) registerSymbolic() {
+	cat := config.CategorySymbolic
+
+	// Differentiate
+	r.addTool(
+		mcp.NewTool("differentiate",
+			mcp.WithDescription("Exact derivative of an expression, simplified, as text and LaTeX: the derivative of sin(x^2) is 2x cos(x^2). "+
+				"Numbers are exact fractions; defined functions are expanded and other names, including session variables, are treated as symbols"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to differentiate, e.g. \"sin(x^2)\"")),
+			mcp.WithString("variable", mcp.Description("Variable to differentiate with respect to (default x)")),
+			mcp.WithNumber("order", mcp.Description(fmt.Sprintf("Order of the derivative, 1 to %d (default 1)", maxSymbolicOrder))),
+		),
+		r.sessions.differentiateHandler,
+		cat,
+	)
+
+	// Simplify
+	r.addTool(
+		mcp.NewTool("simplify",
+			mcp.WithDescription("Simplify an expression exactly: folds constants, collects like terms, cancels common factors of fractions and applies identities such as sin(x)^2 + cos(x)^2 = 1 and exp(2 log(x)) = x^2"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to simplify, e.g. \"(x^2 - 1) / (x - 1)\"")),
+		),
+		r.sessions.simplifyHandler,
+		cat,
+	)
+
+	// Expand
+	r.addTool(
+		mcp.NewTool("expand",
+			mcp.WithDescription("Multiply out products and integer powers of sums: (x + 1)^2 becomes x^2 + 2x + 1"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to expand, e.g. \"(x + 1)^3\"")),
+		),
+		r.sessions.expandHandler,
+		cat,
+	)
+
+	// Factor
+	r.addTool(
+		mcp.NewTool("factor",
+			mcp.WithDescription("Factor an expression: a polynomial in one variable with rational coefficients is split into linear factors for its rational roots, "+
+				"x^3 - x becoming x * (x - 1) (x + 1), and other sums have common factors taken out"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression to factor, e.g. \"6x^2 + 5x + 1\"")),
+		),
+		r.sessions.factorHandler,
+		cat,
+	)
+
+	// Substitute
+	r.addTool(
+		mcp.NewTool("substitute",
+			mcp.WithDescription("Replace variables of an expression by numbers or expressions and simplify the result exactly; when no variables remain its numeric value is given too"),
+			mcp.WithString("expression", mcp.Required(), mcp.Description("Expression, e.g. \"x^2 + y\"")),
+			mcp.WithObject("values", mcp.Required(), mcp.Description("Values by variable name, each a number or an expression, e.g. {\"x\": 2, \"y\": \"t + 1\"}")),
+		),
+		r.sessions.substituteHandler,
+		cat,
+	)
+}
+
+// parseSymbolic parses expression with the session's functions inlined.
+func (s *sessionStore) parseSymbolic(ctx context.Context, expression string) (symbolic.Expr, error) {
+	defs := make(map[string]symbolic.Definition)
+	for name, f := range s.functions(ctx) {
+		defs[name] = symbolic.Definition{Params: f.Params, Body: f.node}
+	}
+	return symbolic.Parse(expression, defs)
+}
+
+// undefinedConstant reports why e, when it has no symbols, has no value: a
+// division by zero, as parsing "1/0" reports, or a function applied outside
+// its domain, as in sqrt(-1). Overflowing values, such as exp(1000), are
+// defined and pass.
+func undefinedConstant(e symbolic.Expr) error {
+	if len(symbolic.Symbols(e)) > 0 {
+		return nil
+	}
+	if symbolic.DividesByZero(e) {
+		return symbolic.ErrDivisionByZero
+	}
+	if v, err := expr.Eval(symbolic.ToNode(e), nil); err == nil && math.IsNaN(v) {
+		return fmt.Errorf("%s is undefined", symbolic.Format(e))
+	}
+	return nil
+}
+
+// symbolicResult reports an expression as text and LaTeX, with its value
+// when it has no variables.
+func symbolicResult(ctx context.Context, e symbolic.Expr) *mcp.CallToolResult {
+	if symbolic.Size(e) > symbolic.MaxSize {
+		return mcp.NewToolResultError(symbolic.ErrTooLarge.Error())
+	}
+	if err := undefinedConstant(e); err != nil {
+		return mcp.NewToolResultError(err.Error())
+	}
+	text := symbolic.Format(e)
+	latex := symbolic.LaTeX(e)
+	content := map[string]any{"result": text, "latex": latex}
+	out := fmt.Sprintf("%s\nLaTeX: %s", text, latex)
+	if len(symbolic.Symbols(e)) == 0 {
+		if v, err := expr.Eval(symbolic.ToNode(e), nil); err == nil && allFinite([]float64{v}) {
+			content["value"] = v
+			out += fmt.Sprintf("\nvalue: %g", v)
+			recordResult(ctx, v)
+		}
+	}
+	result := mcp.NewToolResultText(out)
+	result.StructuredContent = content
+	return result
+}
+
+func (s *sessionStore) differentiateHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	variable := req.GetString("variable", "x")
+	order := req.GetInt("order", 1)
+	if order < 1 || order > maxSymbolicOrder {
+		return mcp.NewToolResultError(fmt.Sprintf("order must be between 1 and %d", maxSymbolicOrder)), nil
+	}
+
+	e, err := s.parseSymbolic(ctx, expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	for range order {
+		if e, err = symbolic.Diff(e, variable); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		e = symbolic.Simplify(e)
+	}
+	return symbolicResult(ctx, e), nil
+}
+
+func (s *sessionStore) simplifyHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	e, err := s.parseSymbolic(ctx, expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return symbolicResult(ctx, symbolic.Simplify(e)), nil
+}
+
+func (s *sessionStore) expandHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	e, err := s.parseSymbolic(ctx, expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if e, err = symbolic.Expand(e); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return symbolicResult(ctx, e), nil
+}
+
+func (s *sessionStore) factorHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	e, err := s.parseSymbolic(ctx, expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if e, err = symbolic.Factor(e); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return symbolicResult(ctx, e), nil
+}
+
+func (s *sessionStore) substituteHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	expression, err := req.RequireString("expression")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	raw, ok := req.GetArguments()["values"].(map[string]any)
+	if !ok {
+		return mcp.NewToolResultError("values must be an object mapping variable names to numbers or expressions"), nil
+	}
+	e, err := s.parseSymbolic(ctx, expression)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	values := make(map[string]symbolic.Expr, len(raw))
+	for _, name := range slices.Sorted(maps.Keys(raw)) {
+		var v symbolic.Expr
+		switch value := raw[name].(type) {
+		case float64:
+			v, err = symbolic.FromFloat(value)
+		case string:
+			v, err = s.parseSymbolic(ctx, value)
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("value of %s must be a number or an expression", name)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("value of %s: %v", name, err)), nil
+		}
+		values[name] = v
+	}
+	return symbolicResult(ctx, symbolic.Simplify(symbolic.Substitute(e, values))), nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolicTools(t *testing.T) {
+	tests := []struct {
+		name  string
+		tool  string
+		args  map[string]any
+		want  string
+		latex string
+	}{
+		{"chain rule", "differentiate", map[string]any{"expression": "sin(x^2)"}, "2x cos(x^2)", `2 x \cos\left(x^{2}\right)`},
+		{"named variable", "differentiate", map[string]any{"expression": "a t^3 + t", "variable": "t"}, "3a t^2 + 1", `3 a t^{2} + 1`},
+		{"second order", "differentiate", map[string]any{"expression": "x^4 / 12", "order": 2.0}, "x^2", `x^{2}`},
+		{"quotient", "differentiate", map[string]any{"expression": "x / (x + 1)"}, "1 / (x + 1)^2", `\frac{1}{\left(x + 1\right)^{2}}`},
+		{"simplify identity", "simplify", map[string]any{"expression": "sin(x)^2 + cos(x)^2 + x"}, "x + 1", `x + 1`},
+		{"simplify fraction", "simplify", map[string]any{"expression": "(x^2 - 1) / (x - 1)"}, "x + 1", `x + 1`},
+		{"expand", "expand", map[string]any{"expression": "(x + 1)^3"}, "x^3 + 3x^2 + 3x + 1", `x^{3} + 3 x^{2} + 3 x + 1`},
+		{"factor", "factor", map[string]any{"expression": "6x^2 + 5x + 1"}, "(2x + 1) (3x + 1)", `\left(2 x + 1\right) \left(3 x + 1\right)`},
+		{"substitute expression", "substitute", map[string]any{"expression": "x^2 + 2x + 1", "values": map[string]any{"x": "y - 1"}}, "y^2", `y^{2}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.False(t, result.IsError, resultText(result))
+
+			content := result.StructuredContent.(map[string]any)
+			assert.Equal(t, tt.want, content["result"])
+			assert.Equal(t, tt.latex, content["latex"])
+			assert.Equal(t, tt.want+"\nLaTeX: "+tt.latex, resultText(result))
+		})
+	}
+}
+
+func TestSubstituteValue(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	result := callTool(t, r, ctx, "substitute", map[string]any{"expression": "sin(x) + y/3", "values": map[string]any{"x": "pi/6", "y": 0.5}})
+	require.False(t, result.IsError, resultText(result))
+	content := result.StructuredContent.(map[string]any)
+	assert.Equal(t, "2/3", content["result"])
+	assert.InDelta(t, 2.0/3, content["value"], 1e-15)
+	assert.True(t, strings.HasSuffix(resultText(result), "\nvalue: 0.6666666666666666"), resultText(result))
+
+	// The value becomes the latest answer
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	require.False(t, result.IsError, resultText(result))
+	ans, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0/3, ans, 1e-15)
+
+	result = callTool(t, r, ctx, "substitute", map[string]any{"expression": "sqrt(x)", "values": map[string]any{"x": 2.0}})
+	require.False(t, result.IsError, resultText(result))
+	assert.InDelta(t, math.Sqrt2, result.StructuredContent.(map[string]any)["value"], 1e-15)
+}
+
+func TestSymbolicSession(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	// Defined functions are expanded; session variables stay symbols
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "k", "value": 3.0})
+	callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"u"}, "body": "exp(k u)"})
+	result := callTool(t, r, ctx, "differentiate", map[string]any{"expression": "f(x^2)"})
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "2k x exp(k x^2)", result.StructuredContent.(map[string]any)["result"])
+}
+
+func TestSymbolicErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"parse error", "simplify", map[string]any{"expression": "x +"}, "unexpected end of expression"},
+		{"unknown function", "expand", map[string]any{"expression": "foo(x)"}, `unknown function "foo"`},
+		{"not differentiable", "differentiate", map[string]any{"expression": "gamma(x)"}, "cannot differentiate gamma symbolically"},
+		{"order", "differentiate", map[string]any{"expression": "x", "order": 11.0}, "order must be between 1 and 10"},
+		{"too large", "expand", map[string]any{"expression": "(a + b + c + d)^50"}, "the result is too large"},
+		{"values", "substitute", map[string]any{"expression": "x", "values": []any{1.0}}, "values must be an object mapping variable names to numbers or expressions"},
+		{"value type", "substitute", map[string]any{"expression": "x", "values": map[string]any{"x": true}}, "value of x must be a number or an expression"},
+		{"division by zero", "substitute", map[string]any{"expression": "1/x", "values": map[string]any{"x": 0.0}}, "division by zero"},
+		{"negative power of zero", "substitute", map[string]any{"expression": "x^(-2)", "values": map[string]any{"x": 0.0}}, "division by zero"},
+		{"outside the domain", "substitute", map[string]any{"expression": "sqrt(x)", "values": map[string]any{"x": -1.0}}, "sqrt(-1) is undefined"},
+		{"simplify outside the domain", "simplify", map[string]any{"expression": "asin(2)"}, "asin(2) is undefined"},
+		{"value expression", "substitute", map[string]any{"expression": "x", "values": map[string]any{"x": "1 +"}}, "value of x: parse error at position 4: unexpected end of expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, NewRegistry(), sessionContext("s1"), tt.tool, tt.args)
+			require.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.want)
+		})
+	}
+}
@@ -85,6 +85,7 @@ func (r *Registry) registerTrig() {
 			mcp.WithDescription("Arc tangent of y/x, using signs to determine quadrant"),
 			mcp.WithNumber("y", mcp.Required(), mcp.Description("Y coordinate")),
 			mcp.WithNumber("x", mcp.Required(), mcp.Description("X coordinate")),
+			explainOption(),
 		),
 		atan2Handler,
 		cat,
@@ -107,7 +108,7 @@ func sinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Sin(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func cosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -116,7 +117,7 @@ func cosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Cos(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func tanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -125,7 +126,7 @@ func tanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResu
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Tan(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func asinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -137,7 +138,7 @@ func asinHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError("asin: input must be in range [-1, 1]"), nil
 	}
 	result := math.Asin(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func acosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -149,7 +150,7 @@ func acosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError("acos: input must be in range [-1, 1]"), nil
 	}
 	result := math.Acos(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func atanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -158,7 +159,7 @@ func atanHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRes
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Atan(x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	return floatResult(ctx, result, x), nil
 }
 
 func atan2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -171,7 +172,32 @@ func atan2Handler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	result := math.Atan2(y, x)
-	return mcp.NewToolResultText(fmt.Sprintf("%g", result)), nil
+	if wantExplain(req) {
+		return explained(floatResult(ctx, result, y, x), atan2Steps(y, x, result)), nil
+	}
+	return floatResult(ctx, result, y, x), nil
+}
+
+// atan2Steps explains how the quadrant of (x, y) adjusts atan(y/x).
+func atan2Steps(y, x, result float64) []explainStep {
+	var steps []explainStep
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0):
+		steps = append(steps, stepf("IEEE 754 special case: atan2(%g, %g) = %g", y, x, result))
+	case x > 0:
+		steps = append(steps, stepf("x > 0, so the angle is atan(y/x) = atan(%g) = %g", y/x, result))
+	case x < 0 && y >= 0:
+		steps = append(steps, stepf("x < 0 and y ≥ 0 (quadrant II), so add π: atan(y/x) + π = %g + π = %g", math.Atan(y/x), result))
+	case x < 0:
+		steps = append(steps, stepf("x < 0 and y < 0 (quadrant III), so subtract π: atan(y/x) − π = %g − π = %g", math.Atan(y/x), result))
+	case y > 0:
+		steps = append(steps, stepf("x = 0 and y > 0: the point is on the positive y-axis, so the angle is π/2 = %g", result))
+	case y < 0:
+		steps = append(steps, stepf("x = 0 and y < 0: the point is on the negative y-axis, so the angle is −π/2 = %g", result))
+	default:
+		steps = append(steps, stepf("x = y = 0: the angle is chosen from the signs of the zeros by IEEE 754 convention: %g", result))
+	}
+	return append(steps, stepf("In degrees: %g × 180/π = %g°", result, result*180/math.Pi))
 }
 
 func sincosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -180,5 +206,8 @@ func sincosHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 	sin, cos := math.Sincos(x)
+	if err := firstError(checkIEEE(ctx, sin, x), checkIEEE(ctx, cos, x)); err != nil {
+		return ieeeErrorResult(err), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("sin: %g, cos: %g", sin, cos)), nil
 }
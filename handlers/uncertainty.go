@@ -0,0 +1,407 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// epsilon is the float64 machine epsilon.
+const epsilon = 0x1p-52
+
+// uncertainCategories are the categories whose scalar tools accept values
+// with uncertainty.
+var uncertainCategories = map[config.Category]bool{
+	config.CategoryArithmetic: true,
+	config.CategoryPower:      true,
+	config.CategoryLogarithm:  true,
+	config.CategoryTrig:       true,
+	config.CategoryStatistics: true,
+}
+
+// exactTools are the tools of uncertainCategories whose results are not
+// smooth scalar functions of their inputs, so uncertainties cannot be
+// propagated through them.
+var exactTools = map[string]bool{
+	"logb":                  true,
+	"sincos":                true,
+	"mode":                  true,
+	"linear_regression":     true,
+	"polynomial_regression": true,
+	"fit_curve":             true,
+	"nonlinear_fit":         true,
+}
+
+// acceptsUncertainty reports whether the tool of td propagates uncertainties.
+func acceptsUncertainty(td ToolDefinition) bool {
+	return uncertainCategories[td.Category] && !exactTools[td.Tool.Name]
+}
+
+// uncertainNote is appended to the description of parameters that accept
+// values with uncertainty.
+const uncertainNote = `A measured value may be given with its uncertainty as "value±sigma" or {"value": v, "sigma": s}.`
+
+// uncertaintyOption documents values with uncertainty in the description of
+// each parameter of td that accepts them: the number and numeric array
+// parameters other than integers.
+func uncertaintyOption(td *ToolDefinition) {
+	if !acceptsUncertainty(*td) {
+		return
+	}
+	for name := range uncertainParams(td.Tool) {
+		schema := td.Tool.InputSchema.Properties[name].(map[string]any)
+		desc, _ := schema["description"].(string)
+		if desc != "" {
+			desc = strings.TrimSuffix(desc, ".") + ". "
+		}
+		schema["description"] = desc + uncertainNote
+	}
+}
+
+// uncertainParams returns the parameters of tool that may carry an
+// uncertainty: numeric parameters except those declared as integers, which
+// cannot vary continuously.
+func uncertainParams(tool mcp.Tool) map[string]bool {
+	params := numericParams(tool)
+	for name := range params {
+		if schema, _ := tool.InputSchema.Properties[name].(map[string]any); schema["type"] == "integer" {
+			delete(params, name)
+		}
+	}
+	return params
+}
+
+// integerType declares a number parameter as an integer, which cannot carry
+// an uncertainty.
+func integerType(schema map[string]any) {
+	schema["type"] = "integer"
+}
+
+// plainNumericParams returns the parameters of tool that take only numbers
+// or numeric arrays, where a string or object can only be a mistake.
+func plainNumericParams(tool mcp.Tool) map[string]bool {
+	params := make(map[string]bool)
+	for name := range numericParams(tool) {
+		switch tool.InputSchema.Properties[name].(map[string]any)["type"] {
+		case "number", "integer", "array":
+			params[name] = true
+		}
+	}
+	return params
+}
+
+// hasUncertain reports the first argument among params that holds a value
+// with uncertainty, in either accepted form.
+func hasUncertain(args map[string]any, params map[string]bool) (string, bool) {
+	for key, val := range args {
+		if !params[key] {
+			continue
+		}
+		items, ok := val.([]any)
+		if !ok {
+			items = []any{val}
+		}
+		for _, item := range items {
+			if _, ok, err := parseUncertain(item); ok || err != nil {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+// uncertainPattern matches "9.81±0.02", "9.81 +/- 0.02" and "9.81+-0.02".
+var uncertainPattern = regexp.MustCompile(`^\s*(.+?)\s*(?:±|\+/-|\+-)\s*(.+?)\s*$`)
+
+// uncertain is a measured value with its standard uncertainty.
+type uncertain struct {
+	value, sigma float64
+}
+
+// parseUncertain recognizes {"value": v, "sigma": s} and "v±s". It reports
+// false for anything else, which is left for the handler to parse.
+func parseUncertain(v any) (uncertain, bool, error) {
+	var u uncertain
+	switch v := v.(type) {
+	case map[string]any:
+		value, okValue := v["value"].(float64)
+		sigma, okSigma := v["sigma"].(float64)
+		if !okValue || !okSigma || len(v) != 2 {
+			return u, false, fmt.Errorf("uncertain value must be {\"value\": number, \"sigma\": number}")
+		}
+		u = uncertain{value, sigma}
+	case string:
+		m := uncertainPattern.FindStringSubmatch(v)
+		if m == nil {
+			return u, false, nil
+		}
+		value, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return u, false, fmt.Errorf("invalid uncertain value %q", v)
+		}
+		sigma, err := strconv.ParseFloat(m[2], 64)
+		if err != nil {
+			return u, false, fmt.Errorf("invalid uncertain value %q", v)
+		}
+		u = uncertain{value, sigma}
+	default:
+		return u, false, nil
+	}
+	if u.sigma < 0 || math.IsNaN(u.sigma) || math.IsInf(u.sigma, 0) {
+		return u, false, fmt.Errorf("uncertainty must be a finite non-negative number, got %g", u.sigma)
+	}
+	return u, true, nil
+}
+
+// uncertainInput locates an uncertain argument: a parameter and, within an
+// array parameter, the index of the element (-1 for scalars).
+type uncertainInput struct {
+	key   string
+	index int
+	u     uncertain
+}
+
+// label names the input for error messages.
+func (in uncertainInput) label() string {
+	if in.index < 0 {
+		return in.key
+	}
+	return fmt.Sprintf("%s[%d]", in.key, in.index)
+}
+
+// splitUncertain replaces uncertain arguments by their values, returning the
+// nominal arguments and the uncertain inputs found.
+func splitUncertain(args map[string]any, numeric map[string]bool) (map[string]any, []uncertainInput, error) {
+	nominal := make(map[string]any, len(args))
+	var inputs []uncertainInput
+	for key, val := range args {
+		nominal[key] = val
+		if !numeric[key] {
+			continue
+		}
+		if items, ok := val.([]any); ok {
+			copied := make([]any, len(items))
+			for i, item := range items {
+				copied[i] = item
+				u, ok, err := parseUncertain(item)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s[%d]: %w", key, i, err)
+				}
+				if ok {
+					copied[i] = u.value
+					inputs = append(inputs, uncertainInput{key, i, u})
+				}
+			}
+			nominal[key] = copied
+			continue
+		}
+		u, ok, err := parseUncertain(val)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if ok {
+			nominal[key] = u.value
+			inputs = append(inputs, uncertainInput{key, -1, u})
+		}
+	}
+	return nominal, inputs, nil
+}
+
+// withArgument returns a copy of args with the input at in set to x.
+func withArgument(args map[string]any, in uncertainInput, x float64) map[string]any {
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		out[k] = v
+	}
+	if in.index < 0 {
+		out[in.key] = x
+		return out
+	}
+	items := append([]any(nil), args[in.key].([]any)...)
+	items[in.index] = x
+	out[in.key] = items
+	return out
+}
+
+// scalarResult parses the number a successful tool result holds.
+func scalarResult(result *mcp.CallToolResult) (float64, bool) {
+	if result == nil || result.IsError || len(result.Content) == 0 {
+		return 0, false
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(text.Text), 64)
+	return v, err == nil
+}
+
+// partialDerivative estimates df/dx at x by a central difference, falling
+// back to a one-sided difference next to a domain boundary.
+func partialDerivative(f func(float64) (float64, bool), x, fx, sigma float64) (float64, bool) {
+	scale := math.Abs(x)
+	if scale == 0 {
+		scale = sigma
+	}
+	h := math.Cbrt(epsilon) * scale
+	fPlus, okPlus := f(x + h)
+	fMinus, okMinus := f(x - h)
+	var d float64
+	switch {
+	case okPlus && okMinus:
+		d = (fPlus - fMinus) / (2 * h)
+	case okPlus:
+		d, ok := oneSidedDerivative(f, x, fx, h)
+		if !ok {
+			return 0, false
+		}
+		return d, true
+	case okMinus:
+		d, ok := oneSidedDerivative(f, x, fx, -h)
+		if !ok {
+			return 0, false
+		}
+		return d, true
+	default:
+		return 0, false
+	}
+	return d, !math.IsNaN(d) && !math.IsInf(d, 0)
+}
+
+// oneSidedDerivative estimates df/dx from steps h and 2h on one side of x.
+// The two estimates disagree where the derivative is unbounded, as for sqrt
+// at 0, and no derivative is reported then.
+func oneSidedDerivative(f func(float64) (float64, bool), x, fx, h float64) (float64, bool) {
+	f1, ok1 := f(x + h)
+	f2, ok2 := f(x + 2*h)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	d1 := (f1 - fx) / h
+	d2 := (f2 - fx) / (2 * h)
+	if math.Abs(d1-d2) > 1e-3*math.Max(math.Abs(d1), math.Abs(d2)) {
+		return 0, false
+	}
+	d := 2*d1 - d2
+	return d, !math.IsNaN(d) && !math.IsInf(d, 0)
+}
+
+// formatUncertain renders value ± sigma with sigma to two significant digits
+// and the value rounded to the same decimal place.
+func formatUncertain(value, sigma float64) string {
+	if sigma == 0 {
+		return fmt.Sprintf("%g ± 0", value)
+	}
+	exp := int(math.Floor(math.Log10(sigma)))
+	if exp < -6 || exp > 6 {
+		digits := 1
+		if value != 0 {
+			digits = max(int(math.Floor(math.Log10(math.Abs(value))))-exp+1, 1)
+		}
+		return fmt.Sprintf("%.*e ± %.1e", digits, value, sigma)
+	}
+	decimals := 1 - exp
+	if decimals >= 0 {
+		return fmt.Sprintf("%.*f ± %.*f", decimals, value, decimals, sigma)
+	}
+	unit := math.Pow(10, float64(-decimals))
+	return fmt.Sprintf("%.0f ± %.0f", math.Round(value/unit)*unit, math.Round(sigma/unit)*unit)
+}
+
+// uncertaintyMiddleware lets the scalar tools of uncertainCategories take
+// values with uncertainty, given as {"value": v, "sigma": s} or "v±s", in
+// any numeric argument or array element. The tool is evaluated at the
+// nominal values and the uncertainties of independent inputs are propagated
+// to first order, σ² = Σ (∂f/∂xᵢ · σᵢ)², with the partial derivatives
+// estimated numerically. The result is reported as "value ± sigma", with the
+// unrounded numbers in the structured content. Other tools, and integer
+// parameters, reject values with uncertainty rather than misreading them.
+func uncertaintyMiddleware(td ToolDefinition) server.ToolHandlerMiddleware {
+	name := td.Tool.Name
+	if !acceptsUncertainty(td) {
+		plain := plainNumericParams(td.Tool)
+		return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+			return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				if _, found := hasUncertain(req.GetArguments(), plain); found {
+					return mcp.NewToolResultError(fmt.Sprintf("%s does not support values with uncertainty", name)), nil
+				}
+				return next(ctx, req)
+			}
+		}
+	}
+	numeric := uncertainParams(td.Tool)
+	integers := plainNumericParams(td.Tool)
+	for param := range numeric {
+		delete(integers, param)
+	}
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			args := req.GetArguments()
+			if args == nil {
+				return next(ctx, req)
+			}
+			if param, found := hasUncertain(args, integers); found {
+				return mcp.NewToolResultError(fmt.Sprintf("%s must be an exact integer and cannot have an uncertainty", param)), nil
+			}
+			nominal, inputs, err := splitUncertain(args, numeric)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(inputs) == 0 {
+				return next(ctx, req)
+			}
+
+			req.Params.Arguments = nominal
+			result, err := next(ctx, req)
+			if err != nil || result == nil || result.IsError {
+				return result, err
+			}
+			value, ok := scalarResult(result)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("%s does not support values with uncertainty", name)), nil
+			}
+
+			// Perturbed evaluations must not enter the answer history
+			quiet := context.WithValue(ctx, resultRecorderKey{}, &resultRecorder{})
+			var variance float64
+			for _, in := range inputs {
+				if in.u.sigma == 0 {
+					continue
+				}
+				f := func(x float64) (float64, bool) {
+					perturbed := req
+					perturbed.Params.Arguments = withArgument(nominal, in, x)
+					r, err := next(quiet, perturbed)
+					if err != nil {
+						return 0, false
+					}
+					v, ok := scalarResult(r)
+					return v, ok && !math.IsNaN(v) && !math.IsInf(v, 0)
+				}
+				d, ok := partialDerivative(f, in.u.value, value, in.u.sigma)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot propagate uncertainty: %s is not differentiable at %s = %g", name, in.label(), in.u.value)), nil
+				}
+				variance += d * in.u.sigma * d * in.u.sigma
+			}
+
+			sigma := math.Sqrt(variance)
+			out := mcp.NewToolResultText(formatUncertain(value, sigma))
+			out.StructuredContent = map[string]any{"value": value, "sigma": sigma}
+			return out, nil
+		}
+	}
+}
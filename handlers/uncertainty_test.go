@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUncertain(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  uncertain
+		ok    bool
+		err   bool
+	}{
+		{"object", map[string]any{"value": 9.81, "sigma": 0.02}, uncertain{9.81, 0.02}, true, false},
+		{"plus-minus sign", "9.81±0.02", uncertain{9.81, 0.02}, true, false},
+		{"spaced ascii", "9.81 +/- 0.02", uncertain{9.81, 0.02}, true, false},
+		{"short ascii", "-3e2+-5", uncertain{-300, 5}, true, false},
+		{"plain number", 9.81, uncertain{}, false, false},
+		{"plain string", "abc", uncertain{}, false, false},
+		{"missing sigma", map[string]any{"value": 1.0}, uncertain{}, false, true},
+		{"extra field", map[string]any{"value": 1.0, "sigma": 0.1, "unit": "m"}, uncertain{}, false, true},
+		{"negative sigma", "1±-0.1", uncertain{}, false, true},
+		{"bad value", "x±0.1", uncertain{}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := parseUncertain(tt.input)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUncertaintyPropagation(t *testing.T) {
+	tests := []struct {
+		name  string
+		tool  string
+		args  map[string]any
+		value float64
+		sigma float64
+	}{
+		{
+			"add", "add",
+			map[string]any{"a": "10±0.3", "b": map[string]any{"value": 5.0, "sigma": 0.4}},
+			15, 0.5,
+		},
+		{
+			"multiply relative errors", "multiply",
+			map[string]any{"a": "2±0.02", "b": "3±0.06"},
+			6, 6 * math.Sqrt(0.01*0.01+0.02*0.02),
+		},
+		{
+			"exact operand", "divide",
+			map[string]any{"a": "9.81±0.02", "b": 2.0},
+			4.905, 0.01,
+		},
+		{
+			"pow", "pow",
+			map[string]any{"x": "2±0.1", "y": 3.0},
+			8, 3 * 4 * 0.1,
+		},
+		{
+			"sqrt", "sqrt",
+			map[string]any{"x": "16±0.8"},
+			4, 0.1,
+		},
+		{
+			"log", "log",
+			map[string]any{"x": "10±0.5"},
+			math.Log(10), 0.05,
+		},
+		{
+			"sin", "sin",
+			map[string]any{"x": "0±0.01"},
+			0, 0.01,
+		},
+		{
+			"mean of measurements", "mean",
+			map[string]any{"numbers": []any{"1±0.3", "2±0.3", "3±0.3", 4.0}},
+			2.5, math.Sqrt(3*0.3*0.3) / 4,
+		},
+		{
+			"sum", "sum",
+			map[string]any{"numbers": []any{"1±0.3", map[string]any{"value": 2.0, "sigma": 0.4}}},
+			3, 0.5,
+		},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, context.Background(), tt.tool, tt.args)
+
+			require.False(t, result.IsError, resultText(result))
+			structured := result.StructuredContent.(map[string]any)
+			assert.InDelta(t, tt.value, structured["value"], 1e-12)
+			assert.InDelta(t, tt.sigma, structured["sigma"], 1e-8)
+		})
+	}
+}
+
+func TestUncertaintyOutput(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, context.Background(), "add", map[string]any{"a": "10±0.3", "b": "5±0.4"})
+
+	require.False(t, result.IsError)
+	assert.Equal(t, "15.00 ± 0.50", resultText(result))
+}
+
+func TestFormatUncertain(t *testing.T) {
+	tests := []struct {
+		value, sigma float64
+		want         string
+	}{
+		{9.80665, 0.0213, "9.807 ± 0.021"},
+		{15, 0.5000000000064968, "15.00 ± 0.50"},
+		{1234.5, 0.98, "1234.50 ± 0.98"},
+		{12345, 123, "12350 ± 120"},
+		{2, 0, "2 ± 0"},
+		{6.02214076e23, 3.1e15, "6.022140760e+23 ± 3.1e+15"},
+		{1.6e-19, 2.2e-21, "1.600e-19 ± 2.2e-21"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatUncertain(tt.value, tt.sigma))
+	}
+}
+
+func TestUncertaintyPlainValuesUnchanged(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, context.Background(), "add", map[string]any{"a": 2.0, "b": 3.0})
+
+	assert.Equal(t, "5", resultText(result))
+	assert.Nil(t, result.StructuredContent)
+}
+
+func TestUncertaintySessionHistory(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "multiply", map[string]any{"a": "2±0.1", "b": 3.0})
+
+	// The nominal value, not a perturbed evaluation, becomes ans
+	result := callTool(t, r, ctx, "add", map[string]any{"a": "$ans", "b": 0.0})
+	assert.Equal(t, "6", resultText(result))
+
+	// Variables combine with uncertain values
+	result = callTool(t, r, ctx, "add", map[string]any{"a": "$ans", "b": "1±0.5"})
+	assert.Equal(t, "7.00 ± 0.50", resultText(result))
+}
+
+func TestUncertaintyErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+	}{
+		{"malformed object", "add", map[string]any{"a": map[string]any{"value": 1.0}, "b": 1.0}},
+		{"negative sigma", "add", map[string]any{"a": "1±-1", "b": 1.0}},
+		{"bad array element", "mean", map[string]any{"numbers": []any{1.0, map[string]any{"sigma": 1.0}}}},
+		{"non-scalar result", "sincos", map[string]any{"x": "1±0.1"}},
+		{"not differentiable", "sqrt", map[string]any{"x": "0±0.1"}},
+		{"domain error at nominal value", "log", map[string]any{"x": "-1±0.1", "ieee_policy": "strict"}},
+		{"unsupported category", "gcd", map[string]any{"a": "12±1", "b": 8.0}},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, context.Background(), tt.tool, tt.args)
+
+			assert.True(t, result.IsError, resultText(result))
+		})
+	}
+}
+
+func TestUncertaintyRejected(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"integer parameter", "pow10", map[string]any{"n": "2±0.1"}, "n must be an exact integer and cannot have an uncertainty"},
+		{"other category", "ceil", map[string]any{"x": "2.5±0.1"}, "ceil does not support values with uncertainty"},
+		{"object form", "floor", map[string]any{"x": map[string]any{"value": 2.5, "sigma": 0.1}}, "floor does not support values with uncertainty"},
+		{"step function", "logb", map[string]any{"x": "8±0.1"}, "logb does not support values with uncertainty"},
+		{"discrete statistic", "mode", map[string]any{"numbers": []any{"1±0.1", 1.0, 2.0}}, "mode does not support values with uncertainty"},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, context.Background(), tt.tool, tt.args)
+
+			assert.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestUncertaintyDocumented(t *testing.T) {
+	r := NewRegistry()
+	descriptions := make(map[string]string)
+	for _, td := range r.tools {
+		for name, prop := range td.Tool.InputSchema.Properties {
+			descriptions[td.Tool.Name+"."+name], _ = prop.(map[string]any)["description"].(string)
+		}
+	}
+
+	assert.Contains(t, descriptions["add.a"], uncertainNote)
+	assert.Contains(t, descriptions["mean.numbers"], uncertainNote)
+	assert.NotContains(t, descriptions["pow10.n"], uncertainNote)
+	assert.NotContains(t, descriptions["ceil.x"], uncertainNote)
+	assert.NotContains(t, descriptions["mode.numbers"], uncertainNote)
+}
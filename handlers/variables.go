@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// registerVariables registers session variable tools.
+func (r *Registry) registerVariables() {
+	cat := config.CategoryVariables
+
+	// SetVariable
+	r.addTool(
+		mcp.NewTool("set_variable",
+			mcp.WithDescription("Store a number in a session variable; any numeric argument of any tool can then reference it as \"$name\""),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Variable name (letters, digits, underscores)")),
+			mcp.WithNumber("value", mcp.Required(), mcp.Description("Value, or a reference such as \"$ans\"")),
+		),
+		r.sessions.setVariableHandler,
+		cat,
+	)
+
+	// GetVariable
+	r.addTool(
+		mcp.NewTool("get_variable",
+			mcp.WithDescription("Read a session variable or answer history entry (ans, ans1..ans10) at full precision"),
+			mcp.WithString("name", mcp.Required(), mcp.Description("Variable name")),
+		),
+		r.sessions.getVariableHandler,
+		cat,
+	)
+
+	// ListVariables
+	r.addTool(
+		mcp.NewTool("list_variables",
+			mcp.WithDescription("List the session's variables and answer history (ans1 is the most recent result)"),
+		),
+		r.sessions.listVariablesHandler,
+		cat,
+	)
+}
+
+func (s *sessionStore) setVariableHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	value, err := req.RequireFloat("value")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	id := sessionID(ctx)
+	if id == "" {
+		return noSessionError("set_variable"), nil
+	}
+	name = strings.TrimPrefix(strings.TrimSpace(name), "$")
+	if err := s.set(id, name, value); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("%s = %g", name, value)), nil
+}
+
+func (s *sessionStore) getVariableHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := req.RequireString("name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	name = strings.TrimPrefix(strings.TrimSpace(name), "$")
+	value, ok := s.get(sessionID(ctx), name)
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown variable $%s", name)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("%g", value)), nil
+}
+
+func (s *sessionStore) listVariablesHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	vars, history := s.snapshot(sessionID(ctx))
+	if len(vars) == 0 && len(history) == 0 {
+		return mcp.NewToolResultText("no variables"), nil
+	}
+
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(vars)+len(history))
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("%s = %g", name, vars[name]))
+	}
+	for i, v := range history {
+		lines = append(lines, fmt.Sprintf("ans%d = %g", i+1, v))
+	}
+	return mcp.NewToolResultText(strings.Join(lines, "\n")), nil
+}
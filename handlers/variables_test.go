@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSession is a minimal client session for session-scoped tests.
+type testSession struct {
+	id string
+}
+
+func (s *testSession) Initialize()                                         {}
+func (s *testSession) Initialized() bool                                   { return true }
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s *testSession) SessionID() string                                   { return s.id }
+
+func sessionContext(id string) context.Context {
+	srv := server.NewMCPServer("test", "1.0.0")
+	return srv.WithContext(context.Background(), &testSession{id: id})
+}
+
+// callTool invokes a registered tool the way a server with every category
+// enabled would.
+func callTool(t *testing.T, r *Registry, ctx context.Context, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	return callToolWithConfig(t, r, ctx, allCategoriesConfig(), name, args)
+}
+
+// callToolWithConfig invokes a registered tool as served under cfg.
+func callToolWithConfig(t *testing.T, r *Registry, ctx context.Context, cfg *config.Config, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	for _, td := range r.tools {
+		if td.Tool.Name == name {
+			result, err := r.wrap(td, cfg)(ctx, makeRequest(args))
+			require.NoError(t, err)
+			return result
+		}
+	}
+	t.Fatalf("tool %s not registered", name)
+	return nil
+}
+
+func resultText(result *mcp.CallToolResult) string {
+	return result.Content[0].(mcp.TextContent).Text
+}
+
+func TestSetAndGetVariable(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	result := callTool(t, r, ctx, "set_variable", map[string]any{"name": "rate", "value": 0.05})
+	require.False(t, result.IsError)
+	assert.Equal(t, "rate = 0.05", resultText(result))
+
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "$rate"})
+	require.False(t, result.IsError)
+	assert.Equal(t, "0.05", resultText(result))
+}
+
+func TestVariableReferenceInArguments(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "rate", "value": 0.05})
+	result := callTool(t, r, ctx, "multiply", map[string]any{"a": "$rate", "b": 200.0})
+	require.False(t, result.IsError)
+	assert.Equal(t, "10", resultText(result))
+
+	result = callTool(t, r, ctx, "sum", map[string]any{"numbers": []any{"$rate", 1.0, "$ans"}})
+	require.False(t, result.IsError)
+	assert.Equal(t, "11.05", resultText(result))
+}
+
+func TestAnswerHistory(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "divide", map[string]any{"a": 1.0, "b": 3.0})
+	callTool(t, r, ctx, "add", map[string]any{"a": 2.0, "b": 2.0})
+
+	result := callTool(t, r, ctx, "multiply", map[string]any{"a": "$ans2", "b": 3.0})
+	require.False(t, result.IsError)
+	// 1/3 is carried at full precision
+	assert.Equal(t, "1", resultText(result))
+
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	assert.Equal(t, "1", resultText(result))
+
+	result = callTool(t, r, ctx, "list_variables", map[string]any{})
+	assert.Equal(t, "ans1 = 1\nans2 = 4\nans3 = 0.3333333333333333", resultText(result))
+}
+
+func TestIntegerResultsJoinHistory(t *testing.T) {
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want string
+	}{
+		{"gcd", "gcd", map[string]any{"a": 12.0, "b": 18.0}, "6"},
+		{"gcd explained", "gcd", map[string]any{"a": 12.0, "b": 18.0, "explain": true}, "6"},
+		{"lcm", "lcm", map[string]any{"a": 4.0, "b": 6.0}, "12"},
+		{"lcm of zero", "lcm", map[string]any{"a": 0.0, "b": 6.0}, "0"},
+		{"factorial", "factorial", map[string]any{"n": 5.0}, "120"},
+		{"fibonacci", "fibonacci", map[string]any{"n": 10.0}, "55"},
+		{"bit_and", "bit_and", map[string]any{"a": 12.0, "b": 10.0}, "8"},
+		{"bit_or", "bit_or", map[string]any{"a": 12.0, "b": 10.0}, "14"},
+		{"bit_xor", "bit_xor", map[string]any{"a": 12.0, "b": 10.0}, "6"},
+		{"bit_not", "bit_not", map[string]any{"a": 5.0}, "-6"},
+		{"bit_left_shift", "bit_left_shift", map[string]any{"a": 5.0, "n": 2.0}, "20"},
+		{"bit_right_shift", "bit_right_shift", map[string]any{"a": 20.0, "n": 2.0}, "5"},
+		{"ilogb", "ilogb", map[string]any{"x": 8.0}, "3"},
+		{"mode", "mode", map[string]any{"numbers": []any{1.0, 2.0, 2.0}}, "2"},
+		// Results that are not a single number leave the history alone
+		{"several modes", "mode", map[string]any{"numbers": []any{1.0, 2.0}}, "3"},
+		{"is_prime", "is_prime", map[string]any{"n": 7.0}, "3"},
+		{"prime_factors", "prime_factors", map[string]any{"n": 12.0}, "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			ctx := sessionContext("s1")
+			callTool(t, r, ctx, "add", map[string]any{"a": 1.0, "b": 2.0})
+			result := callTool(t, r, ctx, tt.tool, tt.args)
+			require.False(t, result.IsError, resultText(result))
+
+			result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestErrorsAreNotRecorded(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "add", map[string]any{"a": 1.0, "b": 1.0})
+	result := callTool(t, r, ctx, "divide", map[string]any{"a": 1.0, "b": 0.0})
+	require.True(t, result.IsError)
+
+	result = callTool(t, r, ctx, "get_variable", map[string]any{"name": "ans"})
+	assert.Equal(t, "2", resultText(result))
+}
+
+func TestVariablesAreSessionScoped(t *testing.T) {
+	r := NewRegistry()
+
+	callTool(t, r, sessionContext("s1"), "set_variable", map[string]any{"name": "x", "value": 1.0})
+	result := callTool(t, r, sessionContext("s2"), "add", map[string]any{"a": "$x", "b": 1.0})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "unknown variable $x")
+
+	r.EndSession("s1")
+	result = callTool(t, r, sessionContext("s1"), "get_variable", map[string]any{"name": "x"})
+	assert.True(t, result.IsError)
+}
+
+func TestSetVariableInvalidName(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable string
+	}{
+		{"reserved ans", "ans"},
+		{"reserved history", "ans3"},
+		{"leading digit", "1x"},
+		{"punctuation", "a-b"},
+	}
+
+	r := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTool(t, r, sessionContext("s1"), "set_variable", map[string]any{"name": tt.variable, "value": 1.0})
+
+			assert.True(t, result.IsError)
+		})
+	}
+}
+
+func TestListVariablesEmpty(t *testing.T) {
+	r := NewRegistry()
+
+	result := callTool(t, r, sessionContext("fresh"), "list_variables", map[string]any{})
+
+	assert.Equal(t, "no variables", resultText(result))
+}
+
+func TestStringArgumentsAreNotResolved(t *testing.T) {
+	r := NewRegistry()
+
+	// ieee_policy is a string parameter and must not be treated as a reference
+	result := callTool(t, r, sessionContext("s1"), "add", map[string]any{"a": 1.0, "b": 2.0, "ieee_policy": "strict"})
+
+	assert.False(t, result.IsError)
+}
+
+func TestCallsOutsideSessionKeepNoState(t *testing.T) {
+	r := NewRegistry()
+	ctx := context.Background()
+
+	// Tools that only change session state say so rather than report success
+	result := callTool(t, r, ctx, "set_variable", map[string]any{"name": "x", "value": 1.0})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "set_variable needs an MCP session: calls without one, such as REST API requests, keep no state", resultText(result))
+	result = callTool(t, r, ctx, "define_function", map[string]any{"name": "f", "params": []any{"x"}, "body": "x"})
+	assert.True(t, result.IsError)
+
+	result = callTool(t, r, ctx, "add", map[string]any{"a": 1.0, "b": 1.0})
+	require.False(t, result.IsError)
+	result = callTool(t, r, ctx, "list_variables", map[string]any{})
+	assert.Equal(t, "no variables", resultText(result))
+}
+
+func TestNumericParametersDocumentReferences(t *testing.T) {
+	r := NewRegistry()
+	descriptions := make(map[string]string)
+	for _, td := range r.tools {
+		for name, prop := range td.Tool.InputSchema.Properties {
+			desc, _ := prop.(map[string]any)["description"].(string)
+			descriptions[td.Tool.Name+"."+name] = desc
+		}
+	}
+
+	assert.Equal(t, `Number. `+referenceNote, descriptions["floor.x"])
+	assert.Contains(t, descriptions["mean.numbers"], referenceNote)
+	// Descriptions that already show a reference are left alone
+	assert.Equal(t, `Value, or a reference such as "$ans"`, descriptions["set_variable.value"])
+	// String parameters are not numeric
+	assert.NotContains(t, descriptions["add.ieee_policy"], referenceNote)
+}
@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// registerVector registers vector geometry tools.
+func (r *Registry) registerVector() {
+	cat := config.CategoryVector
+
+	// Dot product
+	r.addTool(
+		mcp.NewTool("vector_dot",
+			mcp.WithDescription("Dot product a·b of two vectors of the same length"),
+			vectorParam("a", "First vector"),
+			vectorParam("b", "Second vector"),
+		),
+		vectorDotHandler,
+		cat,
+	)
+
+	// Cross product
+	r.addTool(
+		mcp.NewTool("vector_cross",
+			mcp.WithDescription("Cross product a×b of two 3-dimensional vectors"),
+			vectorParam("a", "First 3-dimensional vector"),
+			vectorParam("b", "Second 3-dimensional vector"),
+		),
+		vectorCrossHandler,
+		cat,
+	)
+
+	// Norm
+	r.addTool(
+		mcp.NewTool("vector_norm",
+			mcp.WithDescription("Vector norm: 1 (sum of absolute values), 2 (Euclidean length), inf (largest absolute value) or p"),
+			vectorParam("vector", "Vector"),
+			mcp.WithString("type", mcp.Enum("1", "2", "inf", "p"), mcp.Description("Norm type (default p when p is given, else 2)")),
+			mcp.WithNumber("p", mcp.Description("Order of the p-norm, at least 1; required when type is p and not allowed with other types")),
+		),
+		vectorNormHandler,
+		cat,
+	)
+
+	// Normalize
+	r.addTool(
+		mcp.NewTool("vector_normalize",
+			mcp.WithDescription("Unit vector in the direction of a nonzero vector"),
+			vectorParam("vector", "Vector"),
+		),
+		vectorNormalizeHandler,
+		cat,
+	)
+
+	// Angle
+	r.addTool(
+		mcp.NewTool("vector_angle",
+			mcp.WithDescription("Angle between two nonzero vectors, in radians from 0 to π"),
+			vectorParam("a", "First vector"),
+			vectorParam("b", "Second vector"),
+			mcp.WithBoolean("degrees", mcp.Description("Return the angle in degrees instead")),
+		),
+		vectorAngleHandler,
+		cat,
+	)
+
+	// Projection
+	r.addTool(
+		mcp.NewTool("vector_project",
+			mcp.WithDescription("Projection of a onto b: (a·b / b·b)·b"),
+			vectorParam("a", "Vector to project"),
+			vectorParam("b", "Nonzero vector to project onto"),
+		),
+		vectorProjectHandler,
+		cat,
+	)
+
+	// Rejection
+	r.addTool(
+		mcp.NewTool("vector_reject",
+			mcp.WithDescription("Rejection of a from b: the component of a orthogonal to b, a − (a·b / b·b)·b"),
+			vectorParam("a", "Vector"),
+			vectorParam("b", "Nonzero vector"),
+		),
+		vectorRejectHandler,
+		cat,
+	)
+
+	// Distance
+	r.addTool(
+		mcp.NewTool("vector_distance",
+			mcp.WithDescription("Distance between two vectors: euclidean, manhattan, chebyshev, or cosine (1 − cos θ)"),
+			vectorParam("a", "First vector"),
+			vectorParam("b", "Second vector"),
+			mcp.WithString("metric", mcp.Enum("euclidean", "manhattan", "chebyshev", "cosine"), mcp.Description("Distance metric (default euclidean)")),
+		),
+		vectorDistanceHandler,
+		cat,
+	)
+
+	// Scalar triple product
+	r.addTool(
+		mcp.NewTool("scalar_triple_product",
+			mcp.WithDescription("Scalar triple product a·(b×c) of three 3-dimensional vectors, the signed volume of the parallelepiped they span"),
+			vectorParam("a", "First 3-dimensional vector"),
+			vectorParam("b", "Second 3-dimensional vector"),
+			vectorParam("c", "Third 3-dimensional vector"),
+		),
+		scalarTripleProductHandler,
+		cat,
+	)
+
+	// Vector triple product
+	r.addTool(
+		mcp.NewTool("vector_triple_product",
+			mcp.WithDescription("Vector triple product a×(b×c) of three 3-dimensional vectors"),
+			vectorParam("a", "First 3-dimensional vector"),
+			vectorParam("b", "Second 3-dimensional vector"),
+			vectorParam("c", "Third 3-dimensional vector"),
+		),
+		vectorTripleProductHandler,
+		cat,
+	)
+}
+
+// vectorParam declares a vector argument.
+func vectorParam(name, description string) mcp.ToolOption {
+	return mcp.WithArray(name, mcp.Required(), mcp.Description(description+" as an array of numbers"), mcp.WithNumberItems())
+}
+
+// nonEmptyVectorArg reads a vector argument with at least one entry.
+func nonEmptyVectorArg(req mcp.CallToolRequest, name string) ([]float64, error) {
+	v, err := req.RequireFloatSlice(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return nil, fmt.Errorf("%s must not be empty", name)
+	}
+	return v, nil
+}
+
+// vectorPairArgs reads the vectors a and b, which must have the same length.
+func vectorPairArgs(req mcp.CallToolRequest) ([]float64, []float64, error) {
+	a, err := nonEmptyVectorArg(req, "a")
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := nonEmptyVectorArg(req, "b")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(a) != len(b) {
+		return nil, nil, fmt.Errorf("a and b must have the same length, got %d and %d", len(a), len(b))
+	}
+	return a, b, nil
+}
+
+// vector3Args reads 3-dimensional vector arguments.
+func vector3Args(req mcp.CallToolRequest, names ...string) ([][]float64, error) {
+	vectors := make([][]float64, len(names))
+	for i, name := range names {
+		v, err := req.RequireFloatSlice(name)
+		if err != nil {
+			return nil, err
+		}
+		if len(v) != 3 {
+			return nil, fmt.Errorf("%s must be a 3-dimensional vector, got %d entries", name, len(v))
+		}
+		vectors[i] = v
+	}
+	return vectors, nil
+}
+
+// vectorResult formats v after applying the policy in ctx to its entries.
+// Finite vectors are also returned as structured content.
+func vectorResult(ctx context.Context, v []float64, inputs ...float64) *mcp.CallToolResult {
+	for _, x := range v {
+		if err := checkIEEE(ctx, x, inputs...); err != nil {
+			return ieeeErrorResult(err)
+		}
+	}
+	result := mcp.NewToolResultText(formatFloats(v))
+	if allFinite(v) {
+		result.StructuredContent = map[string]any{"vector": v}
+	}
+	return result
+}
+
+// concatFloats joins vectors into one slice of inputs for the IEEE check.
+func concatFloats(vectors ...[]float64) []float64 {
+	var out []float64
+	for _, v := range vectors {
+		out = append(out, v...)
+	}
+	return out
+}
+
+// dot returns a·b.
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum = math.FMA(a[i], b[i], sum)
+	}
+	return sum
+}
+
+// diffOfProducts returns a·b − c·d with a single rounding error, by Kahan's
+// algorithm.
+func diffOfProducts(a, b, c, d float64) float64 {
+	w := c * d
+	return math.FMA(a, b, -w) + math.FMA(-c, d, w)
+}
+
+// cross returns a×b for 3-dimensional vectors.
+func cross(a, b []float64) []float64 {
+	return []float64{
+		diffOfProducts(a[1], b[2], a[2], b[1]),
+		diffOfProducts(a[2], b[0], a[0], b[2]),
+		diffOfProducts(a[0], b[1], a[1], b[0]),
+	}
+}
+
+// normInf returns the largest absolute entry of v.
+func normInf(v []float64) float64 {
+	var largest float64
+	for _, x := range v {
+		largest = math.Max(largest, math.Abs(x))
+	}
+	return largest
+}
+
+// powerOfTwoScale returns the power of two just above the largest absolute
+// entry of v, so that dividing by it is exact and brings every entry below 1.
+// It returns 0 for the zero vector.
+func powerOfTwoScale(v []float64) float64 {
+	largest := normInf(v)
+	if largest == 0 || math.IsInf(largest, 0) || math.IsNaN(largest) {
+		return largest
+	}
+	_, exp := math.Frexp(largest)
+	return math.Ldexp(1, exp)
+}
+
+// normP returns the p-norm of v for p ≥ 1 without overflow or underflow in
+// the intermediate powers.
+func normP(v []float64, p float64) float64 {
+	var sum float64
+	switch p {
+	case 1:
+		for _, x := range v {
+			sum += math.Abs(x)
+		}
+		return sum
+	case 2:
+		for _, x := range v {
+			sum = math.Hypot(sum, x)
+		}
+		return sum
+	}
+	scale := powerOfTwoScale(v)
+	if scale == 0 || math.IsInf(scale, 0) || math.IsNaN(scale) {
+		return scale
+	}
+	for _, x := range v {
+		sum += math.Pow(math.Abs(x)/scale, p)
+	}
+	return scale * math.Pow(sum, 1/p)
+}
+
+// unitVector returns v divided by its Euclidean length, or an error for the
+// zero vector.
+func unitVector(v []float64, name string) ([]float64, error) {
+	norm := normP(v, 2)
+	if norm == 0 {
+		return nil, fmt.Errorf("%s must not be the zero vector", name)
+	}
+	u := make([]float64, len(v))
+	for i, x := range v {
+		u[i] = x / norm
+	}
+	return u, nil
+}
+
+// projectionCoefficient returns a·b / b·b.
+func projectionCoefficient(a, b []float64) (float64, error) {
+	// Scaling b keeps b·b from overflowing
+	scale := powerOfTwoScale(b)
+	if scale == 0 {
+		return 0, errors.New("cannot project onto the zero vector")
+	}
+	bs := make([]float64, len(b))
+	for i, x := range b {
+		bs[i] = x / scale
+	}
+	return dot(a, bs) / dot(bs, bs) / scale, nil
+}
+
+func vectorDotHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, b, err := vectorPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return floatResult(ctx, dot(a, b), concatFloats(a, b)...), nil
+}
+
+func vectorCrossHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := vector3Args(req, "a", "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return vectorResult(ctx, cross(v[0], v[1]), concatFloats(v...)...), nil
+}
+
+func vectorNormHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := nonEmptyVectorArg(req, "vector")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// Giving p selects the p-norm; with any other type it would be ignored
+	_, hasP := req.GetArguments()["p"]
+	kind := req.GetString("type", "")
+	switch {
+	case kind == "" && hasP:
+		kind = "p"
+	case kind == "":
+		kind = "2"
+	case hasP && kind != "p":
+		return mcp.NewToolResultError(fmt.Sprintf("p applies only to the p-norm, not to type %s", kind)), nil
+	}
+	var norm float64
+	switch kind {
+	case "1":
+		norm = normP(v, 1)
+	case "2":
+		norm = normP(v, 2)
+	case "inf":
+		norm = normInf(v)
+	case "p":
+		p, err := req.RequireFloat("p")
+		if err != nil {
+			return mcp.NewToolResultError("p is required for the p-norm"), nil
+		}
+		if !(p >= 1) || math.IsInf(p, 0) {
+			return mcp.NewToolResultError("p must be a finite number of at least 1; use type inf for the maximum norm"), nil
+		}
+		norm = normP(v, p)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown norm type %q: must be 1, 2, inf or p", kind)), nil
+	}
+	return floatResult(ctx, norm, v...), nil
+}
+
+func vectorNormalizeHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := nonEmptyVectorArg(req, "vector")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	u, err := unitVector(v, "vector")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return vectorResult(ctx, u, v...), nil
+}
+
+func vectorAngleHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, b, err := vectorPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	ua, err := unitVector(a, "a")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	ub, err := unitVector(b, "b")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// Kahan's formula 2·atan(‖â − b̂‖ / ‖â + b̂‖) stays accurate for nearly
+	// parallel and nearly opposite vectors, unlike acos of the dot product.
+	diff := make([]float64, len(a))
+	sum := make([]float64, len(a))
+	for i := range a {
+		diff[i] = ua[i] - ub[i]
+		sum[i] = ua[i] + ub[i]
+	}
+	angle := 2 * math.Atan2(normP(diff, 2), normP(sum, 2))
+	if req.GetBool("degrees", false) {
+		angle *= 180 / math.Pi
+	}
+	return floatResult(ctx, angle, concatFloats(a, b)...), nil
+}
+
+func vectorProjectHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, b, err := vectorPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	k, err := projectionCoefficient(a, b)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	proj := make([]float64, len(b))
+	for i, x := range b {
+		proj[i] = k * x
+	}
+	return vectorResult(ctx, proj, concatFloats(a, b)...), nil
+}
+
+func vectorRejectHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, b, err := vectorPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	k, err := projectionCoefficient(a, b)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	rej := make([]float64, len(a))
+	for i := range a {
+		rej[i] = math.FMA(-k, b[i], a[i])
+	}
+	return vectorResult(ctx, rej, concatFloats(a, b)...), nil
+}
+
+func vectorDistanceHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	a, b, err := vectorPairArgs(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	diff := make([]float64, len(a))
+	for i := range a {
+		diff[i] = a[i] - b[i]
+	}
+	var dist float64
+	switch metric := req.GetString("metric", "euclidean"); metric {
+	case "euclidean":
+		dist = normP(diff, 2)
+	case "manhattan":
+		dist = normP(diff, 1)
+	case "chebyshev":
+		dist = normInf(diff)
+	case "cosine":
+		ua, err := unitVector(a, "a")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ub, err := unitVector(b, "b")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// 1 − cos θ = ‖â − b̂‖²/2, without cancellation for small angles
+		for i := range diff {
+			d := ua[i] - ub[i]
+			dist += d * d
+		}
+		dist /= 2
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unknown metric %q: must be euclidean, manhattan, chebyshev or cosine", metric)), nil
+	}
+	return floatResult(ctx, dist, concatFloats(a, b)...), nil
+}
+
+func scalarTripleProductHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := vector3Args(req, "a", "b", "c")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return floatResult(ctx, dot(v[0], cross(v[1], v[2])), concatFloats(v...)...), nil
+}
+
+func vectorTripleProductHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := vector3Args(req, "a", "b", "c")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return vectorResult(ctx, cross(v[0], cross(v[1], v[2])), concatFloats(v...)...), nil
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package handlers
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vectorJSON converts values to the array of a decoded JSON argument.
+func vectorJSON(values ...float64) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+func TestVectorTools(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"dot", vectorDotHandler, map[string]any{"a": vectorJSON(1, 2, 3), "b": vectorJSON(4, 5, 6)}, "32"},
+		{"dot 5-D", vectorDotHandler, map[string]any{"a": vectorJSON(1, 1, 1, 1, 1), "b": vectorJSON(1, 2, 3, 4, 5)}, "15"},
+		{"cross", vectorCrossHandler, map[string]any{"a": vectorJSON(1, 0, 0), "b": vectorJSON(0, 1, 0)}, "[0, 0, 1]"},
+		{"cross general", vectorCrossHandler, map[string]any{"a": vectorJSON(1, 2, 3), "b": vectorJSON(4, 5, 6)}, "[-3, 6, -3]"},
+		{"norm default", vectorNormHandler, map[string]any{"vector": vectorJSON(3, 4)}, "5"},
+		{"norm 1", vectorNormHandler, map[string]any{"vector": vectorJSON(3, -4), "type": "1"}, "7"},
+		{"norm inf", vectorNormHandler, map[string]any{"vector": vectorJSON(3, -4), "type": "inf"}, "4"},
+		{"norm p", vectorNormHandler, map[string]any{"vector": vectorJSON(1, 1), "type": "p", "p": 3.0}, strconv.FormatFloat(math.Cbrt(2), 'g', -1, 64)},
+		{"norm p implied", vectorNormHandler, map[string]any{"vector": vectorJSON(1, 1), "p": 3.0}, strconv.FormatFloat(math.Cbrt(2), 'g', -1, 64)},
+		{"norm no overflow", vectorNormHandler, map[string]any{"vector": vectorJSON(3e200, 4e200)}, strconv.FormatFloat(math.Hypot(3e200, 4e200), 'g', -1, 64)},
+		{"normalize", vectorNormalizeHandler, map[string]any{"vector": vectorJSON(0, 3, 4)}, "[0, 0.6, 0.8]"},
+		{"angle", vectorAngleHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(0, 2)}, strconv.FormatFloat(math.Pi/2, 'g', -1, 64)},
+		{"angle degrees", vectorAngleHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(-1, 0), "degrees": true}, "180"},
+		{"angle parallel", vectorAngleHandler, map[string]any{"a": vectorJSON(1, 2, 3), "b": vectorJSON(2, 4, 6)}, "0"},
+		{"project", vectorProjectHandler, map[string]any{"a": vectorJSON(2, 3), "b": vectorJSON(4, 0)}, "[2, 0]"},
+		{"reject", vectorRejectHandler, map[string]any{"a": vectorJSON(2, 3), "b": vectorJSON(4, 0)}, "[0, 3]"},
+		{"euclidean", vectorDistanceHandler, map[string]any{"a": vectorJSON(1, 1), "b": vectorJSON(4, 5)}, "5"},
+		{"manhattan", vectorDistanceHandler, map[string]any{"a": vectorJSON(1, 1), "b": vectorJSON(4, 5), "metric": "manhattan"}, "7"},
+		{"chebyshev", vectorDistanceHandler, map[string]any{"a": vectorJSON(1, 1), "b": vectorJSON(4, 5), "metric": "chebyshev"}, "4"},
+		{"cosine orthogonal", vectorDistanceHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(0, 5), "metric": "cosine"}, "1"},
+		{"cosine opposite", vectorDistanceHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(-3, 0), "metric": "cosine"}, "2"},
+		{"scalar triple", scalarTripleProductHandler, map[string]any{"a": vectorJSON(1, 0, 0), "b": vectorJSON(0, 2, 0), "c": vectorJSON(0, 0, 3)}, "6"},
+		{"vector triple", vectorTripleProductHandler, map[string]any{"a": vectorJSON(1, 0, 0), "b": vectorJSON(1, 0, 0), "c": vectorJSON(0, 1, 0)}, "[0, -1, 0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.False(t, result.IsError, resultText(result))
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestVectorAccuracy(t *testing.T) {
+	// acos(a·b/‖a‖‖b‖) would round to 0 for such a small angle
+	result, err := vectorAngleHandler(context.Background(), makeRequest(map[string]any{
+		"a": vectorJSON(1, 0),
+		"b": vectorJSON(1, 1e-10),
+	}))
+	require.NoError(t, err)
+	angle, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 1e-10, angle, 1e-24)
+
+	// 1 − cos θ ≈ θ²/2 without cancellation
+	result, err = vectorDistanceHandler(context.Background(), makeRequest(map[string]any{
+		"a":      vectorJSON(1, 0),
+		"b":      vectorJSON(1, 1e-10),
+		"metric": "cosine",
+	}))
+	require.NoError(t, err)
+	dist, err := strconv.ParseFloat(resultText(result), 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 5e-21, dist, 1e-34)
+}
+
+func TestVectorStructuredContent(t *testing.T) {
+	result, err := vectorCrossHandler(context.Background(), makeRequest(map[string]any{
+		"a": vectorJSON(0, 1, 0),
+		"b": vectorJSON(1, 0, 0),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"vector": []float64{0, 0, -1}}, result.StructuredContent)
+}
+
+func TestVectorErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]any
+		want    string
+	}{
+		{"length mismatch", vectorDotHandler, map[string]any{"a": vectorJSON(1, 2), "b": vectorJSON(1, 2, 3)}, "a and b must have the same length, got 2 and 3"},
+		{"empty", vectorNormHandler, map[string]any{"vector": vectorJSON()}, "vector must not be empty"},
+		{"cross not 3-D", vectorCrossHandler, map[string]any{"a": vectorJSON(1, 2), "b": vectorJSON(1, 2)}, "a must be a 3-dimensional vector, got 2 entries"},
+		{"triple not 3-D", scalarTripleProductHandler, map[string]any{"a": vectorJSON(1, 2, 3), "b": vectorJSON(1, 2, 3), "c": vectorJSON(1)}, "c must be a 3-dimensional vector, got 1 entries"},
+		{"normalize zero", vectorNormalizeHandler, map[string]any{"vector": vectorJSON(0, 0)}, "vector must not be the zero vector"},
+		{"angle zero", vectorAngleHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(0, 0)}, "b must not be the zero vector"},
+		{"project onto zero", vectorProjectHandler, map[string]any{"a": vectorJSON(1, 0), "b": vectorJSON(0, 0)}, "cannot project onto the zero vector"},
+		{"cosine zero", vectorDistanceHandler, map[string]any{"a": vectorJSON(0, 0), "b": vectorJSON(1, 0), "metric": "cosine"}, "a must not be the zero vector"},
+		{"p missing", vectorNormHandler, map[string]any{"vector": vectorJSON(1), "type": "p"}, "p is required for the p-norm"},
+		{"p too small", vectorNormHandler, map[string]any{"vector": vectorJSON(1), "type": "p", "p": 0.5}, "p must be a finite number of at least 1; use type inf for the maximum norm"},
+		{"p with other type", vectorNormHandler, map[string]any{"vector": vectorJSON(1), "type": "2", "p": 3.0}, "p applies only to the p-norm, not to type 2"},
+		{"unknown norm", vectorNormHandler, map[string]any{"vector": vectorJSON(1), "type": "3"}, `unknown norm type "3": must be 1, 2, inf or p`},
+		{"unknown metric", vectorDistanceHandler, map[string]any{"a": vectorJSON(1), "b": vectorJSON(1), "metric": "hamming"}, `unknown metric "hamming": must be euclidean, manhattan, chebyshev or cosine`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.handler(context.Background(), makeRequest(tt.args))
+			require.NoError(t, err)
+
+			require.True(t, result.IsError)
+			assert.Equal(t, tt.want, resultText(result))
+		})
+	}
+}
+
+func TestVectorIEEEPolicy(t *testing.T) {
+	ctx := withIEEEPolicy(context.Background(), config.IEEEStrict)
+
+	result, err := vectorDotHandler(ctx, makeRequest(map[string]any{
+		"a": vectorJSON(1e200),
+		"b": vectorJSON(1e200),
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestVectorSessionReferences(t *testing.T) {
+	r := NewRegistry()
+	ctx := sessionContext("s1")
+
+	callTool(t, r, ctx, "set_variable", map[string]any{"name": "k", "value": 2.0})
+	result := callTool(t, r, ctx, "vector_dot", map[string]any{"a": []any{"$k", 1.0}, "b": []any{3.0, "$k"}})
+
+	require.False(t, result.IsError, resultText(result))
+	assert.Equal(t, "8", resultText(result))
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package httpserver mounts MCP servers on HTTP paths, together with a plain
+// REST API over the same tools, with per-endpoint authentication and request
+// limits.
+package httpserver
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultPath is the path of the endpoint built from the top-level configuration.
+const DefaultPath = "/mcp"
+
+// Endpoint serves one MCP server over streamable HTTP at Path and, when
+// APIPath is set, its tools as a REST API under APIPath.
+type Endpoint struct {
+	// Path is the URL path the endpoint is mounted at.
+	Path string
+	// APIPath is the URL path prefix of the REST API, or "" for none.
+	APIPath string
+	// Server is the MCP server behind the endpoint.
+	Server *server.MCPServer
+
+	cfg  atomic.Pointer[config.Config]
+	http *server.StreamableHTTPServer
+	api  *restAPI
+}
+
+// NewEndpoint creates an endpoint for s at path with its REST API at apiPath
+// (or none if apiPath is ""), using cfg for authentication and limits.
+func NewEndpoint(path, apiPath string, s *server.MCPServer, cfg *config.Config) *Endpoint {
+	e := &Endpoint{
+		Path:    path,
+		APIPath: apiPath,
+		Server:  s,
+		http:    server.NewStreamableHTTPServer(s, server.WithEndpointPath(path)),
+	}
+	if apiPath != "" {
+		e.api = newRESTAPI(apiPath, e)
+	}
+	e.cfg.Store(cfg)
+	return e
+}
+
+// ProfilePath returns the path a profile is mounted at.
+func ProfilePath(name string) string {
+	return DefaultPath + "/" + name
+}
+
+// Config returns the endpoint's current configuration.
+func (e *Endpoint) Config() *config.Config {
+	return e.cfg.Load()
+}
+
+// SetConfig replaces the endpoint's configuration. Keys and limits apply to
+// subsequent requests.
+func (e *Endpoint) SetConfig(cfg *config.Config) {
+	e.cfg.Store(cfg)
+}
+
+// ServeHTTP checks the API key and request size before handing the request
+// to the MCP server or the REST API.
+func (e *Endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cfg := e.Config()
+	if !authorized(r, cfg.APIKeys) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="math-mcp-server"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if cfg.MaxRequestBytes > 0 {
+		if r.ContentLength > cfg.MaxRequestBytes {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, cfg.MaxRequestBytes)
+	}
+	if e.api != nil && strings.HasPrefix(r.URL.Path, e.APIPath+"/") {
+		e.api.ServeHTTP(w, r)
+		return
+	}
+	e.http.ServeHTTP(w, r)
+}
+
+// NewMux returns a mux serving each endpoint at its path and REST API path.
+func NewMux(endpoints ...*Endpoint) *http.ServeMux {
+	mux := http.NewServeMux()
+	for _, e := range endpoints {
+		mux.Handle(e.Path, e)
+		if e.APIPath != "" {
+			mux.Handle(e.APIPath+"/", e)
+		}
+	}
+	return mux
+}
+
+// authorized reports whether r carries one of keys, as a bearer token or in
+// the X-API-Key header. Any request is authorized when keys is empty.
+func authorized(r *http.Request, keys []string) bool {
+	if len(keys) == 0 {
+		return true
+	}
+	presented := r.Header.Get("X-API-Key")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		presented = strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	if presented == "" {
+		return false
+	}
+	for _, key := range keys {
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+)
+
+const initializeRequest = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`
+
+func newTestEndpoint(path string, cfg *config.Config) *Endpoint {
+	return NewEndpoint(path, "", server.NewMCPServer("test", "1.0.0"), cfg)
+}
+
+func postInitialize(t *testing.T, h http.Handler, path string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(initializeRequest))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestEndpointWithoutKeys(t *testing.T) {
+	e := newTestEndpoint(DefaultPath, &config.Config{})
+
+	rec := postInitialize(t, e, DefaultPath, nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestEndpointAuthentication(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   int
+	}{
+		{"no key", nil, http.StatusUnauthorized},
+		{"wrong key", http.Header{"Authorization": {"Bearer nope"}}, http.StatusUnauthorized},
+		{"bearer key", http.Header{"Authorization": {"Bearer secret"}}, http.StatusOK},
+		{"x-api-key", http.Header{"X-Api-Key": {"other"}}, http.StatusOK},
+	}
+
+	e := newTestEndpoint(DefaultPath, &config.Config{APIKeys: []string{"secret", "other"}})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postInitialize(t, e, DefaultPath, tt.header)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestEndpointRequestLimit(t *testing.T) {
+	e := newTestEndpoint(DefaultPath, &config.Config{MaxRequestBytes: 16})
+
+	rec := postInitialize(t, e, DefaultPath, nil)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestEndpointSetConfig(t *testing.T) {
+	e := newTestEndpoint(DefaultPath, &config.Config{})
+	e.SetConfig(&config.Config{APIKeys: []string{"secret"}})
+
+	rec := postInitialize(t, e, DefaultPath, nil)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestNewMuxRoutesProfiles(t *testing.T) {
+	basic := newTestEndpoint(ProfilePath("basic"), &config.Config{APIKeys: []string{"basic-key"}})
+	science := newTestEndpoint(ProfilePath("science"), &config.Config{APIKeys: []string{"science-key"}})
+	mux := NewMux(newTestEndpoint(DefaultPath, &config.Config{}), basic, science)
+
+	assert.Equal(t, http.StatusOK, postInitialize(t, mux, "/mcp", nil).Code)
+	assert.Equal(t, http.StatusOK, postInitialize(t, mux, "/mcp/basic", http.Header{"Authorization": {"Bearer basic-key"}}).Code)
+	assert.Equal(t, http.StatusUnauthorized, postInitialize(t, mux, "/mcp/science", http.Header{"Authorization": {"Bearer basic-key"}}).Code)
+	assert.Equal(t, http.StatusNotFound, postInitialize(t, mux, "/mcp/unknown", nil).Code)
+}
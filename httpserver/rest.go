@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultAPIPath is the path of the REST API built from the top-level
+// configuration.
+const DefaultAPIPath = "/api/v1"
+
+// ProfileAPIPath returns the path a profile's REST API is mounted at.
+func ProfileAPIPath(name string) string {
+	return DefaultAPIPath + "/profiles/" + name
+}
+
+// toolInfo describes a tool in the REST catalog.
+type toolInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	InputSchema any    `json:"inputSchema"`
+}
+
+// toolResponse is the body returned by a tool call. Text holds the result
+// on success and Error the message on failure.
+type toolResponse struct {
+	Text              string `json:"text,omitempty"`
+	Error             string `json:"error,omitempty"`
+	StructuredContent any    `json:"structuredContent,omitempty"`
+}
+
+// restStatelessNote explains in the OpenAPI document that REST calls do not
+// belong to an MCP session.
+const restStatelessNote = "Calls are stateless: they belong to no MCP session, so session variables, " +
+	"the answer history and session functions are unavailable. set_variable, and define_function " +
+	"and delete_function without persist, are rejected with 422."
+
+// restAPI serves the tools of an MCP server as plain JSON over HTTP:
+//
+//	GET  <prefix>/tools          catalog of tools with input schemas
+//	GET  <prefix>/tools/{name}   one tool
+//	POST <prefix>/tools/{name}   call a tool with a JSON object of arguments
+//	GET  <prefix>/openapi.json   OpenAPI 3 document
+//
+// Tools are read from the server on every request, so tools added or removed
+// by a configuration reload are reflected immediately.
+type restAPI struct {
+	prefix   string
+	endpoint *Endpoint
+	mux      *http.ServeMux
+}
+
+func newRESTAPI(prefix string, e *Endpoint) *restAPI {
+	api := &restAPI{prefix: prefix, endpoint: e, mux: http.NewServeMux()}
+	api.mux.HandleFunc("GET "+prefix+"/tools", api.listTools)
+	api.mux.HandleFunc("GET "+prefix+"/tools/{name}", api.getTool)
+	api.mux.HandleFunc("POST "+prefix+"/tools/{name}", api.callTool)
+	api.mux.HandleFunc("GET "+prefix+"/openapi.json", api.openAPI)
+	return api
+}
+
+func (api *restAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mux.ServeHTTP(w, r)
+}
+
+// tools returns the server's tools sorted by name.
+func (api *restAPI) tools() []*server.ServerTool {
+	all := api.endpoint.Server.ListTools()
+	tools := make([]*server.ServerTool, 0, len(all))
+	for _, t := range all {
+		tools = append(tools, t)
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Tool.Name < tools[j].Tool.Name })
+	return tools
+}
+
+func (api *restAPI) listTools(w http.ResponseWriter, r *http.Request) {
+	tools := api.tools()
+	infos := make([]toolInfo, len(tools))
+	for i, t := range tools {
+		infos[i] = newToolInfo(t.Tool)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"tools": infos})
+}
+
+func (api *restAPI) getTool(w http.ResponseWriter, r *http.Request) {
+	t := api.endpoint.Server.GetTool(r.PathValue("name"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown tool %q", r.PathValue("name")))
+		return
+	}
+	writeJSON(w, http.StatusOK, newToolInfo(t.Tool))
+}
+
+func (api *restAPI) callTool(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	t := api.endpoint.Server.GetTool(name)
+	if t == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown tool %q", name))
+		return
+	}
+
+	args, err := decodeArguments(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "request body too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	result, err := callHandler(r, t.Handler, req)
+	if err != nil {
+		log.Printf("REST call to %s failed: %v", name, err)
+		writeError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	resp := toolResponse{StructuredContent: result.StructuredContent}
+	if result.IsError {
+		resp.Error = resultText(result)
+		writeJSON(w, http.StatusUnprocessableEntity, resp)
+		return
+	}
+	resp.Text = resultText(result)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// callHandler runs a tool handler, turning a panic into an error.
+func callHandler(r *http.Request, h server.ToolHandlerFunc, req mcp.CallToolRequest) (result *mcp.CallToolResult, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	result, err = h(r.Context(), req)
+	if err == nil && result == nil {
+		err = errors.New("tool returned no result")
+	}
+	return result, err
+}
+
+// decodeArguments reads a JSON object of tool arguments. An empty body means
+// no arguments.
+func decodeArguments(body io.Reader) (map[string]any, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	args := map[string]any{}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return args, nil
+	}
+	if err := json.Unmarshal(data, &args); err != nil {
+		return nil, fmt.Errorf("request body must be a JSON object of arguments: %v", err)
+	}
+	return args, nil
+}
+
+// resultText joins the text content of a tool result.
+func resultText(result *mcp.CallToolResult) string {
+	var parts []string
+	for _, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func newToolInfo(t mcp.Tool) toolInfo {
+	return toolInfo{Name: t.Name, Description: t.Description, InputSchema: inputSchema(t)}
+}
+
+// inputSchema returns the JSON Schema of a tool's arguments.
+func inputSchema(t mcp.Tool) any {
+	if t.RawInputSchema != nil {
+		return t.RawInputSchema
+	}
+	return t.InputSchema
+}
+
+// writeJSON sends v with the given status. v is encoded before anything is
+// written, so that a value that cannot be encoded becomes a 500 response
+// rather than a truncated body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("Failed to encode response: %v", err)
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(toolResponse{Error: "internal error: the result could not be encoded"})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		log.Printf("Failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, toolResponse{Error: msg})
+}
+
+// openAPI serves an OpenAPI 3 document describing the catalog and one
+// operation per tool.
+func (api *restAPI) openAPI(w http.ResponseWriter, r *http.Request) {
+	jsonBody := func(schema any) map[string]any {
+		return map[string]any{"application/json": map[string]any{"schema": schema}}
+	}
+	ref := func(name string) map[string]any {
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+	response := func(desc, schema string) map[string]any {
+		return map[string]any{"description": desc, "content": jsonBody(ref(schema))}
+	}
+
+	paths := map[string]any{
+		"/tools": map[string]any{
+			"get": map[string]any{
+				"operationId": "listTools",
+				"summary":     "List the available tools",
+				"responses":   map[string]any{"200": response("Tool catalog", "ToolList")},
+			},
+		},
+	}
+	for _, t := range api.tools() {
+		paths["/tools/"+t.Tool.Name] = map[string]any{
+			"post": map[string]any{
+				"operationId": t.Tool.Name,
+				"summary":     t.Tool.Description,
+				"requestBody": map[string]any{"required": true, "content": jsonBody(inputSchema(t.Tool))},
+				"responses": map[string]any{
+					"200": response("Result", "ToolResponse"),
+					"400": response("Malformed arguments", "ToolResponse"),
+					"422": response("The tool reported an error", "ToolResponse"),
+				},
+			},
+		}
+	}
+
+	doc := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "math-mcp-server",
+			"version":     "1.0.0",
+			"description": restStatelessNote,
+		},
+		"servers": []any{map[string]any{"url": api.prefix}},
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"ToolList": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"tools": map[string]any{"type": "array", "items": ref("Tool")},
+					},
+				},
+				"Tool": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"name":        map[string]any{"type": "string"},
+						"description": map[string]any{"type": "string"},
+						"inputSchema": map[string]any{"type": "object"},
+					},
+				},
+				"ToolResponse": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"text":              map[string]any{"type": "string"},
+						"error":             map[string]any{"type": "string"},
+						"structuredContent": map[string]any{"type": "object"},
+					},
+				},
+			},
+		},
+	}
+	if len(api.endpoint.Config().APIKeys) > 0 {
+		components := doc["components"].(map[string]any)
+		components["securitySchemes"] = map[string]any{
+			"bearer": map[string]any{"type": "http", "scheme": "bearer"},
+			"apiKey": map[string]any{"type": "apiKey", "in": "header", "name": "X-API-Key"},
+		}
+		doc["security"] = []any{map[string]any{"bearer": []any{}}, map[string]any{"apiKey": []any{}}}
+	}
+	writeJSON(w, http.StatusOK, doc)
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/config"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPI(cfg *config.Config) *Endpoint {
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(
+		mcp.NewTool("add",
+			mcp.WithDescription("Add two numbers"),
+			mcp.WithNumber("a", mcp.Required()),
+			mcp.WithNumber("b", mcp.Required()),
+		),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			a, err := req.RequireFloat("a")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			b, err := req.RequireFloat("b")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return mcp.FormatNumberResult(a + b), nil
+		},
+	)
+	return NewEndpoint(DefaultPath, DefaultAPIPath, s, cfg)
+}
+
+func doRequest(h http.Handler, method, path, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeBody(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func TestRESTListTools(t *testing.T) {
+	mux := NewMux(newTestAPI(&config.Config{}))
+
+	rec := doRequest(mux, http.MethodGet, "/api/v1/tools", "", nil)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	tools := decodeBody(t, rec)["tools"].([]any)
+	require.Len(t, tools, 1)
+	tool := tools[0].(map[string]any)
+	assert.Equal(t, "add", tool["name"])
+	assert.Equal(t, "Add two numbers", tool["description"])
+	assert.Contains(t, tool["inputSchema"].(map[string]any)["properties"], "a")
+}
+
+func TestRESTCallTool(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+		wantKey  string
+		want     string
+	}{
+		{"success", http.MethodPost, "/api/v1/tools/add", `{"a": 2, "b": 3}`, http.StatusOK, "text", "5"},
+		{"tool error", http.MethodPost, "/api/v1/tools/add", `{"a": 2}`, http.StatusUnprocessableEntity, "error", `required argument "b" not found`},
+		{"malformed body", http.MethodPost, "/api/v1/tools/add", `[1, 2]`, http.StatusBadRequest, "error", "JSON object"},
+		{"unknown tool", http.MethodPost, "/api/v1/tools/nope", `{}`, http.StatusNotFound, "error", "unknown tool"},
+		{"get tool", http.MethodGet, "/api/v1/tools/add", "", http.StatusOK, "name", "add"},
+	}
+
+	mux := NewMux(newTestAPI(&config.Config{}))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRequest(mux, tt.method, tt.path, tt.body, nil)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Contains(t, decodeBody(t, rec)[tt.wantKey], tt.want)
+		})
+	}
+}
+
+func TestRESTUnencodableResult(t *testing.T) {
+	e := newTestAPI(&config.Config{})
+	e.Server.AddTool(mcp.NewTool("nan"), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result := mcp.NewToolResultText("NaN")
+		result.StructuredContent = map[string]any{"value": math.NaN()}
+		return result, nil
+	})
+
+	rec := doRequest(NewMux(e), http.MethodPost, "/api/v1/tools/nan", `{}`, nil)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, decodeBody(t, rec)["error"], "could not be encoded")
+}
+
+func TestRESTRouting(t *testing.T) {
+	mux := NewMux(newTestAPI(&config.Config{}))
+
+	rec := doRequest(mux, http.MethodDelete, "/api/v1/tools/add", "", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = doRequest(mux, http.MethodGet, "/api/v1/other", "", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRESTUsesEndpointAuthAndLimits(t *testing.T) {
+	mux := NewMux(newTestAPI(&config.Config{APIKeys: []string{"secret"}, MaxRequestBytes: 16}))
+
+	rec := doRequest(mux, http.MethodGet, "/api/v1/tools", "", nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	auth := http.Header{"Authorization": {"Bearer secret"}}
+	rec = doRequest(mux, http.MethodGet, "/api/v1/tools", "", auth)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(mux, http.MethodPost, "/api/v1/tools/add", `{"a": 1, "b": 2, "c": 3}`, auth)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestRESTOpenAPI(t *testing.T) {
+	mux := NewMux(newTestAPI(&config.Config{APIKeys: []string{"secret"}}))
+
+	rec := doRequest(mux, http.MethodGet, "/api/v1/openapi.json", "", http.Header{"X-Api-Key": {"secret"}})
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	doc := decodeBody(t, rec)
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	assert.Equal(t, "/api/v1", doc["servers"].([]any)[0].(map[string]any)["url"])
+	assert.Contains(t, doc["info"].(map[string]any)["description"], "stateless")
+
+	paths := doc["paths"].(map[string]any)
+	require.Contains(t, paths, "/tools/add")
+	op := paths["/tools/add"].(map[string]any)["post"].(map[string]any)
+	assert.Equal(t, "add", op["operationId"])
+	schema := op["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+	assert.Equal(t, []any{"a", "b"}, schema["required"])
+
+	assert.Contains(t, doc["components"].(map[string]any), "securitySchemes")
+}
+
+func TestRESTProfilePath(t *testing.T) {
+	assert.Equal(t, "/api/v1/profiles/basic", ProfileAPIPath("basic"))
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package interp
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Grid is a function of two variables through values on a rectangular
+// grid, built by NewGrid. Beyond the grid it extends the pieces at its
+// edges.
+type Grid struct {
+	method Method
+	x, y   []float64
+	z      [][]float64
+	// dz holds for Bicubic the slopes along y of the spline through each
+	// row of z.
+	dz [][]float64
+}
+
+// NewGrid builds the interpolant by Bilinear or Bicubic of the values z on
+// a grid, where z[i][j] is the value at (x[i], y[j]). The values of x and of
+// y may come in any order but must be distinct. Bicubic is the tensor
+// product of natural cubic splines: a spline along y through each row of z
+// gives values at the query y, and a spline along x through those gives the
+// result, which is the same as taking x first.
+func NewGrid(method Method, x, y []float64, z [][]float64) (*Grid, error) {
+	if len(x) < 2 || len(y) < 2 {
+		return nil, errors.New("the grid needs at least 2 values of x and 2 of y")
+	}
+	if len(z) != len(x) {
+		return nil, fmt.Errorf("z has %d rows but there are %d values of x", len(z), len(x))
+	}
+	for i, row := range z {
+		if len(row) != len(y) {
+			return nil, fmt.Errorf("row %d of z has %d values but there are %d values of y", i, len(row), len(y))
+		}
+		if !allFinite(row) {
+			return nil, errors.New("z must be finite")
+		}
+	}
+	if !allFinite(x) {
+		return nil, errors.New("x must be finite")
+	}
+	if !allFinite(y) {
+		return nil, errors.New("y must be finite")
+	}
+	if method != Bilinear && method != Bicubic {
+		return nil, fmt.Errorf("unknown grid method %q", method)
+	}
+	rowPerm, err := order(x, "x")
+	if err != nil {
+		return nil, err
+	}
+	colPerm, err := order(y, "y")
+	if err != nil {
+		return nil, err
+	}
+	g := &Grid{method: method, x: permute(x, rowPerm), y: permute(y, colPerm), z: permute(z, rowPerm)}
+	for i, row := range g.z {
+		g.z[i] = permute(row, colPerm)
+	}
+	if method == Bicubic {
+		g.dz = make([][]float64, len(g.x))
+		for i, row := range g.z {
+			g.dz[i] = splineSlopes(g.y, row, false, 0, 0)
+		}
+	}
+	return g, nil
+}
+
+// Domain returns the smallest and largest x and y of the grid.
+func (g *Grid) Domain() (xlo, xhi, ylo, yhi float64) {
+	return g.x[0], g.x[len(g.x)-1], g.y[0], g.y[len(g.y)-1]
+}
+
+// At returns the interpolant at (u, v).
+func (g *Grid) At(u, v float64) float64 {
+	i, j := segment(g.x, u), segment(g.y, v)
+	if g.method == Bilinear {
+		s := (u - g.x[i]) / (g.x[i+1] - g.x[i])
+		t := (v - g.y[j]) / (g.y[j+1] - g.y[j])
+		return (1-s)*((1-t)*g.z[i][j]+t*g.z[i][j+1]) + s*((1-t)*g.z[i+1][j]+t*g.z[i+1][j+1])
+	}
+	col := make([]float64, len(g.x))
+	y0, y1 := g.y[j], g.y[j+1]
+	for k, row := range g.z {
+		col[k] = hermite(y0, y1, row[j], row[j+1], g.dz[k][j], g.dz[k][j+1], v)
+	}
+	d := splineSlopes(g.x, col, false, 0, 0)
+	return hermite(g.x[i], g.x[i+1], col[i], col[i+1], d[i], d[i+1], u)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sample returns f on the grid x × y.
+func sample(f func(x, y float64) float64, x, y []float64) [][]float64 {
+	z := make([][]float64, len(x))
+	for i, u := range x {
+		z[i] = make([]float64, len(y))
+		for j, v := range y {
+			z[i][j] = f(u, v)
+		}
+	}
+	return z
+}
+
+func TestBilinear(t *testing.T) {
+	// Bilinear functions are reproduced, including beyond the grid
+	f := func(x, y float64) float64 { return 1 + 2*x - y + 0.5*x*y }
+	x := []float64{0, 1, 3}
+	y := []float64{-1, 2}
+	g, err := NewGrid(Bilinear, x, y, sample(f, x, y))
+	require.NoError(t, err)
+	for _, q := range [][2]float64{{0.5, 0}, {2, 1.5}, {3, 2}, {-1, 3}} {
+		assert.InDelta(t, f(q[0], q[1]), g.At(q[0], q[1]), 1e-14)
+	}
+	xlo, xhi, ylo, yhi := g.Domain()
+	assert.Equal(t, []float64{0, 3, -1, 2}, []float64{xlo, xhi, ylo, yhi})
+
+	// A datasheet table, with rows in descending order: midway between four
+	// entries is their mean
+	g, err = NewGrid(Bilinear, []float64{20, 10}, []float64{100, 200}, [][]float64{{3, 4}, {1, 2}})
+	require.NoError(t, err)
+	assert.InDelta(t, 2.5, g.At(15, 150), 1e-15)
+	assert.InDelta(t, 1.5, g.At(10, 150), 1e-15)
+}
+
+func TestBicubic(t *testing.T) {
+	f := func(x, y float64) float64 { return math.Sin(x) * math.Cos(y) }
+	var x, y []float64
+	for i := range 21 {
+		x = append(x, float64(i)*0.15)
+	}
+	for j := range 17 {
+		y = append(y, float64(j)*0.2-1.6)
+	}
+	z := sample(f, x, y)
+	g, err := NewGrid(Bicubic, x, y, z)
+	require.NoError(t, err)
+	assert.Equal(t, z[4][7], g.At(x[4], y[7]))
+	for _, q := range [][2]float64{{0.8, 0.1}, {1.33, -0.77}, {2.2, 1.1}} {
+		assert.InDelta(t, f(q[0], q[1]), g.At(q[0], q[1]), 1e-4)
+	}
+
+	// Swapping the axes gives the same surface
+	transposed := make([][]float64, len(y))
+	for j := range y {
+		transposed[j] = make([]float64, len(x))
+		for i := range x {
+			transposed[j][i] = z[i][j]
+		}
+	}
+	gt, err := NewGrid(Bicubic, y, x, transposed)
+	require.NoError(t, err)
+	assert.InDelta(t, g.At(1.33, -0.77), gt.At(-0.77, 1.33), 1e-14)
+
+	// Bilinear functions are reproduced exactly
+	bilinear := func(x, y float64) float64 { return 2 - x + 3*y - x*y }
+	g, err = NewGrid(Bicubic, []float64{0, 1, 2.5, 4}, []float64{0, 2, 3}, sample(bilinear, []float64{0, 1, 2.5, 4}, []float64{0, 2, 3}))
+	require.NoError(t, err)
+	assert.InDelta(t, bilinear(1.7, 2.4), g.At(1.7, 2.4), 1e-13)
+}
+
+func TestNewGridErrors(t *testing.T) {
+	_, err := NewGrid(Bilinear, []float64{1}, []float64{1, 2}, [][]float64{{1, 2}})
+	assert.EqualError(t, err, "the grid needs at least 2 values of x and 2 of y")
+	_, err = NewGrid(Bilinear, []float64{1, 2}, []float64{1, 2}, [][]float64{{1, 2}})
+	assert.EqualError(t, err, "z has 1 rows but there are 2 values of x")
+	_, err = NewGrid(Bilinear, []float64{1, 2}, []float64{1, 2}, [][]float64{{1, 2}, {3}})
+	assert.EqualError(t, err, "row 1 of z has 1 values but there are 2 values of y")
+	_, err = NewGrid(Bilinear, []float64{1, 2}, []float64{1, 1}, [][]float64{{1, 2}, {3, 4}})
+	assert.EqualError(t, err, "the values of y must be distinct, but 1 appears twice")
+	_, err = NewGrid(Bilinear, []float64{1, 2}, []float64{1, 2}, [][]float64{{1, 2}, {3, math.NaN()}})
+	assert.EqualError(t, err, "z must be finite")
+	_, err = NewGrid(Linear, []float64{1, 2}, []float64{1, 2}, [][]float64{{1, 2}, {3, 4}})
+	assert.EqualError(t, err, `unknown grid method "linear"`)
+}
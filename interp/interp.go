@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package interp implements interpolation through points in one dimension
+// and over rectangular grids in two.
+package interp
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Method is an interpolation method.
+type Method string
+
+const (
+	// Linear joins neighbouring points by straight lines.
+	Linear Method = "linear"
+	// Nearest takes the value at the nearest point, the lower one at a tie.
+	Nearest Method = "nearest"
+	// Polynomial is the polynomial of least degree through all the points,
+	// the Lagrange or Newton interpolating polynomial, evaluated in the
+	// numerically stable barycentric form.
+	Polynomial Method = "polynomial"
+	// NaturalSpline is the cubic spline with zero second derivative at both
+	// ends.
+	NaturalSpline Method = "natural_spline"
+	// ClampedSpline is the cubic spline with given first derivatives at both
+	// ends.
+	ClampedSpline Method = "clamped_spline"
+	// PCHIP is the piecewise cubic Hermite interpolant of Fritsch and Carlson,
+	// which is monotone wherever the points are and so does not overshoot.
+	PCHIP Method = "pchip"
+	// Bilinear interpolates linearly in each direction of a grid.
+	Bilinear Method = "bilinear"
+	// Bicubic interpolates by natural cubic splines in each direction of a
+	// grid.
+	Bicubic Method = "bicubic"
+)
+
+// MaxPolynomialPoints caps the points of Polynomial, whose oscillation
+// between the points grows rapidly with its degree.
+const MaxPolynomialPoints = 100
+
+// Options holds the end conditions of ClampedSpline.
+type Options struct {
+	// StartSlope and EndSlope are the first derivatives at the first and
+	// last points.
+	StartSlope, EndSlope float64
+}
+
+// Interpolant is a function through a set of points, built by New. Beyond
+// the points it extends the first or last piece, or for Polynomial the
+// polynomial itself.
+type Interpolant struct {
+	method Method
+	x, y   []float64
+	// d holds the slopes at the points of the cubic methods.
+	d []float64
+	// w holds the barycentric weights of Polynomial at the points u, which
+	// are x shifted by center and divided by scale.
+	w, u          []float64
+	center, scale float64
+}
+
+// New builds the interpolant of the points (x, y) by method. The points may
+// come in any order, but the values of x must be distinct.
+func New(method Method, x, y []float64, opts Options) (*Interpolant, error) {
+	if len(x) != len(y) {
+		return nil, fmt.Errorf("there are %d values of x but %d of y", len(x), len(y))
+	}
+	if len(x) < 2 {
+		return nil, errors.New("interpolation needs at least 2 points")
+	}
+	if !allFinite(x) {
+		return nil, errors.New("x must be finite")
+	}
+	if !allFinite(y) {
+		return nil, errors.New("y must be finite")
+	}
+	perm, err := order(x, "x")
+	if err != nil {
+		return nil, err
+	}
+	p := &Interpolant{method: method, x: permute(x, perm), y: permute(y, perm)}
+	switch method {
+	case Linear, Nearest:
+	case Polynomial:
+		if len(x) > MaxPolynomialPoints {
+			return nil, fmt.Errorf("polynomial interpolation takes at most %d points; use a spline for more", MaxPolynomialPoints)
+		}
+		p.weights()
+	case NaturalSpline:
+		p.d = splineSlopes(p.x, p.y, false, 0, 0)
+	case ClampedSpline:
+		if !allFinite([]float64{opts.StartSlope, opts.EndSlope}) {
+			return nil, errors.New("the end slopes must be finite")
+		}
+		p.d = splineSlopes(p.x, p.y, true, opts.StartSlope, opts.EndSlope)
+	case PCHIP:
+		p.d = pchipSlopes(p.x, p.y)
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+	return p, nil
+}
+
+// Domain returns the smallest and largest x of the points.
+func (p *Interpolant) Domain() (lo, hi float64) {
+	return p.x[0], p.x[len(p.x)-1]
+}
+
+// At returns the interpolant at t.
+func (p *Interpolant) At(t float64) float64 {
+	if p.method == Polynomial {
+		return p.barycentric(t)
+	}
+	i := segment(p.x, t)
+	x0, x1, y0, y1 := p.x[i], p.x[i+1], p.y[i], p.y[i+1]
+	switch p.method {
+	case Linear:
+		s := (t - x0) / (x1 - x0)
+		return (1-s)*y0 + s*y1
+	case Nearest:
+		if t-x0 <= x1-t {
+			return y0
+		}
+		return y1
+	}
+	return hermite(x0, x1, y0, y1, p.d[i], p.d[i+1], t)
+}
+
+// weights computes the barycentric weights 1/∏(uⱼ − uₖ) over k ≠ j, with x
+// mapped to [−2, 2] so that the products neither overflow nor underflow.
+func (p *Interpolant) weights() {
+	lo, hi := p.Domain()
+	p.center, p.scale = (lo+hi)/2, (hi-lo)/4
+	n := len(p.x)
+	p.u = make([]float64, n)
+	for j, x := range p.x {
+		p.u[j] = (x - p.center) / p.scale
+	}
+	p.w = make([]float64, n)
+	for j := range n {
+		prod := 1.0
+		for k := range n {
+			if k != j {
+				prod *= p.u[j] - p.u[k]
+			}
+		}
+		p.w[j] = 1 / prod
+	}
+}
+
+// barycentric evaluates Polynomial at t by the second barycentric formula
+// Σ wⱼyⱼ/(u − uⱼ) / Σ wⱼ/(u − uⱼ).
+func (p *Interpolant) barycentric(t float64) float64 {
+	u := (t - p.center) / p.scale
+	var num, den float64
+	for j, uj := range p.u {
+		if t == p.x[j] {
+			return p.y[j]
+		}
+		c := p.w[j] / (u - uj)
+		num += c * p.y[j]
+		den += c
+	}
+	return num / den
+}
+
+// segment returns the index i of the interval [x[i], x[i+1]] holding t, or
+// of the first or last interval when t is outside them.
+func segment(x []float64, t float64) int {
+	i := sort.SearchFloat64s(x, t) - 1
+	return max(0, min(i, len(x)-2))
+}
+
+// order returns the permutation that sorts x, or an error naming x when two
+// of its values are equal.
+func order(x []float64, name string) ([]int, error) {
+	perm := make([]int, len(x))
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortStableFunc(perm, func(a, b int) int { return cmp.Compare(x[a], x[b]) })
+	for i := 1; i < len(perm); i++ {
+		if x[perm[i]] == x[perm[i-1]] {
+			return nil, fmt.Errorf("the values of %s must be distinct, but %g appears twice", name, x[perm[i]])
+		}
+	}
+	return perm, nil
+}
+
+// permute returns the values of v in the order perm.
+func permute[T any](v []T, perm []int) []T {
+	out := make([]T, len(perm))
+	for i, j := range perm {
+		out[i] = v[j]
+	}
+	return out
+}
+
+// allFinite reports whether every value is finite.
+func allFinite(v []float64) bool {
+	for _, x := range v {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinear(t *testing.T) {
+	// Points in any order
+	p, err := New(Linear, []float64{2, 0, 1}, []float64{0, 0, 2}, Options{})
+	require.NoError(t, err)
+	lo, hi := p.Domain()
+	assert.Equal(t, 0.0, lo)
+	assert.Equal(t, 2.0, hi)
+	tests := []struct{ x, want float64 }{
+		{0, 0}, {0.25, 0.5}, {1, 2}, {1.5, 1}, {2, 0},
+		// Extended from the end pieces
+		{-1, -2}, {3, -2},
+	}
+	for _, tt := range tests {
+		assert.InDelta(t, tt.want, p.At(tt.x), 1e-15, "x = %g", tt.x)
+	}
+}
+
+func TestNearest(t *testing.T) {
+	p, err := New(Nearest, []float64{0, 1, 3}, []float64{10, 20, 30}, Options{})
+	require.NoError(t, err)
+	tests := []struct{ x, want float64 }{
+		{-5, 10}, {0.4, 10}, {0.5, 10}, {0.6, 20}, {2, 20}, {2.1, 30}, {9, 30},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, p.At(tt.x), "x = %g", tt.x)
+	}
+}
+
+func TestPolynomial(t *testing.T) {
+	// Four points determine a cubic, everywhere
+	f := func(x float64) float64 { return 2 - x + 0.5*x*x*x }
+	x := []float64{3, -1, 0.5, 2}
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = f(v)
+	}
+	p, err := New(Polynomial, x, y, Options{})
+	require.NoError(t, err)
+	for _, v := range []float64{-3, -1, 0, 0.5, 1.7, 2, 4} {
+		assert.InDelta(t, f(v), p.At(v), 1e-12, "x = %g", v)
+	}
+	assert.Equal(t, y[0], p.At(x[0]))
+
+	// Many Chebyshev points of a smooth function stay accurate
+	n := MaxPolynomialPoints
+	x, y = make([]float64, n), make([]float64, n)
+	for i := range n {
+		x[i] = 1e6 * math.Cos(math.Pi*(float64(i)+0.5)/float64(n))
+		y[i] = math.Exp(x[i] / 1e6)
+	}
+	p, err = New(Polynomial, x, y, Options{})
+	require.NoError(t, err)
+	for _, v := range []float64{-1e6, -3e5, 0, 7e5, 1e6} {
+		assert.InDelta(t, math.Exp(v/1e6), p.At(v), 1e-12)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New(Linear, []float64{1, 2}, []float64{1}, Options{})
+	assert.EqualError(t, err, "there are 2 values of x but 1 of y")
+	_, err = New(Linear, []float64{1}, []float64{1}, Options{})
+	assert.EqualError(t, err, "interpolation needs at least 2 points")
+	_, err = New(Linear, []float64{1, 2, 1}, []float64{1, 2, 3}, Options{})
+	assert.EqualError(t, err, "the values of x must be distinct, but 1 appears twice")
+	_, err = New(Linear, []float64{1, math.NaN()}, []float64{1, 2}, Options{})
+	assert.EqualError(t, err, "x must be finite")
+	_, err = New(Linear, []float64{1, 2}, []float64{1, math.Inf(1)}, Options{})
+	assert.EqualError(t, err, "y must be finite")
+	_, err = New(ClampedSpline, []float64{1, 2}, []float64{1, 2}, Options{StartSlope: math.NaN()})
+	assert.EqualError(t, err, "the end slopes must be finite")
+	_, err = New(Bilinear, []float64{1, 2}, []float64{1, 2}, Options{})
+	assert.EqualError(t, err, `unknown method "bilinear"`)
+	x := make([]float64, MaxPolynomialPoints+1)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	_, err = New(Polynomial, x, x, Options{})
+	assert.EqualError(t, err, "polynomial interpolation takes at most 100 points; use a spline for more")
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package interp
+
+import "math"
+
+// hermite evaluates at t the cubic through (x0, y0) and (x1, y1) with slopes
+// d0 and d1 there. It adds the rise y1 − y0 to the nearer end value, so
+// that the ends are matched exactly and a flat piece stays flat.
+func hermite(x0, x1, y0, y1, d0, d1, t float64) float64 {
+	h := x1 - x0
+	s := (t - x0) / h
+	r := 1 - s
+	slopes := h * s * r * (r*d0 - s*d1)
+	if s < 0.5 {
+		return y0 + (y1-y0)*s*s*(3-2*s) + slopes
+	}
+	return y1 - (y1-y0)*r*r*(3-2*r) + slopes
+}
+
+// differences returns the widths h[i] = x[i+1] − x[i] of the intervals and
+// the slopes delta[i] of the chords across them.
+func differences(x, y []float64) (h, delta []float64) {
+	h = make([]float64, len(x)-1)
+	delta = make([]float64, len(x)-1)
+	for i := range h {
+		h[i] = x[i+1] - x[i]
+		delta[i] = (y[i+1] - y[i]) / h[i]
+	}
+	return h, delta
+}
+
+// splineSlopes returns the slopes at the points of the cubic spline through
+// them, whose second derivatives vanish at the ends or, when clamped, whose
+// first derivatives there are start and end. The second derivatives m solve
+// the tridiagonal system that makes the first derivative continuous.
+func splineSlopes(x, y []float64, clamped bool, start, end float64) []float64 {
+	n := len(x)
+	h, delta := differences(x, y)
+	sub := make([]float64, n)
+	diag := make([]float64, n)
+	sup := make([]float64, n)
+	rhs := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		sub[i], diag[i], sup[i] = h[i-1], 2*(h[i-1]+h[i]), h[i]
+		rhs[i] = 6 * (delta[i] - delta[i-1])
+	}
+	if clamped {
+		diag[0], sup[0] = 2*h[0], h[0]
+		rhs[0] = 6 * (delta[0] - start)
+		sub[n-1], diag[n-1] = h[n-2], 2*h[n-2]
+		rhs[n-1] = 6 * (end - delta[n-2])
+	} else {
+		diag[0], diag[n-1] = 1, 1
+	}
+	m := solveTridiagonal(sub, diag, sup, rhs)
+
+	d := make([]float64, n)
+	for i := range n - 1 {
+		d[i] = delta[i] - h[i]*(2*m[i]+m[i+1])/6
+	}
+	d[n-1] = delta[n-2] + h[n-2]*(m[n-2]+2*m[n-1])/6
+	return d
+}
+
+// solveTridiagonal solves the system with subdiagonal sub, diagonal diag
+// and superdiagonal sup by the Thomas algorithm, which is stable for the
+// diagonally dominant systems of splines. It overwrites diag and rhs.
+func solveTridiagonal(sub, diag, sup, rhs []float64) []float64 {
+	n := len(diag)
+	for i := 1; i < n; i++ {
+		f := sub[i] / diag[i-1]
+		diag[i] -= f * sup[i-1]
+		rhs[i] -= f * rhs[i-1]
+	}
+	x := make([]float64, n)
+	x[n-1] = rhs[n-1] / diag[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = (rhs[i] - sup[i]*x[i+1]) / diag[i]
+	}
+	return x
+}
+
+// pchipSlopes returns the slopes at the points of the monotone cubic of
+// Fritsch and Carlson: zero where the chords on either side differ in sign,
+// a weighted harmonic mean of the chord slopes elsewhere, and at the ends a
+// three-point estimate limited to keep the end pieces monotone.
+func pchipSlopes(x, y []float64) []float64 {
+	n := len(x)
+	h, delta := differences(x, y)
+	d := make([]float64, n)
+	if n == 2 {
+		d[0], d[1] = delta[0], delta[0]
+		return d
+	}
+	for k := 1; k < n-1; k++ {
+		if sign(delta[k-1]) != sign(delta[k]) || delta[k-1] == 0 || delta[k] == 0 {
+			continue
+		}
+		w1, w2 := 2*h[k]+h[k-1], h[k]+2*h[k-1]
+		d[k] = (w1 + w2) / (w1/delta[k-1] + w2/delta[k])
+	}
+	d[0] = pchipEnd(h[0], h[1], delta[0], delta[1])
+	d[n-1] = pchipEnd(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	return d
+}
+
+// pchipEnd returns the slope at an end whose interval has width h0 and chord
+// slope m0, next to an interval of width h1 and chord slope m1.
+func pchipEnd(h0, h1, m0, m1 float64) float64 {
+	d := ((2*h0+h1)*m0 - h0*m1) / (h0 + h1)
+	switch {
+	case sign(d) != sign(m0):
+		return 0
+	case sign(m0) != sign(m1) && math.Abs(d) > 3*math.Abs(m0):
+		return 3 * m0
+	}
+	return d
+}
+
+// sign returns −1, 0 or 1 as v is negative, zero or positive.
+func sign(v float64) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
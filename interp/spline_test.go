@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNaturalSpline(t *testing.T) {
+	// Through (0, 0), (1, 1), (2, 0) the middle second derivative is −3,
+	// so S(0.5) = −3/48 + 3/4
+	p, err := New(NaturalSpline, []float64{0, 1, 2}, []float64{0, 1, 0}, Options{})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6875, p.At(0.5), 1e-15)
+	assert.InDelta(t, 0.6875, p.At(1.5), 1e-15)
+	assert.Equal(t, 1.0, p.At(1))
+
+	// A straight line is reproduced, and the second derivative vanishes at
+	// the ends of a curved one
+	x := []float64{0, 0.3, 1, 1.8, 2.5, 4}
+	line := make([]float64, len(x))
+	curve := make([]float64, len(x))
+	for i, v := range x {
+		line[i] = 3 - 2*v
+		curve[i] = math.Sin(v)
+	}
+	p, err = New(NaturalSpline, x, line, Options{})
+	require.NoError(t, err)
+	for _, v := range []float64{-1, 0.7, 2, 3.3, 5} {
+		assert.InDelta(t, 3-2*v, p.At(v), 1e-13)
+	}
+	p, err = New(NaturalSpline, x, curve, Options{})
+	require.NoError(t, err)
+	const h = 1e-4
+	for _, end := range []float64{0, 4} {
+		second := (p.At(end+h) - 2*p.At(end) + p.At(end-h)) / (h * h)
+		assert.InDelta(t, 0, second, 1e-5)
+	}
+}
+
+func TestClampedSpline(t *testing.T) {
+	// A cubic is reproduced given its slopes at the ends
+	f := func(x float64) float64 { return 1 + x - 2*x*x + 0.3*x*x*x }
+	df := func(x float64) float64 { return 1 - 4*x + 0.9*x*x }
+	x := []float64{-1, 0, 0.5, 2, 3.5}
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = f(v)
+	}
+	p, err := New(ClampedSpline, x, y, Options{StartSlope: df(-1), EndSlope: df(3.5)})
+	require.NoError(t, err)
+	for _, v := range []float64{-2, -0.5, 0.25, 1, 3, 4} {
+		assert.InDelta(t, f(v), p.At(v), 1e-12, "x = %g", v)
+	}
+
+	// Two points give the Hermite cubic, here x(1 − x)(1 − 2x)
+	p, err = New(ClampedSpline, []float64{0, 1}, []float64{0, 0}, Options{StartSlope: 1, EndSlope: 1})
+	require.NoError(t, err)
+	for _, v := range []float64{0.25, 0.5, 0.8} {
+		assert.InDelta(t, v*(1-v)*(1-2*v), p.At(v), 1e-15)
+	}
+}
+
+func TestPCHIP(t *testing.T) {
+	// The slopes are zero beside the plateau and 3/2 at the ends
+	p, err := New(PCHIP, []float64{0, 1, 2, 3}, []float64{0, 1, 1, 2}, Options{})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6875, p.At(0.5), 1e-15)
+	assert.InDelta(t, 1.3125, p.At(2.5), 1e-15)
+	for v := 1.0; v <= 2; v += 0.125 {
+		assert.Equal(t, 1.0, p.At(v))
+	}
+
+	// Monotone data give a monotone curve without overshoot, unlike a
+	// spline
+	x := []float64{0, 1, 2, 3, 4, 5}
+	y := []float64{0, 0, 0, 1, 1, 1}
+	p, err = New(PCHIP, x, y, Options{})
+	require.NoError(t, err)
+	spline, err := New(NaturalSpline, x, y, Options{})
+	require.NoError(t, err)
+	prev := p.At(0)
+	var overshoot float64
+	for v := 0.0; v <= 5; v += 0.01 {
+		got := p.At(v)
+		assert.GreaterOrEqual(t, got, prev-1e-15)
+		assert.GreaterOrEqual(t, got, 0.0)
+		assert.LessOrEqual(t, got, 1.0)
+		prev = got
+		overshoot = math.Max(overshoot, spline.At(v)-1)
+	}
+	assert.Greater(t, overshoot, 0.01)
+
+	// Two points give a straight line
+	p, err = New(PCHIP, []float64{1, 3}, []float64{2, 6}, Options{})
+	require.NoError(t, err)
+	assert.InDelta(t, 4, p.At(2), 1e-15)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNotSymmetric is returned by operations that require a symmetric matrix.
+	ErrNotSymmetric = errors.New("matrix must be symmetric")
+	// ErrNotPositiveDefinite is returned when a Cholesky factorization fails.
+	ErrNotPositiveDefinite = errors.New("matrix is not positive definite")
+)
+
+// IsSymmetric reports whether m is square and equal to its transpose up to
+// a relative tolerance of n·ε·max|mᵢⱼ|.
+func (m *Matrix) IsSymmetric() bool {
+	if !m.IsSquare() {
+		return false
+	}
+	tol := float64(m.Rows) * epsilon * m.MaxAbs()
+	for i := range m.Rows {
+		for j := range i {
+			if math.Abs(m.At(i, j)-m.At(j, i)) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// NewCholesky returns the lower triangular L with A = LLᵀ for a symmetric
+// positive definite matrix a.
+func NewCholesky(a *Matrix) (*Matrix, error) {
+	if !a.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	if !a.IsSymmetric() {
+		return nil, ErrNotSymmetric
+	}
+	n := a.Rows
+	l := New(n, n)
+	for j := range n {
+		d := a.At(j, j)
+		for k := range j {
+			d -= l.At(j, k) * l.At(j, k)
+		}
+		if !(d > 0) {
+			return nil, ErrNotPositiveDefinite
+		}
+		ljj := math.Sqrt(d)
+		l.Set(j, j, ljj)
+		for i := j + 1; i < n; i++ {
+			s := a.At(i, j)
+			for k := range j {
+				s -= l.At(i, k) * l.At(j, k)
+			}
+			l.Set(i, j, s/ljj)
+		}
+	}
+	return l, nil
+}
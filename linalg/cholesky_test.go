@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCholesky(t *testing.T) {
+	a := mustRows(t, [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}})
+
+	l, err := NewCholesky(a)
+	require.NoError(t, err)
+	assertMatrixInDelta(t, [][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}, l, 1e-14)
+
+	llt, _ := Mul(l, l.T())
+	assertMatrixInDelta(t, a.ToRows(), llt, 1e-12)
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+		err  error
+	}{
+		{"not square", [][]float64{{1, 2}}, ErrNotSquare},
+		{"not symmetric", [][]float64{{1, 2}, {3, 4}}, ErrNotSymmetric},
+		{"indefinite", [][]float64{{1, 2}, {2, 1}}, ErrNotPositiveDefinite},
+		{"semidefinite", [][]float64{{1, 1}, {1, 1}}, ErrNotPositiveDefinite},
+		{"negative", [][]float64{{-1}}, ErrNotPositiveDefinite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCholesky(mustRows(t, tt.rows))
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestIsSymmetric(t *testing.T) {
+	assert.True(t, mustRows(t, [][]float64{{1, 2}, {2, 1}}).IsSymmetric())
+	assert.True(t, mustRows(t, [][]float64{{1, 0.1 + 0.2}, {0.3, 1}}).IsSymmetric())
+	assert.False(t, mustRows(t, [][]float64{{1, 2}, {2.001, 1}}).IsSymmetric())
+	assert.False(t, mustRows(t, [][]float64{{1, 2}}).IsSymmetric())
+}
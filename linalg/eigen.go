@@ -0,0 +1,704 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// maxQRIterations bounds the QR iterations spent on one eigenvalue.
+const maxQRIterations = 100
+
+// ErrNoConvergence is returned when an iterative algorithm fails to converge.
+var ErrNoConvergence = errors.New("iteration did not converge")
+
+// Eigen is the eigendecomposition of a square matrix.
+type Eigen struct {
+	// Values are the eigenvalues, repeated by algebraic multiplicity and
+	// sorted by decreasing real part, then decreasing imaginary part.
+	Values []complex128
+	// Vectors[k] is a unit eigenvector for Values[k], scaled so that its
+	// largest component is real and positive. Defective matrices have fewer
+	// independent eigenvectors than eigenvalues, so some may coincide.
+	Vectors [][]complex128
+	// Defective reports whether the vectors are numerically linearly
+	// dependent, so that they do not form a basis. This happens for
+	// defective matrices such as [[1, 1], [0, 1]], and for matrices close
+	// to one.
+	Defective bool
+	// Symmetric reports whether the symmetric algorithm was used, in which
+	// case the values are real and the vectors real and orthonormal.
+	Symmetric bool
+}
+
+// NewEigen computes the eigenvalues and eigenvectors of a square matrix a.
+// Symmetric matrices use the cyclic Jacobi method; others are reduced to
+// Hessenberg form and solved with the shifted double QR algorithm. The
+// matrix is first scaled by a power of two so that its largest entry is
+// about 1, keeping norms and rotations from overflowing; eigenvalues too
+// large for a float64 come out as infinities when it is scaled back.
+func NewEigen(a *Matrix) (*Eigen, error) {
+	if !a.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	_, exp := math.Frexp(a.MaxAbs())
+	if exp != 0 {
+		a = a.Clone()
+		for i, x := range a.Data {
+			a.Data[i] = math.Ldexp(x, -exp)
+		}
+	}
+	var (
+		e   *Eigen
+		err error
+	)
+	if a.IsSymmetric() {
+		e = symmetricEigen(a)
+	} else if e, err = generalEigen(a); err != nil {
+		return nil, err
+	}
+	for k, v := range e.Values {
+		e.Values[k] = complex(math.Ldexp(real(v), exp), math.Ldexp(imag(v), exp))
+	}
+	for _, v := range e.Vectors {
+		normalizeVector(v)
+	}
+	if !e.Symmetric {
+		e.Defective = dependent(e.Vectors)
+	}
+	sort.Stable(byValue{e})
+	return e, nil
+}
+
+// dependent reports whether the unit vectors vs are linearly dependent to
+// within √ε. The rank of a complex matrix is half that of its real form
+// [[Re, −Im], [Im, Re]].
+func dependent(vs [][]complex128) bool {
+	n := len(vs)
+	m := New(2*n, 2*n)
+	for k, v := range vs {
+		for i, c := range v {
+			m.Set(i, k, real(c))
+			m.Set(i, n+k, -imag(c))
+			m.Set(n+i, k, imag(c))
+			m.Set(n+i, n+k, real(c))
+		}
+	}
+	svd := NewSVD(m)
+	return svd.Rank(math.Sqrt(epsilon)*svd.S[0]) < 2*n
+}
+
+// byValue sorts an Eigen by decreasing eigenvalue.
+type byValue struct{ e *Eigen }
+
+func (s byValue) Len() int { return len(s.e.Values) }
+
+func (s byValue) Less(i, j int) bool {
+	a, b := s.e.Values[i], s.e.Values[j]
+	if real(a) != real(b) {
+		return real(a) > real(b)
+	}
+	return imag(a) > imag(b)
+}
+
+func (s byValue) Swap(i, j int) {
+	s.e.Values[i], s.e.Values[j] = s.e.Values[j], s.e.Values[i]
+	s.e.Vectors[i], s.e.Vectors[j] = s.e.Vectors[j], s.e.Vectors[i]
+}
+
+// normalizeVector scales v to unit length with its largest component real
+// and positive.
+func normalizeVector(v []complex128) {
+	var norm, largest float64
+	pivot := 0
+	for i, x := range v {
+		abs := cmplx.Abs(x)
+		norm = math.Hypot(norm, abs)
+		if abs > largest {
+			largest, pivot = abs, i
+		}
+	}
+	if norm == 0 {
+		return
+	}
+	scale := cmplx.Conj(v[pivot]) / complex(largest*norm, 0)
+	for i := range v {
+		v[i] *= scale
+	}
+	v[pivot] = complex(real(v[pivot]), 0)
+}
+
+// symmetricEigen diagonalizes a symmetric matrix by cyclic Jacobi rotations.
+func symmetricEigen(a *Matrix) *Eigen {
+	n := a.Rows
+	d := a.Clone()
+	v := Identity(n)
+	for range maxJacobiSweeps {
+		var off float64
+		for i := range n {
+			for j := range i {
+				off = math.Hypot(off, d.At(i, j))
+			}
+		}
+		if off == 0 || off <= epsilon*d.NormFrobenius()/float64(n) {
+			break
+		}
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				apq := d.At(p, q)
+				if apq == 0 {
+					continue
+				}
+				// Choose the rotation that zeroes d[p][q]
+				theta := (d.At(q, q) - d.At(p, p)) / (2 * apq)
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Hypot(1, theta))
+				c := 1 / math.Hypot(1, t)
+				s := t * c
+				rotateColumns(d, p, q, c, s)
+				rotateRows(d, p, q, c, s)
+				rotateColumns(v, p, q, c, s)
+				d.Set(p, q, 0)
+				d.Set(q, p, 0)
+			}
+		}
+	}
+
+	e := &Eigen{Values: make([]complex128, n), Vectors: make([][]complex128, n), Symmetric: true}
+	for k := range n {
+		e.Values[k] = complex(d.At(k, k), 0)
+		e.Vectors[k] = make([]complex128, n)
+		for i := range n {
+			e.Vectors[k][i] = complex(v.At(i, k), 0)
+		}
+	}
+	return e
+}
+
+// rotateRows applies the plane rotation (c, s) to rows p and q of m, the
+// transpose of rotateColumns.
+func rotateRows(m *Matrix, p, q int, c, s float64) {
+	for j := range m.Cols {
+		mp, mq := m.At(p, j), m.At(q, j)
+		m.Set(p, j, c*mp-s*mq)
+		m.Set(q, j, s*mp+c*mq)
+	}
+}
+
+// generalEigen computes the eigendecomposition of a general real matrix,
+// following the EISPACK routines orthes and hqr2 as adapted by JAMA.
+func generalEigen(a *Matrix) (*Eigen, error) {
+	n := a.Rows
+	h := a.ToRows()
+	v := hessenberg(h)
+	d := make([]float64, n)
+	e := make([]float64, n)
+	if err := hqr2(h, v, d, e); err != nil {
+		return nil, err
+	}
+
+	// A real eigenvalue's vector is a column of v. A complex pair
+	// d[k] ± i·e[k] with e[k] > 0 stores the real and imaginary parts of
+	// the first vector in columns k and k+1; the second is its conjugate.
+	eig := &Eigen{Values: make([]complex128, n), Vectors: make([][]complex128, n)}
+	for k := 0; k < n; k++ {
+		eig.Values[k] = complex(d[k], e[k])
+		eig.Vectors[k] = make([]complex128, n)
+		if e[k] == 0 {
+			for i := range n {
+				eig.Vectors[k][i] = complex(v[i][k], 0)
+			}
+			continue
+		}
+		eig.Values[k+1] = complex(d[k+1], e[k+1])
+		eig.Vectors[k+1] = make([]complex128, n)
+		for i := range n {
+			eig.Vectors[k][i] = complex(v[i][k], v[i][k+1])
+			eig.Vectors[k+1][i] = complex(v[i][k], -v[i][k+1])
+		}
+		k++
+	}
+	return eig, nil
+}
+
+// hessenberg reduces h to upper Hessenberg form in place by Householder
+// similarity transformations and returns the accumulated transformation.
+func hessenberg(h [][]float64) [][]float64 {
+	n := len(h)
+	high := n - 1
+	ort := make([]float64, n)
+
+	for m := 1; m < high; m++ {
+		var scale float64
+		for i := m; i <= high; i++ {
+			scale += math.Abs(h[i][m-1])
+		}
+		if scale == 0 {
+			continue
+		}
+
+		// Householder vector for column m-1 below the subdiagonal
+		var sum float64
+		for i := high; i >= m; i-- {
+			ort[i] = h[i][m-1] / scale
+			sum += ort[i] * ort[i]
+		}
+		g := math.Sqrt(sum)
+		if ort[m] > 0 {
+			g = -g
+		}
+		sum -= ort[m] * g
+		ort[m] -= g
+
+		// H ← (I − uuᵀ/sum)·H·(I − uuᵀ/sum)
+		for j := m; j < n; j++ {
+			var f float64
+			for i := high; i >= m; i-- {
+				f += ort[i] * h[i][j]
+			}
+			f /= sum
+			for i := m; i <= high; i++ {
+				h[i][j] -= f * ort[i]
+			}
+		}
+		for i := 0; i <= high; i++ {
+			var f float64
+			for j := high; j >= m; j-- {
+				f += ort[j] * h[i][j]
+			}
+			f /= sum
+			for j := m; j <= high; j++ {
+				h[i][j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		h[m][m-1] = scale * g
+	}
+
+	// Accumulate the transformations
+	v := Identity(n).ToRows()
+	for m := high - 1; m >= 1; m-- {
+		if h[m][m-1] == 0 {
+			continue
+		}
+		for i := m + 1; i <= high; i++ {
+			ort[i] = h[i][m-1]
+		}
+		for j := m; j <= high; j++ {
+			var g float64
+			for i := m; i <= high; i++ {
+				g += ort[i] * v[i][j]
+			}
+			// Double division avoids possible underflow
+			g = (g / ort[m]) / h[m][m-1]
+			for i := m; i <= high; i++ {
+				v[i][j] += g * ort[i]
+			}
+		}
+	}
+	return v
+}
+
+// hqr2 finds the eigenvalues d + i·e of the Hessenberg matrix h by the
+// shifted double QR algorithm, then back-substitutes for the eigenvectors,
+// which overwrite v (see generalEigen for the layout). h is destroyed.
+func hqr2(h, v [][]float64, d, e []float64) error {
+	nn := len(h)
+	n := nn - 1
+	var exshift, p, q, r, s, z, t, w, x, y float64
+
+	var norm float64
+	for i := range nn {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(h[i][j])
+		}
+	}
+
+	iter := 0
+	for n >= 0 {
+		// Look for a single small subdiagonal element
+		l := n
+		for l > 0 {
+			s = math.Abs(h[l-1][l-1]) + math.Abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if math.Abs(h[l][l-1]) < epsilon*s {
+				break
+			}
+			l--
+		}
+
+		switch {
+		case l == n:
+			// One root found
+			h[n][n] += exshift
+			d[n] = h[n][n]
+			e[n] = 0
+			n--
+			iter = 0
+
+		case l == n-1:
+			// Two roots found
+			w = h[n][n-1] * h[n-1][n]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			h[n][n] += exshift
+			h[n-1][n-1] += exshift
+			x = h[n][n]
+
+			if q >= 0 {
+				// Real pair
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0
+				e[n] = 0
+				x = h[n][n-1]
+				s = math.Abs(x) + math.Abs(z)
+				p = x / s
+				q = z / s
+				r = math.Sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				for j := n - 1; j < nn; j++ {
+					z = h[n-1][j]
+					h[n-1][j] = q*z + p*h[n][j]
+					h[n][j] = q*h[n][j] - p*z
+				}
+				for i := 0; i <= n; i++ {
+					z = h[i][n-1]
+					h[i][n-1] = q*z + p*h[i][n]
+					h[i][n] = q*h[i][n] - p*z
+				}
+				for i := range nn {
+					z = v[i][n-1]
+					v[i][n-1] = q*z + p*v[i][n]
+					v[i][n] = q*v[i][n] - p*z
+				}
+			} else {
+				// Complex pair
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+
+		default:
+			// Form the shift
+			x = h[n][n]
+			y = 0
+			w = 0
+			if l < n {
+				y = h[n-1][n-1]
+				w = h[n][n-1] * h[n-1][n]
+			}
+
+			// Wilkinson's original ad hoc shift
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					h[i][i] -= x
+				}
+				s = math.Abs(h[n][n-1]) + math.Abs(h[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						h[i][i] -= s
+					}
+					exshift += s
+					x, y, w = 0.964, 0.964, 0.964
+				}
+			}
+
+			iter++
+			if iter > maxQRIterations {
+				return ErrNoConvergence
+			}
+
+			// Look for two consecutive small subdiagonal elements
+			m := n - 2
+			for m >= l {
+				z = h[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/h[m+1][m] + h[m][m+1]
+				q = h[m+1][m+1] - z - r - s
+				r = h[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(h[m][m-1])*(math.Abs(q)+math.Abs(r)) <
+					epsilon*(math.Abs(p)*(math.Abs(h[m-1][m-1])+math.Abs(z)+math.Abs(h[m+1][m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h[i][i-2] = 0
+				if i > m+2 {
+					h[i][i-3] = 0
+				}
+			}
+
+			// Double QR step on rows l..n and columns m..n
+			for k := m; k <= n-1; k++ {
+				notlast := k != n-1
+				if k != m {
+					p = h[k][k-1]
+					q = h[k+1][k-1]
+					r = 0
+					if notlast {
+						r = h[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+				if k != m {
+					h[k][k-1] = -s * x
+				} else if l != m {
+					h[k][k-1] = -h[k][k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				for j := k; j < nn; j++ {
+					p = h[k][j] + q*h[k+1][j]
+					if notlast {
+						p += r * h[k+2][j]
+						h[k+2][j] -= p * z
+					}
+					h[k][j] -= p * x
+					h[k+1][j] -= p * y
+				}
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*h[i][k] + y*h[i][k+1]
+					if notlast {
+						p += z * h[i][k+2]
+						h[i][k+2] -= p * r
+					}
+					h[i][k] -= p
+					h[i][k+1] -= p * q
+				}
+				for i := range nn {
+					p = x*v[i][k] + y*v[i][k+1]
+					if notlast {
+						p += z * v[i][k+2]
+						v[i][k+2] -= p * r
+					}
+					v[i][k] -= p
+					v[i][k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	if norm == 0 {
+		return nil
+	}
+
+	// Back-substitute for the eigenvectors of the quasi-triangular form
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		switch {
+		case q == 0:
+			// Real vector
+			l := n
+			h[n][n] = 1
+			for i := n - 1; i >= 0; i-- {
+				w = h[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += h[i][j] * h[j][n]
+				}
+				if e[i] < 0 {
+					z = w
+					s = r
+					continue
+				}
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						h[i][n] = -r / w
+					} else {
+						h[i][n] = -r / (epsilon * norm)
+					}
+				} else {
+					// Solve the real 2×2 system
+					x = h[i][i+1]
+					y = h[i+1][i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					h[i][n] = t
+					if math.Abs(x) > math.Abs(z) {
+						h[i+1][n] = (-r - w*t) / x
+					} else {
+						h[i+1][n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control
+				t = math.Abs(h[i][n])
+				if (epsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n] /= t
+					}
+				}
+			}
+
+		case q < 0:
+			// Complex vector, stored in columns n-1 and n
+			l := n - 1
+			if math.Abs(h[n][n-1]) > math.Abs(h[n-1][n]) {
+				h[n-1][n-1] = q / h[n][n-1]
+				h[n-1][n] = -(h[n][n] - p) / h[n][n-1]
+			} else {
+				c := complex(0, -h[n-1][n]) / complex(h[n-1][n-1]-p, q)
+				h[n-1][n-1] = real(c)
+				h[n-1][n] = imag(c)
+			}
+			h[n][n-1] = 0
+			h[n][n] = 1
+			for i := n - 2; i >= 0; i-- {
+				var ra, sa float64
+				for j := l; j <= n; j++ {
+					ra += h[i][j] * h[j][n-1]
+					sa += h[i][j] * h[j][n]
+				}
+				w = h[i][i] - p
+
+				if e[i] < 0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+				} else {
+					// Solve the complex 2×2 system
+					x = h[i][i+1]
+					y = h[i+1][i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = epsilon * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(z))
+					}
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+					if math.Abs(x) > math.Abs(z)+math.Abs(q) {
+						h[i+1][n-1] = (-ra - w*h[i][n-1] + q*h[i][n]) / x
+						h[i+1][n] = (-sa - w*h[i][n] - q*h[i][n-1]) / x
+					} else {
+						c := complex(-r-y*h[i][n-1], -s-y*h[i][n]) / complex(z, q)
+						h[i+1][n-1] = real(c)
+						h[i+1][n] = imag(c)
+					}
+				}
+
+				// Overflow control
+				t = math.Max(math.Abs(h[i][n-1]), math.Abs(h[i][n]))
+				if (epsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n-1] /= t
+						h[j][n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back-transform to the eigenvectors of the original matrix
+	for j := nn - 1; j >= 0; j-- {
+		for i := range nn {
+			z = 0
+			for k := 0; k <= j; k++ {
+				z += v[i][k] * h[k][j]
+			}
+			v[i][j] = z
+		}
+	}
+	return nil
+}
+
+// CharPoly returns the coefficients of the characteristic polynomial
+// det(λI − A) of a square matrix, highest degree first, computed by the
+// Faddeev–LeVerrier algorithm. The coefficients are exact for small integer
+// matrices.
+func (m *Matrix) CharPoly() ([]float64, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	n := m.Rows
+	coef := make([]float64, n+1)
+	coef[0] = 1
+	// M₀ = 0, Mₖ = A·Mₖ₋₁ + cₖ₋₁·I, cₖ = −tr(A·Mₖ)/k
+	mk := New(n, n)
+	for k := 1; k <= n; k++ {
+		for i := range n {
+			mk.Set(i, i, mk.At(i, i)+coef[k-1])
+		}
+		am, _ := Mul(m, mk)
+		tr, _ := am.Trace()
+		coef[k] = -tr / float64(k)
+		mk = am
+	}
+	return coef, nil
+}
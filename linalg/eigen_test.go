@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertEigenpairs checks Av = λv and ‖v‖ = 1 for every pair.
+func assertEigenpairs(t *testing.T, a *Matrix, e *Eigen, tol float64) {
+	t.Helper()
+	n := a.Rows
+	require.Len(t, e.Values, n)
+	require.Len(t, e.Vectors, n)
+	for k, lambda := range e.Values {
+		v := e.Vectors[k]
+		var norm float64
+		for i := range n {
+			var av complex128
+			for j := range n {
+				av += complex(a.At(i, j), 0) * v[j]
+			}
+			assert.InDelta(t, 0, cmplx.Abs(av-lambda*v[i]), tol, "λ = %v, row %d", lambda, i)
+			norm = math.Hypot(norm, cmplx.Abs(v[i]))
+		}
+		assert.InDelta(t, 1, norm, 1e-14)
+	}
+}
+
+func TestEigenSymmetric(t *testing.T) {
+	a := mustRows(t, [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}})
+	e, err := NewEigen(a)
+	require.NoError(t, err)
+
+	assert.True(t, e.Symmetric)
+	s := math.Sqrt2
+	want := []complex128{complex(2+s, 0), 2, complex(2-s, 0)}
+	for k := range want {
+		assert.InDelta(t, real(want[k]), real(e.Values[k]), 1e-14)
+		assert.Zero(t, imag(e.Values[k]))
+	}
+	assertEigenpairs(t, a, e, 1e-14)
+
+	// Orthogonal eigenvectors
+	for i := range 3 {
+		for j := range i {
+			var dot complex128
+			for k := range 3 {
+				dot += e.Vectors[i][k] * e.Vectors[j][k]
+			}
+			assert.InDelta(t, 0, cmplx.Abs(dot), 1e-14)
+		}
+	}
+}
+
+func TestEigenGeneral(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      [][]float64
+		values    []complex128
+		defective bool
+	}{
+		{"triangular", [][]float64{{1, 2, 3}, {0, 4, 5}, {0, 0, 6}}, []complex128{6, 4, 1}, false},
+		{"rotation", [][]float64{{0, -1}, {1, 0}}, []complex128{1i, -1i}, false},
+		{"markov", [][]float64{{0.9, 0.5}, {0.1, 0.5}}, []complex128{1, 0.4}, false},
+		{"complex pair and real", [][]float64{{1, -2, 0}, {2, 1, 0}, {0, 0, 3}}, []complex128{3, 1 + 2i, 1 - 2i}, false},
+		{"companion", [][]float64{{6, -11, 6}, {1, 0, 0}, {0, 1, 0}}, []complex128{3, 2, 1}, false},
+		{"repeated", [][]float64{{2, 0, 0}, {0, 2, 1}, {0, 0, 3}}, []complex128{3, 2, 2}, false},
+		{"defective", [][]float64{{1, 1}, {0, 1}}, []complex128{1, 1}, true},
+		{"jordan block", [][]float64{{2, 1, 0}, {0, 2, 1}, {0, 0, 2}}, []complex128{2, 2, 2}, true},
+		{"defective complex pair", [][]float64{{0, -1, 1, 0}, {1, 0, 0, 1}, {0, 0, 0, -1}, {0, 0, 1, 0}}, []complex128{1i, 1i, -1i, -1i}, true},
+		{"1x1", [][]float64{{-7}}, []complex128{-7}, false},
+		{"zero", [][]float64{{0, 0}, {0, 0}}, []complex128{0, 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustRows(t, tt.rows)
+			e, err := NewEigen(a)
+			require.NoError(t, err)
+
+			for k := range tt.values {
+				assert.InDelta(t, 0, cmplx.Abs(e.Values[k]-tt.values[k]), 1e-7, "λ%d = %v", k, e.Values[k])
+			}
+			assertEigenpairs(t, a, e, 1e-7)
+			assert.Equal(t, tt.defective, e.Defective)
+		})
+	}
+}
+
+func TestEigenVectorScaling(t *testing.T) {
+	// Steady state of a Markov chain: the λ = 1 vector is positive
+	e, err := NewEigen(mustRows(t, [][]float64{{0.9, 0.5}, {0.1, 0.5}}))
+	require.NoError(t, err)
+	v := e.Vectors[0]
+	assert.InDelta(t, 5, real(v[0])/real(v[1]), 1e-13)
+	assert.Positive(t, real(v[0]))
+	assert.Zero(t, imag(v[0]))
+}
+
+func TestEigenRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 2; n <= 8; n++ {
+		a := New(n, n)
+		for i := range a.Data {
+			a.Data[i] = rng.NormFloat64()
+		}
+		e, err := NewEigen(a)
+		require.NoError(t, err)
+		assertEigenpairs(t, a, e, 1e-11)
+
+		// The eigenvalues sum to the trace
+		var sum complex128
+		for _, v := range e.Values {
+			sum += v
+		}
+		trace, _ := a.Trace()
+		assert.InDelta(t, trace, real(sum), 1e-12)
+		assert.InDelta(t, 0, imag(sum), 1e-12)
+	}
+}
+
+func TestEigenScaling(t *testing.T) {
+	// Entries near the float64 limit no longer overflow the norms
+	e, err := NewEigen(mustRows(t, [][]float64{{1e308, 1e308}, {1e308, 1e308}}))
+	require.NoError(t, err)
+	assert.Equal(t, []complex128{complex(math.Inf(1), 0), 0}, e.Values)
+
+	e, err = NewEigen(mustRows(t, [][]float64{{1e308, -1e308}, {1e308, 1e308}}))
+	require.NoError(t, err)
+	assert.Equal(t, []complex128{complex(1e308, 1e308), complex(1e308, -1e308)}, e.Values)
+
+	e, err = NewEigen(mustRows(t, [][]float64{{1e-310, 0}, {0, 2e-310}}))
+	require.NoError(t, err)
+	assert.Equal(t, []complex128{2e-310, 1e-310}, e.Values)
+}
+
+func TestEigenErrors(t *testing.T) {
+	_, err := NewEigen(mustRows(t, [][]float64{{1, 2}}))
+	assert.ErrorIs(t, err, ErrNotSquare)
+}
+
+func TestCharPoly(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+		want []float64
+	}{
+		{"1x1", [][]float64{{3}}, []float64{1, -3}},
+		{"2x2", [][]float64{{1, 2}, {3, 4}}, []float64{1, -5, -2}},
+		{"companion", [][]float64{{6, -11, 6}, {1, 0, 0}, {0, 1, 0}}, []float64{1, -6, 11, -6}},
+		{"3x3", [][]float64{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, []float64{1, -7, 19, -49}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustRows(t, tt.rows).CharPoly()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
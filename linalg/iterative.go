@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"fmt"
+	"math"
+)
+
+// IterativeResult is the outcome of an iterative solve of Ax = b.
+type IterativeResult struct {
+	X          []float64
+	Iterations int
+	// Residual is the relative residual ‖b − Ax‖₂ / ‖b‖₂ of X.
+	Residual  float64
+	Converged bool
+}
+
+// checkSystem verifies that a is square and b matches its rows.
+func checkSystem(a *CSR, b []float64) error {
+	if a.Rows != a.Cols {
+		return ErrNotSquare
+	}
+	if len(b) != a.Rows {
+		return fmt.Errorf("right-hand side has %d entries but the matrix has %d rows", len(b), a.Rows)
+	}
+	return nil
+}
+
+// norm2 returns the Euclidean norm of v.
+func norm2(v []float64) float64 {
+	var norm float64
+	for _, x := range v {
+		norm = math.Hypot(norm, x)
+	}
+	return norm
+}
+
+// dot returns a·b.
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// finish computes the true relative residual of x and fills in the result.
+func finish(a *CSR, b, x []float64, iterations int, tol float64) *IterativeResult {
+	r := make([]float64, len(b))
+	a.mulVec(r, x)
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+	res := norm2(r) / norm2(b)
+	return &IterativeResult{X: x, Iterations: iterations, Residual: res, Converged: res <= tol}
+}
+
+// CG solves Ax = b for symmetric positive definite a by the conjugate
+// gradient method, starting from zero, until the relative residual is at most
+// tol or maxIter iterations have run. The curvature test uses the unit search
+// direction and the step is built from residual norms rather than their
+// squares, so tiny or huge entries are not mistaken for indefiniteness; a
+// solution too large for a float64 stops the iteration with infinite entries
+// in X.
+func CG(a *CSR, b []float64, tol float64, maxIter int) (*IterativeResult, error) {
+	if err := checkSystem(a, b); err != nil {
+		return nil, err
+	}
+	if !a.IsSymmetric() {
+		return nil, ErrNotSymmetric
+	}
+	n := a.Rows
+	x := make([]float64, n)
+	bnorm := norm2(b)
+	if bnorm == 0 {
+		return &IterativeResult{X: x, Converged: true}, nil
+	}
+
+	r := append([]float64(nil), b...)
+	p := append([]float64(nil), b...)
+	u := make([]float64, n)
+	au := make([]float64, n)
+	rnorm := bnorm
+	iter := 0
+	for iter < maxIter && rnorm/bnorm > tol {
+		iter++
+		pnorm := norm2(p)
+		for i := range p {
+			u[i] = p[i] / pnorm
+		}
+		a.mulVec(au, u)
+		curvature := dot(u, au)
+		if !(curvature > 0) {
+			return nil, ErrNotPositiveDefinite
+		}
+		// The step along u is α‖p‖ with α = ‖r‖²/pᵀAp
+		step := rnorm / pnorm * rnorm / curvature
+		if math.IsInf(step, 0) {
+			for i := range x {
+				if u[i] != 0 {
+					x[i] += step * u[i]
+				}
+			}
+			break
+		}
+		for i := range x {
+			x[i] += step * u[i]
+			r[i] -= step * au[i]
+		}
+		next := norm2(r)
+		beta := (next / rnorm) * (next / rnorm)
+		rnorm = next
+		for i := range p {
+			p[i] = r[i] + beta*p[i]
+		}
+	}
+	return finish(a, b, x, iter, tol), nil
+}
+
+// GMRES solves Ax = b by the restarted generalized minimal residual method,
+// starting from zero, until the relative residual is at most tol or maxIter
+// iterations have run. The Krylov basis is rebuilt every restart iterations.
+func GMRES(a *CSR, b []float64, tol float64, restart, maxIter int) (*IterativeResult, error) {
+	if err := checkSystem(a, b); err != nil {
+		return nil, err
+	}
+	n := a.Rows
+	restart = max(1, min(restart, n))
+	x := make([]float64, n)
+	bnorm := norm2(b)
+	if bnorm == 0 {
+		return &IterativeResult{X: x, Converged: true}, nil
+	}
+
+	// v holds the orthonormal Krylov basis, h the Hessenberg matrix reduced
+	// to triangular form by the Givens rotations (cs, sn), and g the rotated
+	// right-hand side, whose last entry is the residual norm.
+	v := make([][]float64, restart+1)
+	h := make([][]float64, restart+1)
+	for i := range h {
+		h[i] = make([]float64, restart)
+	}
+	cs := make([]float64, restart)
+	sn := make([]float64, restart)
+	g := make([]float64, restart+1)
+	r := make([]float64, n)
+
+	iter := 0
+	for {
+		a.mulVec(r, x)
+		for i := range r {
+			r[i] = b[i] - r[i]
+		}
+		beta := norm2(r)
+		if beta/bnorm <= tol || iter >= maxIter {
+			break
+		}
+
+		v[0] = make([]float64, n)
+		for i := range r {
+			v[0][i] = r[i] / beta
+		}
+		clear(g)
+		g[0] = beta
+
+		k := 0
+		for k < restart && iter < maxIter {
+			iter++
+			w := make([]float64, n)
+			a.mulVec(w, v[k])
+			// Modified Gram–Schmidt against the basis so far
+			for i := 0; i <= k; i++ {
+				h[i][k] = dot(w, v[i])
+				for l := range w {
+					w[l] -= h[i][k] * v[i][l]
+				}
+			}
+			wnorm := norm2(w)
+			h[k+1][k] = wnorm
+
+			for i := range k {
+				hi, hn := h[i][k], h[i+1][k]
+				h[i][k] = cs[i]*hi + sn[i]*hn
+				h[i+1][k] = -sn[i]*hi + cs[i]*hn
+			}
+			d := math.Hypot(h[k][k], h[k+1][k])
+			if d == 0 {
+				return nil, ErrSingular
+			}
+			cs[k], sn[k] = h[k][k]/d, h[k+1][k]/d
+			h[k][k], h[k+1][k] = d, 0
+			g[k+1] = -sn[k] * g[k]
+			g[k] *= cs[k]
+			k++
+
+			// A zero wnorm means the Krylov space is invariant and the
+			// solution is exact in it
+			if math.Abs(g[k])/bnorm <= tol || wnorm == 0 {
+				break
+			}
+			v[k] = make([]float64, n)
+			for l := range w {
+				v[k][l] = w[l] / wnorm
+			}
+		}
+
+		// Solve the k×k triangular system and update x
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for j := i + 1; j < k; j++ {
+				sum -= h[i][j] * y[j]
+			}
+			y[i] = sum / h[i][i]
+		}
+		for j := range k {
+			for l := range x {
+				x[l] += y[j] * v[j][l]
+			}
+		}
+	}
+	return finish(a, b, x, iter, tol), nil
+}
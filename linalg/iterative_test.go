@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// denseSolve solves the sparse system with dense LU as a reference.
+func denseSolve(t *testing.T, a *CSR, b []float64) []float64 {
+	t.Helper()
+	d := New(a.Rows, a.Cols)
+	for i := range a.Rows {
+		for k := a.RowPtr[i]; k < a.RowPtr[i+1]; k++ {
+			d.Set(i, a.ColIdx[k], a.Values[k])
+		}
+	}
+	x, err := NewLU(d).Solve(&Matrix{Rows: len(b), Cols: 1, Data: b})
+	require.NoError(t, err)
+	return x.Data
+}
+
+func ones(n int) []float64 {
+	b := make([]float64, n)
+	for i := range b {
+		b[i] = 1
+	}
+	return b
+}
+
+func TestCG(t *testing.T) {
+	// 1-D Poisson problem
+	a := tridiagonal(t, 200, -1, 2, -1)
+	b := ones(200)
+
+	res, err := CG(a, b, 1e-12, 1000)
+	require.NoError(t, err)
+	assert.True(t, res.Converged)
+	assert.LessOrEqual(t, res.Residual, 1e-12)
+	assert.LessOrEqual(t, res.Iterations, 200)
+	assert.InDeltaSlice(t, denseSolve(t, a, b), res.X, 1e-7)
+}
+
+func TestCGIterationLimit(t *testing.T) {
+	a := tridiagonal(t, 200, -1, 2, -1)
+
+	res, err := CG(a, ones(200), 1e-12, 5)
+	require.NoError(t, err)
+	assert.False(t, res.Converged)
+	assert.Equal(t, 5, res.Iterations)
+	assert.Greater(t, res.Residual, 1e-12)
+}
+
+func TestCGScaling(t *testing.T) {
+	diag := func(d ...float64) *CSR {
+		a, err := FromCOO(len(d), len(d), []int{0, 1}, []int{0, 1}, d)
+		require.NoError(t, err)
+		return a
+	}
+
+	// pᵀAp underflows for tiny b and overflows for huge b
+	for _, scale := range []float64{1e-170, 1e200} {
+		res, err := CG(diag(1, 2), []float64{scale, scale}, 1e-12, 10)
+		require.NoError(t, err)
+		assert.True(t, res.Converged)
+		assert.InEpsilonSlice(t, []float64{scale, scale / 2}, res.X, 1e-12)
+	}
+
+	// The solution 1e616 overflows rather than failing the curvature test
+	res, err := CG(diag(1e-308, 1), []float64{1e308, 1}, 1e-12, 10)
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(res.X[0], 1))
+	assert.False(t, res.Converged)
+}
+
+func TestCGErrors(t *testing.T) {
+	_, err := CG(tridiagonal(t, 3, -1, 2, -2), ones(3), 1e-10, 10)
+	assert.ErrorIs(t, err, ErrNotSymmetric)
+
+	_, err = CG(tridiagonal(t, 3, 0, -1, 0), ones(3), 1e-10, 10)
+	assert.ErrorIs(t, err, ErrNotPositiveDefinite)
+
+	_, err = CG(tridiagonal(t, 3, -1, 2, -1), ones(2), 1e-10, 10)
+	assert.EqualError(t, err, "right-hand side has 2 entries but the matrix has 3 rows")
+}
+
+func TestGMRES(t *testing.T) {
+	// Convection–diffusion: nonsymmetric
+	a := tridiagonal(t, 100, -1.4, 2, -0.6)
+	b := ones(100)
+	want := denseSolve(t, a, b)
+
+	for _, restart := range []int{100, 20} {
+		res, err := GMRES(a, b, 1e-12, restart, 2000)
+		require.NoError(t, err)
+		assert.True(t, res.Converged, "restart %d", restart)
+		assert.LessOrEqual(t, res.Residual, 1e-12)
+		assert.InDeltaSlice(t, want, res.X, 1e-8)
+	}
+
+	// Small systems converge in at most n iterations without restarts
+	res, err := GMRES(tridiagonal(t, 3, 1, 4, 2), []float64{1, 2, 3}, 1e-14, 30, 100)
+	require.NoError(t, err)
+	assert.True(t, res.Converged)
+	assert.LessOrEqual(t, res.Iterations, 3)
+}
+
+func TestGMRESZeroRightHandSide(t *testing.T) {
+	res, err := GMRES(tridiagonal(t, 3, 1, 4, 2), []float64{0, 0, 0}, 1e-10, 30, 100)
+	require.NoError(t, err)
+	assert.True(t, res.Converged)
+	assert.Equal(t, []float64{0, 0, 0}, res.X)
+	assert.Zero(t, res.Iterations)
+}
+
+func TestGMRESIterationLimit(t *testing.T) {
+	a := tridiagonal(t, 100, -1.4, 2, -0.6)
+
+	res, err := GMRES(a, ones(100), 1e-12, 10, 7)
+	require.NoError(t, err)
+	assert.False(t, res.Converged)
+	assert.Equal(t, 7, res.Iterations)
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"fmt"
+	"math"
+)
+
+// LU is the factorization PA = LU of a square matrix A with partial
+// pivoting, where L is unit lower triangular and U upper triangular.
+type LU struct {
+	// lu holds L below the diagonal and U on and above it.
+	lu *Matrix
+	// pivot[i] is the row of A that became row i.
+	pivot []int
+	// sign is the determinant of P.
+	sign float64
+	// norm is max|aᵢⱼ|, the scale for the singularity test.
+	norm float64
+	// norm1 is ‖A‖₁.
+	norm1 float64
+}
+
+// NewLU computes the LU factorization of a square matrix a.
+func NewLU(a *Matrix) *LU {
+	n := a.Rows
+	f := &LU{lu: a.Clone(), pivot: make([]int, n), sign: 1, norm: a.MaxAbs(), norm1: a.Norm1()}
+	for i := range f.pivot {
+		f.pivot[i] = i
+	}
+	m := f.lu
+	for k := range n {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(m.At(i, k)) > math.Abs(m.At(p, k)) {
+				p = i
+			}
+		}
+		if p != k {
+			m.swapRows(k, p)
+			f.pivot[k], f.pivot[p] = f.pivot[p], f.pivot[k]
+			f.sign = -f.sign
+		}
+		pivot := m.At(k, k)
+		if pivot == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			l := m.At(i, k) / pivot
+			m.Set(i, k, l)
+			for j := k + 1; j < n; j++ {
+				m.Set(i, j, m.At(i, j)-l*m.At(k, j))
+			}
+		}
+	}
+	return f
+}
+
+// L returns the unit lower triangular factor.
+func (f *LU) L() *Matrix {
+	n := f.lu.Rows
+	l := Identity(n)
+	for i := range n {
+		for j := range i {
+			l.Set(i, j, f.lu.At(i, j))
+		}
+	}
+	return l
+}
+
+// U returns the upper triangular factor.
+func (f *LU) U() *Matrix {
+	n := f.lu.Rows
+	u := New(n, n)
+	for i := range n {
+		for j := i; j < n; j++ {
+			u.Set(i, j, f.lu.At(i, j))
+		}
+	}
+	return u
+}
+
+// P returns the permutation matrix P of PA = LU.
+func (f *LU) P() *Matrix {
+	n := f.lu.Rows
+	p := New(n, n)
+	for i, row := range f.pivot {
+		p.Set(i, row, 1)
+	}
+	return p
+}
+
+// Det returns the determinant of the factorized matrix.
+func (f *LU) Det() float64 {
+	det := f.sign
+	for i := range f.lu.Rows {
+		det *= f.lu.At(i, i)
+	}
+	return det
+}
+
+// IsSingular reports whether a pivot vanishes to working precision, that is
+// |uᵢᵢ| ≤ n·ε·max|aᵢⱼ|.
+func (f *LU) IsSingular() bool {
+	tol := float64(f.lu.Rows) * epsilon * f.norm
+	for i := range f.lu.Rows {
+		if math.Abs(f.lu.At(i, i)) <= tol {
+			return true
+		}
+	}
+	return false
+}
+
+// Cond returns the condition number ‖A‖₁·‖A⁻¹‖₁ of the factorized matrix,
+// or +Inf if it is singular.
+func (f *LU) Cond() float64 {
+	inv, err := f.Solve(Identity(f.lu.Rows))
+	if err != nil {
+		return math.Inf(1)
+	}
+	return f.norm1 * inv.Norm1()
+}
+
+// Solve returns X with AX = B for the factorized A.
+func (f *LU) Solve(b *Matrix) (*Matrix, error) {
+	n := f.lu.Rows
+	if b.Rows != n {
+		return nil, fmt.Errorf("right-hand side has %d rows, expected %d", b.Rows, n)
+	}
+	if f.IsSingular() {
+		return nil, ErrSingular
+	}
+	x := New(n, b.Cols)
+	for i, p := range f.pivot {
+		copy(x.Data[i*x.Cols:(i+1)*x.Cols], b.Data[p*b.Cols:(p+1)*b.Cols])
+	}
+	m := f.lu
+	for c := range x.Cols {
+		// Forward substitution with the unit lower triangle
+		for i := range n {
+			sum := x.At(i, c)
+			for k := range i {
+				sum -= m.At(i, k) * x.At(k, c)
+			}
+			x.Set(i, c, sum)
+		}
+		// Back substitution with the upper triangle
+		for i := n - 1; i >= 0; i-- {
+			sum := x.At(i, c)
+			for k := i + 1; k < n; k++ {
+				sum -= m.At(i, k) * x.At(k, c)
+			}
+			x.Set(i, c, sum/m.At(i, i))
+		}
+	}
+	return x, nil
+}
@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package linalg implements the dense matrix algorithms behind the linear
+// algebra tools.
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// epsilon is the float64 machine epsilon.
+const epsilon = 0x1p-52
+
+var (
+	// ErrNotSquare is returned by operations defined only for square matrices.
+	ErrNotSquare = errors.New("matrix must be square")
+	// ErrSingular is returned when a matrix is singular to working precision.
+	ErrSingular = errors.New("matrix is singular")
+)
+
+// Matrix is a dense matrix stored in row-major order.
+type Matrix struct {
+	Rows, Cols int
+	Data       []float64
+}
+
+// New returns a rows×cols zero matrix.
+func New(rows, cols int) *Matrix {
+	return &Matrix{Rows: rows, Cols: cols, Data: make([]float64, rows*cols)}
+}
+
+// Identity returns the n×n identity matrix.
+func Identity(n int) *Matrix {
+	m := New(n, n)
+	for i := range n {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// FromRows builds a matrix from its rows, which must be non-empty and of
+// equal length.
+func FromRows(rows [][]float64) (*Matrix, error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, errors.New("matrix must have at least one row and one column")
+	}
+	m := New(len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.Cols {
+			return nil, fmt.Errorf("row %d has %d entries, expected %d", i, len(row), m.Cols)
+		}
+		copy(m.Data[i*m.Cols:], row)
+	}
+	return m, nil
+}
+
+// ToRows returns the rows of m.
+func (m *Matrix) ToRows() [][]float64 {
+	rows := make([][]float64, m.Rows)
+	for i := range rows {
+		rows[i] = append([]float64(nil), m.Data[i*m.Cols:(i+1)*m.Cols]...)
+	}
+	return rows
+}
+
+// Shape formats the dimensions of m as "rows×cols".
+func (m *Matrix) Shape() string {
+	return fmt.Sprintf("%d×%d", m.Rows, m.Cols)
+}
+
+// At returns the entry in row i and column j.
+func (m *Matrix) At(i, j int) float64 {
+	return m.Data[i*m.Cols+j]
+}
+
+// Set sets the entry in row i and column j.
+func (m *Matrix) Set(i, j int, v float64) {
+	m.Data[i*m.Cols+j] = v
+}
+
+// Clone returns a copy of m.
+func (m *Matrix) Clone() *Matrix {
+	return &Matrix{Rows: m.Rows, Cols: m.Cols, Data: append([]float64(nil), m.Data...)}
+}
+
+// IsSquare reports whether m has as many rows as columns.
+func (m *Matrix) IsSquare() bool {
+	return m.Rows == m.Cols
+}
+
+// MaxAbs returns the largest absolute value of the entries of m.
+func (m *Matrix) MaxAbs() float64 {
+	var largest float64
+	for _, v := range m.Data {
+		largest = math.Max(largest, math.Abs(v))
+	}
+	return largest
+}
+
+// T returns the transpose of m.
+func (m *Matrix) T() *Matrix {
+	t := New(m.Cols, m.Rows)
+	for i := range m.Rows {
+		for j := range m.Cols {
+			t.Set(j, i, m.At(i, j))
+		}
+	}
+	return t
+}
+
+// Add returns a + b.
+func Add(a, b *Matrix) (*Matrix, error) {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return nil, fmt.Errorf("cannot add %s and %s matrices: shapes differ", a.Shape(), b.Shape())
+	}
+	sum := New(a.Rows, a.Cols)
+	for i := range a.Data {
+		sum.Data[i] = a.Data[i] + b.Data[i]
+	}
+	return sum, nil
+}
+
+// Mul returns the product a·b.
+func Mul(a, b *Matrix) (*Matrix, error) {
+	if a.Cols != b.Rows {
+		return nil, fmt.Errorf("cannot multiply %s by %s matrix: %d columns but %d rows", a.Shape(), b.Shape(), a.Cols, b.Rows)
+	}
+	p := New(a.Rows, b.Cols)
+	for i := range a.Rows {
+		for k := range a.Cols {
+			aik := a.At(i, k)
+			if aik == 0 {
+				continue
+			}
+			for j := range b.Cols {
+				p.Data[i*p.Cols+j] += aik * b.At(k, j)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Trace returns the sum of the diagonal of a square matrix.
+func (m *Matrix) Trace() (float64, error) {
+	if !m.IsSquare() {
+		return 0, ErrNotSquare
+	}
+	var sum float64
+	for i := range m.Rows {
+		sum += m.At(i, i)
+	}
+	return sum, nil
+}
+
+// Det returns the determinant of a square matrix.
+func (m *Matrix) Det() (float64, error) {
+	if !m.IsSquare() {
+		return 0, ErrNotSquare
+	}
+	return NewLU(m).Det(), nil
+}
+
+// Inverse returns the inverse of a square matrix, or ErrSingular.
+func (m *Matrix) Inverse() (*Matrix, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	lu := NewLU(m)
+	if lu.IsSingular() {
+		return nil, ErrSingular
+	}
+	return lu.Solve(Identity(m.Rows))
+}
+
+// Pow returns m^n for a square matrix by repeated squaring. Negative powers
+// are powers of the inverse.
+func (m *Matrix) Pow(n int) (*Matrix, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	base := m
+	if n < 0 {
+		inv, err := m.Inverse()
+		if err != nil {
+			return nil, err
+		}
+		base, n = inv, -n
+	}
+	result := Identity(m.Rows)
+	for n > 0 {
+		if n&1 == 1 {
+			result, _ = Mul(result, base)
+		}
+		n >>= 1
+		if n > 0 {
+			base, _ = Mul(base, base)
+		}
+	}
+	return result, nil
+}
+
+// Rank returns the numerical rank of m: the number of pivots larger than
+// tol in Gaussian elimination with complete pivoting. A negative tol
+// selects max(rows, cols)·ε·max|mᵢⱼ|.
+func (m *Matrix) Rank(tol float64) int {
+	if tol < 0 {
+		tol = float64(max(m.Rows, m.Cols)) * epsilon * m.MaxAbs()
+	}
+	a := m.Clone()
+	rank := 0
+	for rank < min(a.Rows, a.Cols) {
+		// Find the largest remaining entry
+		pi, pj, best := rank, rank, 0.0
+		for i := rank; i < a.Rows; i++ {
+			for j := rank; j < a.Cols; j++ {
+				if v := math.Abs(a.At(i, j)); v > best {
+					pi, pj, best = i, j, v
+				}
+			}
+		}
+		if best <= tol {
+			break
+		}
+		a.swapRows(rank, pi)
+		a.swapCols(rank, pj)
+		pivot := a.At(rank, rank)
+		for i := rank + 1; i < a.Rows; i++ {
+			f := a.At(i, rank) / pivot
+			for j := rank; j < a.Cols; j++ {
+				a.Set(i, j, a.At(i, j)-f*a.At(rank, j))
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+func (m *Matrix) swapRows(i, k int) {
+	if i == k {
+		return
+	}
+	for j := range m.Cols {
+		m.Data[i*m.Cols+j], m.Data[k*m.Cols+j] = m.Data[k*m.Cols+j], m.Data[i*m.Cols+j]
+	}
+}
+
+func (m *Matrix) swapCols(j, k int) {
+	if j == k {
+		return
+	}
+	for i := range m.Rows {
+		m.Data[i*m.Cols+j], m.Data[i*m.Cols+k] = m.Data[i*m.Cols+k], m.Data[i*m.Cols+j]
+	}
+}
+
+// NormFrobenius returns the square root of the sum of squared entries.
+func (m *Matrix) NormFrobenius() float64 {
+	// Scale to avoid overflow, as hypot does
+	scale := m.MaxAbs()
+	if scale == 0 || math.IsInf(scale, 0) {
+		return scale
+	}
+	var sum float64
+	for _, v := range m.Data {
+		sum += (v / scale) * (v / scale)
+	}
+	return scale * math.Sqrt(sum)
+}
+
+// Norm1 returns the maximum absolute column sum.
+func (m *Matrix) Norm1() float64 {
+	var norm float64
+	for j := range m.Cols {
+		var sum float64
+		for i := range m.Rows {
+			sum += math.Abs(m.At(i, j))
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// NormInf returns the maximum absolute row sum.
+func (m *Matrix) NormInf() float64 {
+	var norm float64
+	for i := range m.Rows {
+		var sum float64
+		for j := range m.Cols {
+			sum += math.Abs(m.At(i, j))
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
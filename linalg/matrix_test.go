@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustRows(t *testing.T, rows [][]float64) *Matrix {
+	t.Helper()
+	m, err := FromRows(rows)
+	require.NoError(t, err)
+	return m
+}
+
+// assertMatrixInDelta checks m entry by entry against want.
+func assertMatrixInDelta(t *testing.T, want [][]float64, m *Matrix, delta float64) {
+	t.Helper()
+	got := m.ToRows()
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.InDeltaSlice(t, want[i], got[i], delta, "row %d", i)
+	}
+}
+
+func TestFromRows(t *testing.T) {
+	m, err := FromRows([][]float64{{1, 2, 3}, {4, 5, 6}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, m.Rows)
+	assert.Equal(t, 3, m.Cols)
+	assert.Equal(t, 6.0, m.At(1, 2))
+	assert.Equal(t, "2×3", m.Shape())
+
+	_, err = FromRows([][]float64{{1, 2}, {3}})
+	assert.EqualError(t, err, "row 1 has 1 entries, expected 2")
+
+	_, err = FromRows(nil)
+	assert.Error(t, err)
+	_, err = FromRows([][]float64{{}})
+	assert.Error(t, err)
+}
+
+func TestAddMul(t *testing.T) {
+	a := mustRows(t, [][]float64{{1, 2}, {3, 4}})
+	b := mustRows(t, [][]float64{{5, 6}, {7, 8}})
+
+	sum, err := Add(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{6, 8}, {10, 12}}, sum.ToRows())
+
+	p, err := Mul(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{19, 22}, {43, 50}}, p.ToRows())
+
+	// 2×3 · 3×1
+	p, err = Mul(mustRows(t, [][]float64{{1, 2, 3}, {4, 5, 6}}), mustRows(t, [][]float64{{1}, {0}, {-1}}))
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{-2}, {-2}}, p.ToRows())
+
+	_, err = Add(a, mustRows(t, [][]float64{{1, 2}}))
+	assert.Error(t, err)
+	_, err = Mul(a, mustRows(t, [][]float64{{1, 2}}))
+	assert.EqualError(t, err, "cannot multiply 2×2 by 1×2 matrix: 2 columns but 1 rows")
+}
+
+func TestTranspose(t *testing.T) {
+	m := mustRows(t, [][]float64{{1, 2, 3}, {4, 5, 6}})
+	assert.Equal(t, [][]float64{{1, 4}, {2, 5}, {3, 6}}, m.T().ToRows())
+}
+
+func TestTraceDet(t *testing.T) {
+	tests := []struct {
+		name  string
+		rows  [][]float64
+		trace float64
+		det   float64
+	}{
+		{"1x1", [][]float64{{-3}}, -3, -3},
+		{"2x2", [][]float64{{1, 2}, {3, 4}}, 5, -2},
+		{"needs pivoting", [][]float64{{0, 1}, {1, 0}}, 0, -1},
+		{"3x3", [][]float64{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, 7, 49},
+		{"singular", [][]float64{{1, 2}, {2, 4}}, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mustRows(t, tt.rows)
+
+			trace, err := m.Trace()
+			require.NoError(t, err)
+			assert.Equal(t, tt.trace, trace)
+
+			det, err := m.Det()
+			require.NoError(t, err)
+			assert.InDelta(t, tt.det, det, 1e-12)
+		})
+	}
+
+	_, err := mustRows(t, [][]float64{{1, 2}}).Det()
+	assert.ErrorIs(t, err, ErrNotSquare)
+	_, err = mustRows(t, [][]float64{{1, 2}}).Trace()
+	assert.ErrorIs(t, err, ErrNotSquare)
+}
+
+func TestInverse(t *testing.T) {
+	m := mustRows(t, [][]float64{{4, 7}, {2, 6}})
+
+	inv, err := m.Inverse()
+	require.NoError(t, err)
+	assertMatrixInDelta(t, [][]float64{{0.6, -0.7}, {-0.2, 0.4}}, inv, 1e-15)
+
+	p, _ := Mul(m, inv)
+	assertMatrixInDelta(t, Identity(2).ToRows(), p, 1e-15)
+
+	_, err = mustRows(t, [][]float64{{1, 2}, {2, 4}}).Inverse()
+	assert.ErrorIs(t, err, ErrSingular)
+
+	// Singular up to rounding
+	_, err = mustRows(t, [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}).Inverse()
+	assert.ErrorIs(t, err, ErrSingular)
+}
+
+func TestPow(t *testing.T) {
+	fib := mustRows(t, [][]float64{{1, 1}, {1, 0}})
+
+	p, err := fib.Pow(10)
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{89, 55}, {55, 34}}, p.ToRows())
+
+	p, err = fib.Pow(0)
+	require.NoError(t, err)
+	assert.Equal(t, Identity(2).ToRows(), p.ToRows())
+
+	p, err = mustRows(t, [][]float64{{2, 0}, {0, 4}}).Pow(-2)
+	require.NoError(t, err)
+	assertMatrixInDelta(t, [][]float64{{0.25, 0}, {0, 0.0625}}, p, 1e-15)
+
+	_, err = mustRows(t, [][]float64{{0, 0}, {0, 0}}).Pow(-1)
+	assert.ErrorIs(t, err, ErrSingular)
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+		want int
+	}{
+		{"full", [][]float64{{1, 2}, {3, 4}}, 2},
+		{"dependent rows", [][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}, 2},
+		{"rounding", [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, 2},
+		{"zero", [][]float64{{0, 0}, {0, 0}}, 0},
+		{"wide", [][]float64{{1, 0, 0, 1}, {0, 1, 0, 1}}, 2},
+		{"tall", [][]float64{{1}, {2}, {3}}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mustRows(t, tt.rows).Rank(-1))
+		})
+	}
+
+	// An explicit tolerance treats small pivots as zero
+	assert.Equal(t, 1, mustRows(t, [][]float64{{1, 0}, {0, 1e-9}}).Rank(1e-6))
+}
+
+func TestNorms(t *testing.T) {
+	m := mustRows(t, [][]float64{{1, -2}, {-3, 4}})
+
+	assert.InDelta(t, math.Sqrt(30), m.NormFrobenius(), 1e-15)
+	assert.Equal(t, 6.0, m.Norm1())
+	assert.Equal(t, 7.0, m.NormInf())
+
+	// No overflow in the intermediate squares
+	big := mustRows(t, [][]float64{{3e200, 4e200}})
+	assert.InDelta(t, 5e200, big.NormFrobenius(), 1e186)
+}
+
+func TestLUSolve(t *testing.T) {
+	a := mustRows(t, [][]float64{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}})
+	b := mustRows(t, [][]float64{{5}, {-2}, {9}})
+
+	x, err := NewLU(a).Solve(b)
+	require.NoError(t, err)
+	assertMatrixInDelta(t, [][]float64{{1}, {1}, {2}}, x, 1e-14)
+
+	_, err = NewLU(a).Solve(mustRows(t, [][]float64{{1}, {2}}))
+	assert.Error(t, err)
+}
+
+func TestLUFactors(t *testing.T) {
+	a := mustRows(t, [][]float64{{1, 2}, {3, 4}})
+	f := NewLU(a)
+
+	assert.Equal(t, [][]float64{{0, 1}, {1, 0}}, f.P().ToRows())
+	assertMatrixInDelta(t, [][]float64{{1, 0}, {1.0 / 3, 1}}, f.L(), 1e-15)
+	assertMatrixInDelta(t, [][]float64{{3, 4}, {0, 2.0 / 3}}, f.U(), 1e-15)
+
+	pa, _ := Mul(f.P(), a)
+	lu, _ := Mul(f.L(), f.U())
+	assertMatrixInDelta(t, pa.ToRows(), lu, 1e-15)
+}
+
+func TestLUCond(t *testing.T) {
+	// ‖A‖₁ = 6, ‖A⁻¹‖₁ = 3.5
+	assert.InDelta(t, 21, NewLU(mustRows(t, [][]float64{{1, 2}, {3, 4}})).Cond(), 1e-12)
+	assert.InDelta(t, 1, NewLU(Identity(3)).Cond(), 0)
+	assert.True(t, math.IsInf(NewLU(mustRows(t, [][]float64{{1, 2}, {2, 4}})).Cond(), 1))
+}
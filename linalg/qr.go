@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import "math"
+
+// QR is the factorization A = QR of an m×n matrix, where Q is m×m
+// orthogonal and R is m×n upper triangular.
+type QR struct {
+	Q, R *Matrix
+}
+
+// NewQR computes the QR factorization of a by Householder reflections.
+func NewQR(a *Matrix) *QR {
+	m, n := a.Rows, a.Cols
+	r := a.Clone()
+	q := Identity(m)
+	v := make([]float64, m)
+
+	for k := range min(m-1, n) {
+		// Reflect r[k:, k] onto a multiple of the first unit vector
+		var norm float64
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, r.At(i, k))
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, r.At(k, k))
+		var vnorm float64
+		for i := k; i < m; i++ {
+			v[i] = r.At(i, k)
+		}
+		v[k] -= alpha
+		for i := k; i < m; i++ {
+			vnorm = math.Hypot(vnorm, v[i])
+		}
+		for i := k; i < m; i++ {
+			v[i] /= vnorm
+		}
+
+		// R ← (I − 2vvᵀ)R
+		for j := k; j < n; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += v[i] * r.At(i, j)
+			}
+			for i := k; i < m; i++ {
+				r.Set(i, j, r.At(i, j)-2*v[i]*dot)
+			}
+		}
+		// Q ← Q(I − 2vvᵀ)
+		for i := range m {
+			var dot float64
+			for l := k; l < m; l++ {
+				dot += q.At(i, l) * v[l]
+			}
+			for l := k; l < m; l++ {
+				q.Set(i, l, q.At(i, l)-2*dot*v[l])
+			}
+		}
+
+		r.Set(k, k, alpha)
+		for i := k + 1; i < m; i++ {
+			r.Set(i, k, 0)
+		}
+	}
+	return &QR{Q: q, R: r}
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQR(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+	}{
+		{"square", [][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}}},
+		{"tall", [][]float64{{1, 2}, {3, 4}, {5, 6}}},
+		{"wide", [][]float64{{1, 2, 3}, {4, 5, 6}}},
+		{"rank deficient", [][]float64{{1, 2}, {2, 4}, {3, 6}}},
+		{"zero column", [][]float64{{0, 1}, {0, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustRows(t, tt.rows)
+			f := NewQR(a)
+			assert.Equal(t, a.Rows, f.Q.Rows)
+			assert.Equal(t, a.Rows, f.Q.Cols)
+
+			// Q is orthogonal
+			qtq, _ := Mul(f.Q.T(), f.Q)
+			assertMatrixInDelta(t, Identity(a.Rows).ToRows(), qtq, 1e-13)
+
+			// R is upper triangular with exact zeros
+			for i := range f.R.Rows {
+				for j := range min(i, f.R.Cols) {
+					assert.Zero(t, f.R.At(i, j), "R[%d][%d]", i, j)
+				}
+			}
+
+			qr, _ := Mul(f.Q, f.R)
+			assertMatrixInDelta(t, tt.rows, qr, 1e-12)
+		})
+	}
+
+	f := NewQR(mustRows(t, [][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}}))
+	assertMatrixInDelta(t, [][]float64{{-14, -21, 14}, {0, -175, 70}, {0, 0, -35}}, f.R, 1e-12)
+}
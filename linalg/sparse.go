@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// CSR is a sparse matrix in compressed sparse row form. The nonzero entries
+// of row i are Values[RowPtr[i]:RowPtr[i+1]], in the columns given by the
+// same range of ColIdx, sorted by column without duplicates.
+type CSR struct {
+	Rows, Cols int
+	RowPtr     []int
+	ColIdx     []int
+	Values     []float64
+}
+
+// FromCOO builds a rows×cols CSR matrix from coordinate form: entry k has
+// value values[k] at (row[k], col[k]). Duplicate coordinates are summed and
+// zeros are dropped.
+func FromCOO(rows, cols int, row, col []int, values []float64) (*CSR, error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("matrix must have at least one row and one column")
+	}
+	if len(row) != len(col) || len(row) != len(values) {
+		return nil, fmt.Errorf("row, column and value lists must have the same length, got %d, %d and %d", len(row), len(col), len(values))
+	}
+	for k := range row {
+		if row[k] < 0 || row[k] >= rows || col[k] < 0 || col[k] >= cols {
+			return nil, fmt.Errorf("entry %d at (%d, %d) is outside the %d×%d matrix", k, row[k], col[k], rows, cols)
+		}
+	}
+
+	order := make([]int, len(row))
+	for k := range order {
+		order[k] = k
+	}
+	sort.Slice(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if row[a] != row[b] {
+			return row[a] < row[b]
+		}
+		return col[a] < col[b]
+	})
+
+	m := &CSR{Rows: rows, Cols: cols, RowPtr: make([]int, rows+1)}
+	for i := 0; i < len(order); {
+		r, c := row[order[i]], col[order[i]]
+		var sum float64
+		for ; i < len(order) && row[order[i]] == r && col[order[i]] == c; i++ {
+			sum += values[order[i]]
+		}
+		if sum != 0 {
+			m.ColIdx = append(m.ColIdx, c)
+			m.Values = append(m.Values, sum)
+			m.RowPtr[r+1]++
+		}
+	}
+	for i := range rows {
+		m.RowPtr[i+1] += m.RowPtr[i]
+	}
+	return m, nil
+}
+
+// FromCSR builds a rows×cols CSR matrix from row pointers, column indices and
+// values. Entries within a row may be in any order; duplicates are summed and
+// zeros dropped, as in FromCOO.
+func FromCSR(rows, cols int, rowPtr, colIdx []int, values []float64) (*CSR, error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("matrix must have at least one row and one column")
+	}
+	if len(rowPtr) != rows+1 {
+		return nil, fmt.Errorf("row pointers must have %d entries for %d rows, got %d", rows+1, rows, len(rowPtr))
+	}
+	if rowPtr[0] != 0 {
+		return nil, fmt.Errorf("row pointers must start at 0")
+	}
+	for i := range rows {
+		if rowPtr[i+1] < rowPtr[i] {
+			return nil, fmt.Errorf("row pointers must be nondecreasing")
+		}
+	}
+	if len(colIdx) != len(values) || rowPtr[rows] != len(values) {
+		return nil, fmt.Errorf("row pointers end at %d but there are %d column indices and %d values", rowPtr[rows], len(colIdx), len(values))
+	}
+	row := make([]int, len(colIdx))
+	for i := range rows {
+		for k := rowPtr[i]; k < rowPtr[i+1]; k++ {
+			row[k] = i
+		}
+	}
+	return FromCOO(rows, cols, row, colIdx, values)
+}
+
+// Shape returns the dimensions as "rows×cols".
+func (m *CSR) Shape() string {
+	return fmt.Sprintf("%d×%d", m.Rows, m.Cols)
+}
+
+// NNZ returns the number of stored nonzero entries.
+func (m *CSR) NNZ() int {
+	return len(m.Values)
+}
+
+// At returns the entry at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	cols := m.ColIdx[m.RowPtr[i]:m.RowPtr[i+1]]
+	k := sort.SearchInts(cols, j)
+	if k < len(cols) && cols[k] == j {
+		return m.Values[m.RowPtr[i]+k]
+	}
+	return 0
+}
+
+// MulVec returns the product Ax.
+func (m *CSR) MulVec(x []float64) ([]float64, error) {
+	if len(x) != m.Cols {
+		return nil, fmt.Errorf("vector has %d entries but the matrix has %d columns", len(x), m.Cols)
+	}
+	y := make([]float64, m.Rows)
+	m.mulVec(y, x)
+	return y, nil
+}
+
+// mulVec stores Ax in y without checking lengths.
+func (m *CSR) mulVec(y, x []float64) {
+	for i := range m.Rows {
+		var sum float64
+		for k := m.RowPtr[i]; k < m.RowPtr[i+1]; k++ {
+			sum += m.Values[k] * x[m.ColIdx[k]]
+		}
+		y[i] = sum
+	}
+}
+
+// IsSymmetric reports whether m is square and equal to its transpose up to
+// a relative tolerance of n·ε·max|mᵢⱼ|, as for dense matrices.
+func (m *CSR) IsSymmetric() bool {
+	if m.Rows != m.Cols {
+		return false
+	}
+	var largest float64
+	for _, v := range m.Values {
+		largest = math.Max(largest, math.Abs(v))
+	}
+	tol := float64(m.Rows) * epsilon * largest
+	for i := range m.Rows {
+		for k := m.RowPtr[i]; k < m.RowPtr[i+1]; k++ {
+			if math.Abs(m.Values[k]-m.At(m.ColIdx[k], i)) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Structure summarizes the sparsity pattern of a matrix.
+type Structure struct {
+	NNZ int
+	// Density is NNZ divided by rows·cols.
+	Density              float64
+	MinRowNNZ, MaxRowNNZ int
+	EmptyRows, EmptyCols int
+	// LowerBandwidth and UpperBandwidth are the largest distances of a
+	// nonzero below and above the diagonal.
+	LowerBandwidth, UpperBandwidth int
+	// StructurallySymmetric reports whether (i, j) is nonzero exactly when
+	// (j, i) is; Symmetric compares the values too.
+	StructurallySymmetric, Symmetric bool
+	// ZeroDiagonal counts the zero diagonal entries of a square matrix.
+	ZeroDiagonal int
+	// DiagonallyDominant reports whether every row of a square matrix has
+	// |aᵢᵢ| > Σⱼ≠ᵢ |aᵢⱼ|.
+	DiagonallyDominant bool
+}
+
+// Structure reports the sparsity pattern of m.
+func (m *CSR) Structure() Structure {
+	s := Structure{
+		NNZ:                   m.NNZ(),
+		Density:               float64(m.NNZ()) / float64(m.Rows) / float64(m.Cols),
+		MinRowNNZ:             m.Cols,
+		StructurallySymmetric: m.Rows == m.Cols,
+		Symmetric:             m.IsSymmetric(),
+		DiagonallyDominant:    m.Rows == m.Cols,
+	}
+	colUsed := make([]bool, m.Cols)
+	for i := range m.Rows {
+		count := m.RowPtr[i+1] - m.RowPtr[i]
+		s.MinRowNNZ = min(s.MinRowNNZ, count)
+		s.MaxRowNNZ = max(s.MaxRowNNZ, count)
+		if count == 0 {
+			s.EmptyRows++
+		}
+
+		var diag, off float64
+		for k := m.RowPtr[i]; k < m.RowPtr[i+1]; k++ {
+			j := m.ColIdx[k]
+			colUsed[j] = true
+			s.LowerBandwidth = max(s.LowerBandwidth, i-j)
+			s.UpperBandwidth = max(s.UpperBandwidth, j-i)
+			if j == i {
+				diag = math.Abs(m.Values[k])
+			} else {
+				off += math.Abs(m.Values[k])
+			}
+			if s.StructurallySymmetric && m.At(j, i) == 0 {
+				s.StructurallySymmetric = false
+			}
+		}
+		if m.Rows == m.Cols {
+			if diag == 0 {
+				s.ZeroDiagonal++
+			}
+			if !(diag > off) {
+				s.DiagonallyDominant = false
+			}
+		}
+	}
+	for _, used := range colUsed {
+		if !used {
+			s.EmptyCols++
+		}
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tridiagonal returns the n×n sparse matrix with lower, diag and upper on
+// its three central diagonals.
+func tridiagonal(t *testing.T, n int, lower, diag, upper float64) *CSR {
+	t.Helper()
+	var row, col []int
+	var values []float64
+	for i := range n {
+		if i > 0 {
+			row, col, values = append(row, i), append(col, i-1), append(values, lower)
+		}
+		row, col, values = append(row, i), append(col, i), append(values, diag)
+		if i < n-1 {
+			row, col, values = append(row, i), append(col, i+1), append(values, upper)
+		}
+	}
+	m, err := FromCOO(n, n, row, col, values)
+	require.NoError(t, err)
+	return m
+}
+
+func TestFromCOO(t *testing.T) {
+	// Unsorted, with a duplicate and an explicit zero
+	m, err := FromCOO(2, 3, []int{1, 0, 1, 0, 1}, []int{2, 0, 0, 1, 2}, []float64{1, 5, 3, 0, 4})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{0, 1, 3}, m.RowPtr)
+	assert.Equal(t, []int{0, 0, 2}, m.ColIdx)
+	assert.Equal(t, []float64{5, 3, 5}, m.Values)
+	assert.Equal(t, 3, m.NNZ())
+	assert.Equal(t, 5.0, m.At(1, 2))
+	assert.Equal(t, 0.0, m.At(0, 2))
+	assert.Equal(t, "2×3", m.Shape())
+
+	_, err = FromCOO(2, 2, []int{0, 2}, []int{0, 0}, []float64{1, 1})
+	assert.EqualError(t, err, "entry 1 at (2, 0) is outside the 2×2 matrix")
+	_, err = FromCOO(2, 2, []int{0}, []int{0, 1}, []float64{1})
+	assert.EqualError(t, err, "row, column and value lists must have the same length, got 1, 2 and 1")
+	_, err = FromCOO(0, 2, nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestFromCSR(t *testing.T) {
+	m, err := FromCSR(2, 2, []int{0, 2, 3}, []int{1, 0, 1}, []float64{2, 1, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, m.ColIdx[:2])
+	assert.Equal(t, []float64{1, 2, 3}, m.Values)
+
+	tests := []struct {
+		name   string
+		rowPtr []int
+		colIdx []int
+		values []float64
+		want   string
+	}{
+		{"length", []int{0, 1}, []int{0}, []float64{1}, "row pointers must have 3 entries for 2 rows, got 2"},
+		{"start", []int{1, 1, 1}, []int{0}, []float64{1}, "row pointers must start at 0"},
+		{"decreasing", []int{0, 2, 1}, []int{0, 1}, []float64{1, 1}, "row pointers must be nondecreasing"},
+		{"end", []int{0, 1, 3}, []int{0, 1}, []float64{1, 1}, "row pointers end at 3 but there are 2 column indices and 2 values"},
+		{"column", []int{0, 1, 1}, []int{5}, []float64{1}, "entry 0 at (0, 5) is outside the 2×2 matrix"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromCSR(2, 2, tt.rowPtr, tt.colIdx, tt.values)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestCSRMulVec(t *testing.T) {
+	m, err := FromCOO(2, 3, []int{0, 0, 1}, []int{0, 2, 1}, []float64{1, 2, 3})
+	require.NoError(t, err)
+
+	y, err := m.MulVec([]float64{1, 1, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 3}, y)
+
+	_, err = m.MulVec([]float64{1, 1})
+	assert.EqualError(t, err, "vector has 2 entries but the matrix has 3 columns")
+}
+
+func TestCSRStructure(t *testing.T) {
+	s := tridiagonal(t, 5, -1, 4, -1).Structure()
+	assert.Equal(t, Structure{
+		NNZ:                   13,
+		Density:               13.0 / 25,
+		MinRowNNZ:             2,
+		MaxRowNNZ:             3,
+		LowerBandwidth:        1,
+		UpperBandwidth:        1,
+		StructurallySymmetric: true,
+		Symmetric:             true,
+		DiagonallyDominant:    true,
+	}, s)
+
+	// Nonsymmetric values on a symmetric pattern, and a weak diagonal
+	s = tridiagonal(t, 3, -1, 2, -2).Structure()
+	assert.True(t, s.StructurallySymmetric)
+	assert.False(t, s.Symmetric)
+	assert.False(t, s.DiagonallyDominant)
+
+	m, err := FromCOO(3, 4, []int{0, 2}, []int{3, 0}, []float64{1, 1})
+	require.NoError(t, err)
+	s = m.Structure()
+	assert.Equal(t, 1, s.EmptyRows)
+	assert.Equal(t, 2, s.EmptyCols)
+	assert.Equal(t, 0, s.MinRowNNZ)
+	assert.Equal(t, 2, s.LowerBandwidth)
+	assert.Equal(t, 3, s.UpperBandwidth)
+	assert.False(t, s.StructurallySymmetric)
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"math"
+	"sort"
+)
+
+// maxJacobiSweeps bounds the sweeps of the Jacobi iterations, which
+// converge quadratically and normally need fewer than ten.
+const maxJacobiSweeps = 60
+
+// SVD is the thin singular value decomposition A = U·diag(S)·Vᵀ of an m×n
+// matrix, with k = min(m, n): U is m×k and V is n×k, both with orthonormal
+// columns, and S holds the k singular values in decreasing order.
+type SVD struct {
+	U *Matrix
+	S []float64
+	V *Matrix
+}
+
+// NewSVD computes the singular value decomposition of a with the one-sided
+// Jacobi method.
+func NewSVD(a *Matrix) *SVD {
+	if a.Rows < a.Cols {
+		// Aᵀ = USVᵀ gives A = VSUᵀ
+		t := NewSVD(a.T())
+		return &SVD{U: t.V, S: t.S, V: t.U}
+	}
+
+	m, n := a.Rows, a.Cols
+	w := a.Clone()
+	v := Identity(n)
+	for range maxJacobiSweeps {
+		rotated := false
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma float64
+				for i := range m {
+					wp, wq := w.At(i, p), w.At(i, q)
+					alpha += wp * wp
+					beta += wq * wq
+					gamma += wp * wq
+				}
+				if gamma == 0 || math.Abs(gamma) <= epsilon*math.Sqrt(alpha)*math.Sqrt(beta) {
+					continue
+				}
+				rotated = true
+				// Rotate columns p and q to make them orthogonal
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Hypot(1, zeta))
+				c := 1 / math.Hypot(1, t)
+				s := c * t
+				rotateColumns(w, p, q, c, s)
+				rotateColumns(v, p, q, c, s)
+			}
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	// The column norms are the singular values
+	order := make([]int, n)
+	norms := make([]float64, n)
+	for j := range n {
+		order[j] = j
+		for i := range m {
+			norms[j] = math.Hypot(norms[j], w.At(i, j))
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return norms[order[i]] > norms[order[j]] })
+
+	svd := &SVD{U: New(m, n), S: make([]float64, n), V: New(n, n)}
+	for k, j := range order {
+		svd.S[k] = norms[j]
+		for i := range m {
+			if norms[j] > 0 {
+				svd.U.Set(i, k, w.At(i, j)/norms[j])
+			}
+		}
+		for i := range n {
+			svd.V.Set(i, k, v.At(i, j))
+		}
+	}
+	completeColumns(svd.U, svd.S)
+	return svd
+}
+
+// rotateColumns applies the plane rotation (c, s) to columns p and q of m.
+func rotateColumns(m *Matrix, p, q int, c, s float64) {
+	for i := range m.Rows {
+		mp, mq := m.At(i, p), m.At(i, q)
+		m.Set(i, p, c*mp-s*mq)
+		m.Set(i, q, s*mp+c*mq)
+	}
+}
+
+// completeColumns fills the columns of u for zero singular values with unit
+// vectors orthogonal to the other columns. Each is the standard basis vector
+// with the largest component outside the span of the columns so far, made
+// orthogonal to them by Gram–Schmidt.
+func completeColumns(u *Matrix, s []float64) {
+	m := u.Rows
+	for k := range s {
+		if s[k] > 0 {
+			continue
+		}
+		var best []float64
+		bestNorm := 0.0
+		for basis := range m {
+			col := make([]float64, m)
+			col[basis] = 1
+			for j := range s {
+				// Zero-σ columns after k are not filled yet
+				if j == k || (s[j] == 0 && j > k) {
+					continue
+				}
+				var dot float64
+				for i := range m {
+					dot += u.At(i, j) * col[i]
+				}
+				for i := range m {
+					col[i] -= dot * u.At(i, j)
+				}
+			}
+			var norm float64
+			for _, x := range col {
+				norm = math.Hypot(norm, x)
+			}
+			if norm > bestNorm {
+				best, bestNorm = col, norm
+			}
+		}
+		for i := range m {
+			u.Set(i, k, best[i]/bestNorm)
+		}
+	}
+}
+
+// Rank returns the number of singular values above tol. A negative tol
+// selects max(m, n)·ε·σ₁.
+func (d *SVD) Rank(tol float64) int {
+	if tol < 0 {
+		if len(d.S) == 0 {
+			return 0
+		}
+		tol = float64(max(d.U.Rows, d.V.Rows)) * epsilon * d.S[0]
+	}
+	rank := 0
+	for _, s := range d.S {
+		if s > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+// LeastSquares returns the minimum-norm x minimizing ‖Ax − b‖₂, treating
+// singular values up to tol as zero (see Rank), and the rank used.
+func (d *SVD) LeastSquares(b []float64, tol float64) ([]float64, int) {
+	rank := d.Rank(tol)
+	x := make([]float64, d.V.Rows)
+	for k := range rank {
+		var dot float64
+		for i := range d.U.Rows {
+			dot += d.U.At(i, k) * b[i]
+		}
+		coef := dot / d.S[k]
+		for i := range x {
+			x[i] += coef * d.V.At(i, k)
+		}
+	}
+	return x, rank
+}
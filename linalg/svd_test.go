@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package linalg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+		s    []float64
+	}{
+		{"diagonal", [][]float64{{3, 0}, {0, -4}}, []float64{4, 3}},
+		{"square", [][]float64{{2, 0}, {1, 2}}, nil},
+		{"tall", [][]float64{{1, 2}, {3, 4}, {5, 6}}, nil},
+		{"wide", [][]float64{{3, 2, 2}, {2, 3, -2}}, []float64{5, 3}},
+		{"rank deficient", [][]float64{{1, 2}, {2, 4}, {3, 6}}, []float64{math.Sqrt(70), 0}},
+		{"zero", [][]float64{{0, 0}, {0, 0}}, []float64{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustRows(t, tt.rows)
+			d := NewSVD(a)
+			k := min(a.Rows, a.Cols)
+			assert.Len(t, d.S, k)
+			assert.Equal(t, a.Rows, d.U.Rows)
+			assert.Equal(t, k, d.U.Cols)
+			assert.Equal(t, a.Cols, d.V.Rows)
+			assert.Equal(t, k, d.V.Cols)
+			if tt.s != nil {
+				assert.InDeltaSlice(t, tt.s, d.S, 1e-13)
+			}
+			for i := 1; i < k; i++ {
+				assert.GreaterOrEqual(t, d.S[i-1], d.S[i])
+			}
+
+			// Orthonormal columns
+			utu, _ := Mul(d.U.T(), d.U)
+			assertMatrixInDelta(t, Identity(k).ToRows(), utu, 1e-13)
+			vtv, _ := Mul(d.V.T(), d.V)
+			assertMatrixInDelta(t, Identity(k).ToRows(), vtv, 1e-13)
+
+			// U·diag(S)·Vᵀ = A
+			us := d.U.Clone()
+			for i := range us.Rows {
+				for j := range k {
+					us.Set(i, j, us.At(i, j)*d.S[j])
+				}
+			}
+			usv, _ := Mul(us, d.V.T())
+			assertMatrixInDelta(t, tt.rows, usv, 1e-12)
+		})
+	}
+}
+
+func TestSVDLeastSquares(t *testing.T) {
+	// Fit y = c₀ + c₁x through (0, 1), (1, 2), (2, 4)
+	d := NewSVD(mustRows(t, [][]float64{{1, 0}, {1, 1}, {1, 2}}))
+	x, rank := d.LeastSquares([]float64{1, 2, 4}, -1)
+	assert.Equal(t, 2, rank)
+	assert.InDeltaSlice(t, []float64{5.0 / 6, 1.5}, x, 1e-14)
+
+	// Rank deficient: minimum-norm solution of x₀ + x₁ = 2
+	d = NewSVD(mustRows(t, [][]float64{{1, 1}, {1, 1}}))
+	x, rank = d.LeastSquares([]float64{2, 2}, -1)
+	assert.Equal(t, 1, rank)
+	assert.InDeltaSlice(t, []float64{1, 1}, x, 1e-14)
+
+	// Underdetermined
+	d = NewSVD(mustRows(t, [][]float64{{1, 2, 2}}))
+	x, rank = d.LeastSquares([]float64{9}, -1)
+	assert.Equal(t, 1, rank)
+	assert.InDeltaSlice(t, []float64{1, 2, 2}, x, 1e-14)
+}
@@ -7,14 +7,17 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
 
 	"github.com/sagacient/math-mcp-server/config"
 	"github.com/sagacient/math-mcp-server/handlers"
+	"github.com/sagacient/math-mcp-server/httpserver"
 
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -26,8 +29,16 @@ func main() {
 	flag.StringVar(&transport, "t", "", "Transport type (stdio or http) (shorthand)")
 	flag.Parse()
 
-	// Load configuration
+	// Load configuration, from MATH_CONFIG_FILE when set
+	configFile := os.Getenv("MATH_CONFIG_FILE")
 	cfg := config.LoadConfig()
+	if configFile != "" {
+		var err error
+		cfg, err = config.LoadConfigFile(configFile)
+		if err != nil {
+			log.Fatalf("Failed to load config file: %v", err)
+		}
+	}
 
 	// Override transport from flag if provided
 	if transport != "" {
@@ -35,49 +46,141 @@ func main() {
 	}
 
 	// Log enabled categories
-	enabled := cfg.EnabledCategories()
-	if len(enabled) == 0 {
-		log.Fatal("No categories enabled. Set MATH_CATEGORIES environment variable.")
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v. Set MATH_CATEGORIES, or MATH_PROFILE_<NAME>_CATEGORIES for a profile.", err)
+	}
+	logEnabledCategories(cfg)
+	if cfg.ToolMode == config.ToolModeLazy {
+		log.Printf("Lazy tool mode: only search_tools and invoke_tool are listed")
 	}
 
-	categoryNames := make([]string, len(enabled))
-	for i, cat := range enabled {
-		categoryNames[i] = string(cat)
+	// Create MCP servers for the top-level configuration and each profile,
+	// all built from one registry
+	registry := handlers.NewRegistry()
+	if err := loadFunctionLibraries(registry, cfg); err != nil {
+		log.Fatalf("Failed to load function library: %v", err)
 	}
-	log.Printf("Enabled categories: %s", strings.Join(categoryNames, ", "))
+	endpoints := []*httpserver.Endpoint{
+		httpserver.NewEndpoint(httpserver.DefaultPath, httpserver.DefaultAPIPath, newMCPServer(registry, cfg), cfg),
+	}
+	for _, profile := range cfg.Profiles {
+		path := httpserver.ProfilePath(profile.Name)
+		apiPath := httpserver.ProfileAPIPath(profile.Name)
+		endpoints = append(endpoints, httpserver.NewEndpoint(path, apiPath, newMCPServer(registry, profile.Config), profile.Config))
+	}
+
+	// Reload configuration on SIGHUP or config file change. The transport
+	// and set of profiles cannot change while running, so only the existing
+	// endpoints are updated.
+	watcher := &config.Watcher{
+		Path: configFile,
+		OnReload: func(next *config.Config) {
+			next.Transport = cfg.Transport
+			if err := loadFunctionLibraries(registry, next); err != nil {
+				log.Printf("Failed to reload function library: %v", err)
+			}
+			reloadEndpoint(registry, endpoints[0], next)
+			for _, e := range endpoints[1:] {
+				reloaded := false
+				for _, profile := range next.Profiles {
+					if httpserver.ProfilePath(profile.Name) == e.Path {
+						reloadEndpoint(registry, e, profile.Config)
+						reloaded = true
+					}
+				}
+				if !reloaded {
+					log.Printf("Profile at %s was removed from the configuration; restart to unmount it", e.Path)
+				}
+			}
+			logEnabledCategories(next)
+		},
+	}
+	go watcher.Run(context.Background())
+
+	// Start server based on transport
+	switch cfg.Transport {
+	case "http":
+		port := os.Getenv("MATH_PORT")
+		if port == "" {
+			port = "8080"
+		}
+		for _, e := range endpoints {
+			log.Printf("Serving MCP endpoint on :%s%s", port, e.Path)
+			log.Printf("Serving REST API on :%s%s", port, e.APIPath)
+		}
+		log.Printf("Starting HTTP server on :%s", port)
+		if err := http.ListenAndServe(":"+port, httpserver.NewMux(endpoints...)); err != nil {
+			log.Fatalf("HTTP server error: %v", err)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, "Starting stdio server...")
+		if err := server.ServeStdio(endpoints[0].Server); err != nil {
+			log.Fatalf("Stdio server error: %v", err)
+		}
+	}
+}
+
+// newMCPServer creates an MCP server with the tools and resources enabled by cfg.
+func newMCPServer(registry *handlers.Registry, cfg *config.Config) *server.MCPServer {
+	// Drop session variables when a session ends
+	hooks := &server.Hooks{}
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		registry.EndSession(session.SessionID())
+	})
 
-	// Create MCP server
 	mcpServer := server.NewMCPServer(
 		"math-mcp-server",
 		"1.0.0",
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, false),
 		server.WithRecovery(),
+		server.WithHooks(hooks),
 	)
 
 	// Register tools based on configuration
-	registry := handlers.NewRegistry()
 	registry.RegisterTools(mcpServer, cfg)
 
 	// Register constants resource if enabled
 	handlers.RegisterConstants(mcpServer, cfg)
 
-	// Start server based on transport
-	switch cfg.Transport {
-	case "http":
-		httpServer := server.NewStreamableHTTPServer(mcpServer)
-		port := os.Getenv("MATH_PORT")
-		if port == "" {
-			port = "8080"
-		}
-		log.Printf("Starting HTTP server on :%s/mcp", port)
-		if err := httpServer.Start(":" + port); err != nil {
-			log.Fatalf("HTTP server error: %v", err)
+	return mcpServer
+}
+
+// reloadEndpoint moves an endpoint's server, keys and limits to next.
+func reloadEndpoint(registry *handlers.Registry, e *httpserver.Endpoint, next *config.Config) {
+	current := e.Config()
+	registry.SyncTools(e.Server, current, next)
+	handlers.SyncConstants(e.Server, current, next)
+	e.SetConfig(next)
+}
+
+// loadFunctionLibraries loads the function library files named by cfg and
+// its profiles, so that each endpoint shares the functions of its own file.
+func loadFunctionLibraries(registry *handlers.Registry, cfg *config.Config) error {
+	configs := []*config.Config{cfg}
+	for _, profile := range cfg.Profiles {
+		configs = append(configs, profile.Config)
+	}
+	loaded := make(map[string]bool)
+	for _, c := range configs {
+		if c.FunctionsFile == "" || loaded[c.FunctionsFile] {
+			continue
 		}
-	default:
-		fmt.Fprintln(os.Stderr, "Starting stdio server...")
-		if err := server.ServeStdio(mcpServer); err != nil {
-			log.Fatalf("Stdio server error: %v", err)
+		if err := registry.LoadFunctionLibrary(c.FunctionsFile); err != nil {
+			return err
 		}
+		loaded[c.FunctionsFile] = true
+		log.Printf("Function library: %s", c.FunctionsFile)
+	}
+	return nil
+}
+
+// logEnabledCategories logs the categories enabled by cfg.
+func logEnabledCategories(cfg *config.Config) {
+	enabled := cfg.EnabledCategories()
+	categoryNames := make([]string, len(enabled))
+	for i, cat := range enabled {
+		categoryNames[i] = string(cat)
 	}
+	log.Printf("Enabled categories: %s", strings.Join(categoryNames, ", "))
 }
@@ -0,0 +1,527 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Method selects how Minimize searches for a minimum.
+type Method string
+
+const (
+	// BFGS is the quasi-Newton method of Broyden, Fletcher, Goldfarb and
+	// Shanno with numerical gradients: fast for smooth functions.
+	BFGS Method = "bfgs"
+	// NelderMead is the downhill simplex method, which needs no gradients
+	// and so copes with functions that are not smooth, at the cost of many
+	// more evaluations.
+	NelderMead Method = "nelder-mead"
+)
+
+const (
+	// maxLineSearch bounds the halvings of a BFGS step.
+	maxLineSearch = 60
+	// armijo is the fraction of the decrease predicted by the gradient that
+	// a BFGS step must achieve.
+	armijo = 1e-4
+	// boundMargin is the relative distance from a bound at which a start on
+	// or beyond it is placed, since the bound transformations have zero
+	// slope on the bounds.
+	boundMargin = 1e-4
+	// probeStep is the step, relative to the size of a variable, of the
+	// points BFGS compares with a minimum before accepting it.
+	probeStep = 1e-3
+)
+
+// ErrNotFiniteStart is returned when the function is not finite at the
+// starting point.
+var ErrNotFiniteStart = errors.New("function is not finite at the starting point")
+
+// Options configures Minimize.
+type Options struct {
+	// Method defaults to BFGS.
+	Method Method
+	// Lower and Upper bound the variables; nil, or infinite entries, leave
+	// them unbounded.
+	Lower, Upper []float64
+	// Tol ends the search once the gradient is within Tol·max(1, |f|), a
+	// full BFGS step within Tol·max(1, |x|) or a step changes f by less than
+	// Tol·max(1, |f|), or once the simplex of Nelder–Mead spans less than
+	// those in both x and f. For BFGS, |f| is the least magnitude seen so
+	// far, so that the tests do not loosen as f falls without limit.
+	Tol     float64
+	MaxIter int
+}
+
+// bounds maps unbounded variables z onto the box [lower, upper]: by
+// l + (u − l)(1 + sin z)/2 between two bounds, l + z² or u − z² beyond one,
+// and the identity for free variables.
+type bounds struct {
+	lower, upper []float64
+}
+
+// toX maps z into the box.
+func (b bounds) toX(z []float64) []float64 {
+	x := make([]float64, len(z))
+	for i, zi := range z {
+		l, u := b.lower[i], b.upper[i]
+		switch {
+		case !math.IsInf(l, 0) && !math.IsInf(u, 0):
+			x[i] = l + (u-l)*(1+math.Sin(zi))/2
+		case !math.IsInf(l, 0):
+			x[i] = l + zi*zi
+		case !math.IsInf(u, 0):
+			x[i] = u - zi*zi
+		default:
+			x[i] = zi
+		}
+		x[i] = math.Min(math.Max(x[i], l), u)
+	}
+	return x
+}
+
+// toZ maps a point of the box to z, moving it inside first.
+func (b bounds) toZ(x []float64) []float64 {
+	z := make([]float64, len(x))
+	for i, xi := range x {
+		l, u := b.lower[i], b.upper[i]
+		switch {
+		case !math.IsInf(l, 0) && !math.IsInf(u, 0):
+			margin := boundMargin * (u - l)
+			xi = math.Min(math.Max(xi, l+margin), u-margin)
+			if l == u {
+				xi = l
+			}
+			z[i] = math.Asin(math.Min(math.Max(2*(xi-l)/(u-l)-1, -1), 1))
+			if l == u {
+				z[i] = 0
+			}
+		case !math.IsInf(l, 0):
+			z[i] = math.Sqrt(math.Max(xi-l, boundMargin*math.Max(1, math.Abs(l))))
+		case !math.IsInf(u, 0):
+			z[i] = math.Sqrt(math.Max(u-xi, boundMargin*math.Max(1, math.Abs(u))))
+		default:
+			z[i] = xi
+		}
+	}
+	return z
+}
+
+// Minimize searches for a local minimum of f from x0, within the bounds if
+// given. Bounds are enforced by changing variables, so that the search
+// itself is unconstrained and the function is never evaluated outside
+// them; a start on a bound is moved slightly inside. Points where f is not
+// finite are treated as infinitely high.
+func Minimize(f MultiFunc, x0 []float64, opts Options) (Result, error) {
+	n := len(x0)
+	if n == 0 {
+		return Result{}, errors.New("there must be at least one variable")
+	}
+	for _, x := range x0 {
+		if !isFinite(x) {
+			return Result{}, errors.New("starting point must be finite")
+		}
+	}
+	if !(opts.Tol >= 0) || math.IsInf(opts.Tol, 0) {
+		return Result{}, errors.New("tolerance must be a non-negative number")
+	}
+	b := bounds{lower: make([]float64, n), upper: make([]float64, n)}
+	for i := range n {
+		b.lower[i], b.upper[i] = math.Inf(-1), math.Inf(1)
+	}
+	for name, dst := range map[string][]float64{"lower": opts.Lower, "upper": opts.Upper} {
+		if dst == nil {
+			continue
+		}
+		if len(dst) != n {
+			return Result{}, fmt.Errorf("%s bounds have %d values but there are %d variables", name, len(dst), n)
+		}
+		if slices.ContainsFunc(dst, math.IsNaN) {
+			return Result{}, fmt.Errorf("%s bounds must not be NaN", name)
+		}
+	}
+	if opts.Lower != nil {
+		copy(b.lower, opts.Lower)
+	}
+	if opts.Upper != nil {
+		copy(b.upper, opts.Upper)
+	}
+	for i := range n {
+		if b.lower[i] > b.upper[i] {
+			return Result{}, fmt.Errorf("lower bound %g exceeds upper bound %g of variable %d", b.lower[i], b.upper[i], i+1)
+		}
+	}
+
+	c := &counter{f: func(z []float64) (float64, error) { return f(b.toX(z)) }}
+	z0 := b.toZ(x0)
+	var r Result
+	var err error
+	switch opts.Method {
+	case BFGS, "":
+		r, err = bfgs(c, z0, opts.Tol, opts.MaxIter)
+	case NelderMead:
+		r, err = nelderMead(c, z0, opts.Tol, opts.MaxIter)
+	default:
+		return Result{}, fmt.Errorf("unknown method %q", opts.Method)
+	}
+	if r.X != nil {
+		r.X = b.toX(r.X)
+	}
+	r.Evaluations = c.evaluations
+	return r, err
+}
+
+// gradient estimates the gradient of f at x by central differences, given
+// fx = f(x), with one-sided differences where f is not finite on one side.
+func gradient(c *counter, x []float64, fx float64) ([]float64, error) {
+	g := make([]float64, len(x))
+	shifted := slices.Clone(x)
+	for i, xi := range x {
+		h := math.Cbrt(epsilon) * math.Max(math.Abs(xi), 1)
+		h = (xi + h) - xi
+		shifted[i] = xi + h
+		fr, err := c.eval(shifted)
+		if err != nil {
+			return nil, err
+		}
+		shifted[i] = xi - h
+		fl, err := c.eval(shifted)
+		if err != nil {
+			return nil, err
+		}
+		shifted[i] = xi
+		switch {
+		case isFinite(fr) && isFinite(fl):
+			g[i] = (fr - fl) / (2 * h)
+		case isFinite(fr):
+			g[i] = (fr - fx) / h
+		case isFinite(fl):
+			g[i] = (fx - fl) / h
+		}
+	}
+	return g, nil
+}
+
+// probe looks for a point lower than fx by more than drop a step away from
+// x along each axis, which there is next to a maximum or saddle point,
+// where the gradient vanishes as at a minimum. It returns nil if there is
+// none.
+func probe(c *counter, x []float64, fx, drop float64) ([]float64, float64, error) {
+	for i, xi := range x {
+		h := probeStep * math.Max(math.Abs(xi), 1)
+		for _, step := range []float64{h, -h} {
+			p := slices.Clone(x)
+			p[i] = xi + step
+			v, err := c.eval(p)
+			if err != nil {
+				return nil, 0, err
+			}
+			if v < fx-drop {
+				return p, v, nil
+			}
+		}
+	}
+	return nil, 0, nil
+}
+
+// bfgs minimises by BFGS steps with a backtracking line search, keeping an
+// approximation H of the inverse Hessian.
+func bfgs(c *counter, x []float64, tol float64, maxIter int) (Result, error) {
+	n := len(x)
+	fx, err := c.eval(x)
+	if err != nil {
+		return Result{}, err
+	}
+	if !isFinite(fx) {
+		return Result{}, ErrNotFiniteStart
+	}
+	g, err := gradient(c, x, fx)
+	if err != nil {
+		return Result{}, err
+	}
+	identity := func() [][]float64 {
+		h := make([][]float64, n)
+		for i := range h {
+			h[i] = make([]float64, n)
+			h[i][i] = 1
+		}
+		return h
+	}
+	h := identity()
+	scaled := false
+	r := Result{X: x, Value: fx}
+	f0, fscale := fx, math.Max(1, math.Abs(fx))
+	// restart moves the search to a lower point next to x, if there is one,
+	// before it ends there
+	restart := func() (bool, error) {
+		lower, fl, err := probe(c, x, fx, tol*fscale)
+		if lower == nil || err != nil {
+			return false, err
+		}
+		x, fx = lower, fl
+		if g, err = gradient(c, x, fx); err != nil {
+			return false, err
+		}
+		h, scaled = identity(), false
+		r.X, r.Value = x, fx
+		return true, nil
+	}
+	p := make([]float64, n)
+	next := make([]float64, n)
+	for r.Iterations < maxIter {
+		if normInf(g) <= tol*fscale {
+			moved, err := restart()
+			if err != nil {
+				return r, err
+			}
+			if moved {
+				continue
+			}
+			r.Converged = true
+			break
+		}
+		r.Iterations++
+		for i := range p {
+			p[i] = -dot(h[i], g)
+		}
+		slope := dot(g, p)
+		if !(slope < 0) {
+			// H has lost positive definiteness: restart from steepest descent
+			h, scaled = identity(), false
+			for i := range p {
+				p[i] = -g[i]
+			}
+			slope = dot(g, p)
+		}
+
+		alpha := 1.0
+		fn := math.Inf(1)
+		found := false
+		for range maxLineSearch {
+			for i := range next {
+				next[i] = x[i] + alpha*p[i]
+			}
+			if fn, err = c.eval(next); err != nil {
+				return r, err
+			}
+			if fn <= fx+armijo*alpha*slope {
+				found = true
+				break
+			}
+			alpha /= 2
+		}
+		if !found {
+			// No decrease within rounding: x is a minimum to the precision
+			// of f if the gradient is small
+			if normInf(g) <= math.Sqrt(tol)*fscale {
+				moved, err := restart()
+				if err != nil {
+					return r, err
+				}
+				if moved {
+					continue
+				}
+				r.Converged = true
+			}
+			break
+		}
+		gn, err := gradient(c, next, fn)
+		if err != nil {
+			return r, err
+		}
+		s, y := make([]float64, n), make([]float64, n)
+		for i := range s {
+			s[i] = next[i] - x[i]
+			y[i] = gn[i] - g[i]
+		}
+		decrease := fx - fn
+		x, fx, g = slices.Clone(next), fn, gn
+		r.X, r.Value = x, fx
+		if unbounded(f0, fx) {
+			r.Unbounded = true
+			break
+		}
+		fscale = math.Min(fscale, math.Max(1, math.Abs(fx)))
+		// Numerical gradients are accurate only to about the cube root of
+		// the precision, so a step that barely changes f also ends the
+		// search once the gradient is small to the square root of tol
+		if (alpha == 1 && normInf(s) <= tol*math.Max(1, normInf(x))) ||
+			(decrease <= tol*fscale && normInf(g) <= math.Sqrt(tol)*fscale) {
+			moved, err := restart()
+			if err != nil {
+				return r, err
+			}
+			if moved {
+				continue
+			}
+			r.Converged = true
+			break
+		}
+
+		// Update H only if the curvature along the step is positive, which
+		// keeps it positive definite
+		sy := dot(s, y)
+		if sy <= 1e-12*math.Sqrt(dot(s, s)*dot(y, y)) {
+			continue
+		}
+		if !scaled {
+			// Scale the first approximation to the curvature seen
+			scale := sy / dot(y, y)
+			for i := range h {
+				h[i][i] = scale
+			}
+			scaled = true
+		}
+		// H ← (I − ρ s yᵀ) H (I − ρ y sᵀ) + ρ s sᵀ
+		rho := 1 / sy
+		hy := make([]float64, n)
+		for i := range hy {
+			hy[i] = dot(h[i], y)
+		}
+		yhy := dot(y, hy)
+		for i := range n {
+			for j := range n {
+				h[i][j] += rho * ((1+rho*yhy)*s[i]*s[j] - hy[i]*s[j] - s[i]*hy[j])
+			}
+		}
+	}
+	return r, nil
+}
+
+// nelderMead minimises by the downhill simplex method, with the parameters
+// Gao and Han adapted to the dimension, which work better than the classic
+// ones beyond a few variables.
+func nelderMead(c *counter, x0 []float64, tol float64, maxIter int) (Result, error) {
+	n := len(x0)
+	fn := float64(n)
+	alpha, beta, gamma, delta := 1.0, 1+2/fn, 0.75-1/(2*fn), 1-1/fn
+
+	// The first simplex steps 5% along each axis, or by 0.05 from zero: the
+	// tiny step MATLAB's fminsearch takes from zero leaves the simplex too
+	// flat to cross the bound transformations
+	type vertex struct {
+		x []float64
+		f float64
+	}
+	simplex := make([]vertex, n+1)
+	for i := range simplex {
+		x := slices.Clone(x0)
+		if i > 0 {
+			if x[i-1] != 0 {
+				x[i-1] *= 1.05
+			} else {
+				x[i-1] = 0.05
+			}
+		}
+		f, err := c.eval(x)
+		if err != nil {
+			return Result{}, err
+		}
+		simplex[i] = vertex{x, f}
+	}
+	if !isFinite(simplex[0].f) {
+		return Result{}, ErrNotFiniteStart
+	}
+	f0 := simplex[0].f
+	// point returns centroid + t·(centroid − worst)
+	point := func(centroid, worst []float64, t float64) []float64 {
+		p := make([]float64, n)
+		for i := range p {
+			p[i] = centroid[i] + t*(centroid[i]-worst[i])
+		}
+		return p
+	}
+	r := Result{}
+	for {
+		sort.SliceStable(simplex, func(i, j int) bool { return simplex[i].f < simplex[j].f })
+		best, worst := simplex[0], simplex[n]
+		r.X, r.Value = best.x, best.f
+		if unbounded(f0, best.f) {
+			r.Unbounded = true
+			break
+		}
+		var size, spread float64
+		for _, v := range simplex[1:] {
+			for i := range v.x {
+				size = math.Max(size, math.Abs(v.x[i]-best.x[i]))
+			}
+			spread = math.Max(spread, math.Abs(v.f-best.f))
+		}
+		if size <= tol*math.Max(1, normInf(best.x)) && spread <= tol*math.Max(1, math.Abs(best.f)) {
+			r.Converged = true
+			break
+		}
+		if r.Iterations >= maxIter {
+			break
+		}
+		r.Iterations++
+
+		centroid := make([]float64, n)
+		for _, v := range simplex[:n] {
+			for i := range centroid {
+				centroid[i] += v.x[i] / fn
+			}
+		}
+		eval := func(x []float64) (vertex, error) {
+			f, err := c.eval(x)
+			return vertex{x, f}, err
+		}
+		reflected, err := eval(point(centroid, worst.x, alpha))
+		if err != nil {
+			return r, err
+		}
+		var accept *vertex
+		switch {
+		case reflected.f < best.f:
+			expanded, err := eval(point(centroid, worst.x, alpha*beta))
+			if err != nil {
+				return r, err
+			}
+			accept = &reflected
+			if expanded.f < reflected.f {
+				accept = &expanded
+			}
+		case reflected.f < simplex[n-1].f:
+			accept = &reflected
+		case reflected.f < worst.f:
+			outside, err := eval(point(centroid, worst.x, alpha*gamma))
+			if err != nil {
+				return r, err
+			}
+			if outside.f <= reflected.f {
+				accept = &outside
+			}
+		default:
+			inside, err := eval(point(centroid, worst.x, -gamma))
+			if err != nil {
+				return r, err
+			}
+			if inside.f < worst.f {
+				accept = &inside
+			}
+		}
+		if accept != nil {
+			simplex[n] = *accept
+			continue
+		}
+		// Shrink towards the best vertex
+		for k := 1; k <= n; k++ {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = best.x[i] + delta*(simplex[k].x[i]-best.x[i])
+			}
+			if simplex[k], err = eval(x); err != nil {
+				return r, err
+			}
+		}
+	}
+	return r, nil
+}
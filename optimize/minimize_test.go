@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exactMulti(f func([]float64) float64) MultiFunc {
+	return func(x []float64) (float64, error) { return f(x), nil }
+}
+
+func rosenbrock(x []float64) float64 {
+	return 100*math.Pow(x[1]-x[0]*x[0], 2) + math.Pow(1-x[0], 2)
+}
+
+func TestMinimize(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func([]float64) float64
+		x0   []float64
+		want []float64
+	}{
+		{"quadratic", func(x []float64) float64 { return math.Pow(x[0]-1, 2) + 10*math.Pow(x[1]+2, 2) }, []float64{0, 0}, []float64{1, -2}},
+		{"rosenbrock", rosenbrock, []float64{-1.2, 1}, []float64{1, 1}},
+		{"one variable", func(x []float64) float64 { return math.Cosh(x[0] - 3) }, []float64{0}, []float64{3}},
+		{"four variables", func(x []float64) float64 {
+			var s float64
+			for i, xi := range x {
+				s += float64(i+1) * math.Pow(xi-float64(i), 2)
+			}
+			return s
+		}, []float64{1, 1, 1, 1}, []float64{0, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		for _, method := range []Method{BFGS, NelderMead} {
+			t.Run(tt.name+"/"+string(method), func(t *testing.T) {
+				r, err := Minimize(exactMulti(tt.f), tt.x0, Options{Method: method, Tol: 1e-10, MaxIter: 5000})
+				require.NoError(t, err)
+				assert.True(t, r.Converged)
+				assert.InDeltaSlice(t, tt.want, r.X, 1e-4)
+				assert.Equal(t, tt.f(r.X), r.Value)
+				assert.Positive(t, r.Evaluations)
+			})
+		}
+	}
+}
+
+func TestBFGSIsFaster(t *testing.T) {
+	bfgs, err := Minimize(exactMulti(rosenbrock), []float64{-1.2, 1}, Options{Method: BFGS, Tol: 1e-8, MaxIter: 1000})
+	require.NoError(t, err)
+	nm, err := Minimize(exactMulti(rosenbrock), []float64{-1.2, 1}, Options{Method: NelderMead, Tol: 1e-8, MaxIter: 1000})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1}, bfgs.X, 1e-5)
+	assert.Less(t, bfgs.Iterations, nm.Iterations/2)
+}
+
+func TestMinimizeBounded(t *testing.T) {
+	tests := []struct {
+		name         string
+		lower, upper []float64
+		x0           []float64
+		want         []float64
+	}{
+		{"both bounds", []float64{2, -5}, []float64{4, 5}, []float64{3, 0}, []float64{2, 1}},
+		{"lower bound", []float64{-10, 1.5}, nil, []float64{0, 2}, []float64{1, 1.5}},
+		{"upper bound", nil, []float64{0.5, 10}, []float64{0, 0}, []float64{0.5, 1}},
+		{"start on a bound", []float64{2, math.Inf(-1)}, []float64{4, math.Inf(1)}, []float64{2, 0}, []float64{2, 1}},
+		{"fixed variable", []float64{3, math.Inf(-1)}, []float64{3, math.Inf(1)}, []float64{3, 0}, []float64{3, 1}},
+		{"inactive bounds", []float64{-5, -5}, []float64{5, 5}, []float64{0, 0}, []float64{1, 1}},
+	}
+	f := func(x []float64) float64 { return math.Pow(x[0]-1, 2) + math.Pow(x[1]-1, 2) }
+	for _, tt := range tests {
+		for _, method := range []Method{BFGS, NelderMead} {
+			t.Run(tt.name+"/"+string(method), func(t *testing.T) {
+				var seen []float64
+				g := func(x []float64) float64 {
+					seen = append(seen, x...)
+					return f(x)
+				}
+				r, err := Minimize(exactMulti(g), tt.x0, Options{Method: method, Lower: tt.lower, Upper: tt.upper, Tol: 1e-10, MaxIter: 5000})
+				require.NoError(t, err)
+				assert.InDeltaSlice(t, tt.want, r.X, 1e-4)
+				for i, x := range seen {
+					if tt.lower != nil {
+						assert.GreaterOrEqual(t, x, tt.lower[i%2])
+					}
+					if tt.upper != nil {
+						assert.LessOrEqual(t, x, tt.upper[i%2])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestMinimizeAvoidsUndefined(t *testing.T) {
+	// x log x + y² is undefined for x < 0 and least at (1/e, 0)
+	f := func(x []float64) float64 { return x[0]*math.Log(x[0]) + x[1]*x[1] }
+	for _, method := range []Method{BFGS, NelderMead} {
+		r, err := Minimize(exactMulti(f), []float64{0.05, 1}, Options{Method: method, Tol: 1e-10, MaxIter: 5000})
+		require.NoError(t, err, method)
+		assert.InDeltaSlice(t, []float64{1 / math.E, 0}, r.X, 1e-4, method)
+	}
+}
+
+func TestMinimizeNotConverged(t *testing.T) {
+	r, err := Minimize(exactMulti(rosenbrock), []float64{-1.2, 1}, Options{Method: NelderMead, Tol: 1e-10, MaxIter: 10})
+	require.NoError(t, err)
+	assert.False(t, r.Converged)
+	assert.Equal(t, 10, r.Iterations)
+}
+
+func TestMinimizeUnbounded(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func([]float64) float64
+		x0   []float64
+	}{
+		{"linear", func(x []float64) float64 { return x[0] + x[1] }, []float64{0, 0}},
+		// The gradient vanishes at the start, a maximum or saddle point
+		{"maximum", func(x []float64) float64 { return -x[0] * x[0] }, []float64{0}},
+		{"saddle", func(x []float64) float64 { return x[0]*x[0] - x[1]*x[1] }, []float64{0, 0}},
+	}
+	for _, tt := range tests {
+		for _, method := range []Method{BFGS, NelderMead} {
+			t.Run(tt.name+"/"+string(method), func(t *testing.T) {
+				r, err := Minimize(exactMulti(tt.f), tt.x0, Options{Method: method, Tol: 1e-8, MaxIter: 1000})
+				require.NoError(t, err)
+				assert.True(t, r.Unbounded)
+				assert.False(t, r.Converged)
+				assert.Less(t, r.Value, -1e15)
+			})
+		}
+	}
+}
+
+func TestMinimizeErrors(t *testing.T) {
+	f := exactMulti(rosenbrock)
+	tests := []struct {
+		name string
+		x0   []float64
+		opts Options
+		msg  string
+	}{
+		{"no variables", nil, Options{}, "there must be at least one variable"},
+		{"bound length", []float64{0, 0}, Options{Lower: []float64{0}}, "lower bounds have 1 values but there are 2 variables"},
+		{"crossed bounds", []float64{0, 0}, Options{Lower: []float64{0, 2}, Upper: []float64{1, 1}}, "lower bound 2 exceeds upper bound 1 of variable 2"},
+		{"not finite start", []float64{math.NaN(), 0}, Options{}, "starting point must be finite"},
+		{"tolerance", []float64{0, 0}, Options{Tol: -1}, "tolerance must be a non-negative number"},
+		{"method", []float64{0, 0}, Options{Method: "powell"}, `unknown method "powell"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Minimize(f, tt.x0, tt.opts)
+			assert.EqualError(t, err, tt.msg)
+		})
+	}
+
+	log := exactMulti(func(x []float64) float64 { return math.Log(x[0]) })
+	for _, method := range []Method{BFGS, NelderMead} {
+		_, err := Minimize(log, []float64{-1}, Options{Method: method, MaxIter: 100})
+		assert.ErrorIs(t, err, ErrNotFiniteStart, method)
+	}
+}
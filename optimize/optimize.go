@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package optimize implements minimisation of real functions, with and
+// without bounds, and linear programming.
+package optimize
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// epsilon is the float64 machine epsilon.
+	epsilon = 0x1p-52
+	// MaxEvaluations caps the function evaluations of a minimisation.
+	MaxEvaluations = 1_000_000
+	// unboundedDrop is the fall of f, relative to its size at the start,
+	// beyond which Minimize takes f to be unbounded below.
+	unboundedDrop = 1 / epsilon
+)
+
+// ErrTooManyEvaluations is returned when a minimisation would take more
+// than MaxEvaluations evaluations of the function.
+var ErrTooManyEvaluations = errors.New("minimisation needs more than 1000000 evaluations")
+
+// Func is a real function of one variable that may fail to evaluate.
+type Func func(x float64) (float64, error)
+
+// MultiFunc is a real function of several variables that may fail to
+// evaluate.
+type MultiFunc func(x []float64) (float64, error)
+
+// Result is the outcome of a minimisation.
+type Result struct {
+	// X is the minimiser found and Value the function there.
+	X     []float64
+	Value float64
+	// Iterations counts the iterations of the method and Evaluations the
+	// calls of the function.
+	Iterations, Evaluations int
+	// Converged is false when the iterations ran out before the tolerance
+	// was met.
+	Converged bool
+	// Unbounded is true when the search was stopped because f fell without
+	// limit; the result is then not converged.
+	Unbounded bool
+}
+
+// counter wraps a function, counting its evaluations and treating values
+// that are not finite as +Inf, so that minimisers steer away from points
+// where the function is undefined.
+type counter struct {
+	f           MultiFunc
+	evaluations int
+}
+
+func (c *counter) eval(x []float64) (float64, error) {
+	if c.evaluations >= MaxEvaluations {
+		return 0, ErrTooManyEvaluations
+	}
+	c.evaluations++
+	v, err := c.f(x)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 1) {
+		return math.Inf(1), nil
+	}
+	return v, nil
+}
+
+// unbounded reports whether f has fallen from f0 to fx by so much that it
+// has no minimum.
+func unbounded(f0, fx float64) bool {
+	return fx < f0-unboundedDrop*math.Max(1, math.Abs(f0))
+}
+
+// normInf returns the largest magnitude of the entries of v.
+func normInf(v []float64) float64 {
+	var m float64
+	for _, x := range v {
+		m = math.Max(m, math.Abs(x))
+	}
+	return m
+}
+
+// dot returns a·b.
+func dot(a, b []float64) float64 {
+	var s float64
+	for i := range a {
+		s += a[i] * b[i]
+	}
+	return s
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+)
+
+// invPhi is 1/φ, the fraction of an interval kept by each golden section
+// step, and invPhi2 = 1 − 1/φ = 1/φ².
+var (
+	invPhi  = (math.Sqrt(5) - 1) / 2
+	invPhi2 = (3 - math.Sqrt(5)) / 2
+	// sqrtEpsilon is the relative precision to which a minimum can be
+	// located: near it f changes only quadratically with x.
+	sqrtEpsilon = math.Sqrt(epsilon)
+)
+
+// maxPoleProbes bounds the points evaluated on each side of a minimum to
+// check that it is not a pole.
+const maxPoleProbes = 16
+
+// ScalarResult is the outcome of a minimisation in one variable.
+type ScalarResult struct {
+	X, Value                float64
+	Iterations, Evaluations int
+	// Converged is false when the iterations ran out before the tolerance
+	// was met.
+	Converged bool
+	// Unbounded is true when f falls without limit next to X, as at a
+	// pole; the result is then not converged.
+	Unbounded bool
+}
+
+// scalarSetup checks the interval [a, b] and orders its ends.
+func scalarSetup(f Func, a, b float64) (*counter, float64, float64, error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return nil, 0, 0, errors.New("interval must be finite")
+	}
+	if a == b {
+		return nil, 0, 0, errors.New("interval must have distinct ends")
+	}
+	if a > b {
+		a, b = b, a
+	}
+	return &counter{f: func(x []float64) (float64, error) { return f(x[0]) }}, a, b, nil
+}
+
+// scalarTolerance is the accuracy to which a minimum at x is located, for
+// an absolute tolerance tol.
+func scalarTolerance(x, tol float64) float64 {
+	return sqrtEpsilon*math.Abs(x) + tol/3
+}
+
+// GoldenSection minimises f on [a, b] by golden section search, shrinking
+// the interval around the lowest value found by a factor 1/φ per
+// evaluation until it is within tol, or the precision to which a minimum
+// can be located. For a unimodal f it finds the minimum; otherwise it finds
+// a local one. The ends of the interval are checked last, so a minimum there
+// is found exactly.
+func GoldenSection(f Func, a, b, tol float64, maxIter int) (ScalarResult, error) {
+	c, a, b, err := scalarSetup(f, a, b)
+	if err != nil {
+		return ScalarResult{}, err
+	}
+	a0, b0 := a, b
+	eval := func(x float64) (float64, error) { return c.eval([]float64{x}) }
+	x1, x2 := a+invPhi2*(b-a), a+invPhi*(b-a)
+	f1, err := eval(x1)
+	if err != nil {
+		return ScalarResult{}, err
+	}
+	f2, err := eval(x2)
+	if err != nil {
+		return ScalarResult{}, err
+	}
+	r := ScalarResult{}
+	for r.Iterations < maxIter {
+		if b-a <= 2*scalarTolerance((a+b)/2, tol) {
+			r.Converged = true
+			break
+		}
+		r.Iterations++
+		if f1 <= f2 {
+			b, x2, f2 = x2, x1, f1
+			x1 = a + invPhi2*(b-a)
+			if f1, err = eval(x1); err != nil {
+				return r, err
+			}
+		} else {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + invPhi*(b-a)
+			if f2, err = eval(x2); err != nil {
+				return r, err
+			}
+		}
+	}
+	r.X, r.Value = x1, f1
+	if f2 < f1 {
+		r.X, r.Value = x2, f2
+	}
+	if err := c.checkPole(&r, a, b); err != nil {
+		return r, err
+	}
+	return c.preferEnds(r, a0, b0)
+}
+
+// Brent minimises f on [a, b] by Brent's method, which fits parabolas
+// through the three best points and falls back on golden section steps
+// when they do not make progress, converging superlinearly for smooth
+// functions and never much more slowly than GoldenSection.
+func Brent(f Func, a, b, tol float64, maxIter int) (ScalarResult, error) {
+	c, a, b, err := scalarSetup(f, a, b)
+	if err != nil {
+		return ScalarResult{}, err
+	}
+	a0, b0 := a, b
+	eval := func(x float64) (float64, error) { return c.eval([]float64{x}) }
+	// x has the lowest value found, w the second lowest and v the previous
+	// value of w; d is the latest step and e the one before it
+	x := a + invPhi2*(b-a)
+	w, v := x, x
+	fx, err := eval(x)
+	if err != nil {
+		return ScalarResult{}, err
+	}
+	fw, fv := fx, fx
+	var d, e float64
+	r := ScalarResult{}
+	for r.Iterations < maxIter {
+		m := (a + b) / 2
+		tol1 := scalarTolerance(x, tol)
+		tol2 := 2 * tol1
+		if math.Abs(x-m) <= tol2-(b-a)/2 {
+			r.Converged = true
+			break
+		}
+		r.Iterations++
+		golden := true
+		if math.Abs(e) > tol1 && isFinite(fx) && isFinite(fw) && isFinite(fv) {
+			// Parabola through x, w and v
+			s := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*s
+			q = 2 * (q - s)
+			if q > 0 {
+				p = -p
+			}
+			q = math.Abs(q)
+			// Accept the parabolic step if it falls inside the interval
+			// and is less than half the step before last
+			if math.Abs(p) < math.Abs(q*e/2) && p > q*(a-x) && p < q*(b-x) {
+				e, d = d, p/q
+				u := x + d
+				if u-a < tol2 || b-u < tol2 {
+					d = math.Copysign(tol1, m-x)
+				}
+				golden = false
+			}
+		}
+		if golden {
+			if x < m {
+				e = b - x
+			} else {
+				e = a - x
+			}
+			d = invPhi2 * e
+		}
+		u := x + d
+		if math.Abs(d) < tol1 {
+			u = x + math.Copysign(tol1, d)
+		}
+		fu, err := eval(u)
+		if err != nil {
+			return r, err
+		}
+		if fu <= fx {
+			if u < x {
+				b = x
+			} else {
+				a = x
+			}
+			v, fv = w, fw
+			w, fw = x, fx
+			x, fx = u, fu
+		} else {
+			if u < x {
+				a = u
+			} else {
+				b = u
+			}
+			switch {
+			case fu <= fw || w == x:
+				v, fv = w, fw
+				w, fw = u, fu
+			case fu <= fv || v == x || v == w:
+				v, fv = u, fu
+			}
+		}
+	}
+	r.X, r.Value = x, fx
+	if err := c.checkPole(&r, a, b); err != nil {
+		return r, err
+	}
+	return c.preferEnds(r, a0, b0)
+}
+
+// checkPole marks r as unbounded if f falls without limit next to r.X, as
+// at a pole. The final interval [a, b] holds the pole, if there is one, and
+// f is nearly flat across it next to a minimum, so nothing more is done if
+// f at a and b is within sqrt(ε)·max(1, |r.Value|) of r.Value. Otherwise f
+// is evaluated at up to maxPoleProbes points approaching r.X from each end:
+// as the distance to a pole halves between two of them, the value of 1/x,
+// say, at least doubles, so that one point is lower than r.Value by more
+// than max(1, |r.Value|). Next to a minimum, none is lower by that much.
+func (c *counter) checkPole(r *ScalarResult, a, b float64) error {
+	drop := math.Max(1, math.Abs(r.Value))
+	flat := true
+	for _, end := range []float64{a, b} {
+		v, err := c.eval([]float64{end})
+		if err != nil {
+			return err
+		}
+		flat = flat && math.Abs(v-r.Value) <= sqrtEpsilon*drop
+	}
+	if flat {
+		return nil
+	}
+	for _, end := range []float64{a, b} {
+		t := end
+		for range maxPoleProbes {
+			next := (r.X + t) / 2
+			if next == r.X || next == t {
+				break
+			}
+			t = next
+			v, err := c.eval([]float64{t})
+			if err != nil {
+				return err
+			}
+			if v < r.Value-drop {
+				r.Converged, r.Unbounded = false, true
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// preferEnds replaces the minimum found inside [a, b] by an end of the
+// interval where f is lower, since the methods converge to a minimum at an
+// end only to within their tolerance, and fills in the evaluation count.
+func (c *counter) preferEnds(r ScalarResult, a, b float64) (ScalarResult, error) {
+	for _, end := range []float64{a, b} {
+		v, err := c.eval([]float64{end})
+		if err != nil {
+			return r, err
+		}
+		if v < r.Value {
+			r.X, r.Value = end, v
+		}
+	}
+	r.Evaluations = c.evaluations
+	return r, nil
+}
+
+// isFinite reports whether x is neither infinite nor NaN.
+func isFinite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exact(f func(float64) float64) Func {
+	return func(x float64) (float64, error) { return f(x), nil }
+}
+
+func TestScalarMinimizers(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"parabola", func(x float64) float64 { return (x - 2) * (x - 2) }, 0, 5, 2},
+		{"cosine", math.Cos, 0, 2 * math.Pi, math.Pi},
+		{"reversed interval", math.Cos, 2 * math.Pi, 0, math.Pi},
+		{"minimum at an end", func(x float64) float64 { return x }, -1, 3, -1},
+		{"quartic", func(x float64) float64 { return math.Pow(x-1, 4) + x/100 }, -3, 3, 1 - math.Cbrt(1.0/400)},
+		{"not smooth", func(x float64) float64 { return math.Abs(x - 0.3) }, -1, 1, 0.3},
+		{"undefined in part", func(x float64) float64 { return x*math.Log(x) - x }, -1, 3, 1},
+	}
+	for _, tt := range tests {
+		for _, method := range []struct {
+			name     string
+			minimize func(Func, float64, float64, float64, int) (ScalarResult, error)
+		}{{"golden", GoldenSection}, {"brent", Brent}} {
+			t.Run(tt.name+"/"+method.name, func(t *testing.T) {
+				r, err := method.minimize(exact(tt.f), tt.a, tt.b, 1e-10, 500)
+				require.NoError(t, err)
+				assert.True(t, r.Converged)
+				assert.InDelta(t, tt.want, r.X, 1e-6)
+				assert.Equal(t, tt.f(r.X), r.Value)
+				assert.Positive(t, r.Evaluations)
+			})
+		}
+	}
+}
+
+func TestBrentIsFaster(t *testing.T) {
+	f := exact(func(x float64) float64 { return math.Exp(x) - 2*x })
+	golden, err := GoldenSection(f, 0, 2, 1e-10, 500)
+	require.NoError(t, err)
+	brent, err := Brent(f, 0, 2, 1e-10, 500)
+	require.NoError(t, err)
+	assert.InDelta(t, math.Ln2, brent.X, 1e-7)
+	assert.Less(t, brent.Evaluations, golden.Evaluations/2)
+}
+
+func TestScalarNotConverged(t *testing.T) {
+	r, err := GoldenSection(exact(math.Cos), 0, 2*math.Pi, 0, 5)
+	require.NoError(t, err)
+	assert.False(t, r.Converged)
+	assert.Equal(t, 5, r.Iterations)
+	assert.InDelta(t, math.Pi, r.X, 1)
+}
+
+func TestScalarPole(t *testing.T) {
+	for name, minimize := range map[string]func(Func, float64, float64, float64, int) (ScalarResult, error){"brent": Brent, "golden": GoldenSection} {
+		t.Run(name, func(t *testing.T) {
+			r, err := minimize(exact(func(x float64) float64 { return 1 / (x - 0.3) }), -1, 1, 1e-8, 1000)
+			require.NoError(t, err)
+			assert.True(t, r.Unbounded)
+			assert.False(t, r.Converged)
+			assert.InDelta(t, 0.3, r.X, 1e-7)
+
+			// A steep minimum is not a pole
+			r, err = minimize(exact(func(x float64) float64 { return 1e20 * (x - 0.3) * (x - 0.3) }), -1, 1, 1e-8, 1000)
+			require.NoError(t, err)
+			assert.False(t, r.Unbounded)
+			assert.True(t, r.Converged)
+		})
+	}
+}
+
+func TestScalarErrors(t *testing.T) {
+	_, err := Brent(exact(math.Cos), 1, 1, 0, 100)
+	assert.EqualError(t, err, "interval must have distinct ends")
+	_, err = GoldenSection(exact(math.Cos), 0, math.Inf(1), 0, 100)
+	assert.EqualError(t, err, "interval must be finite")
+
+	failure := errors.New("failure")
+	_, err = Brent(func(float64) (float64, error) { return 0, failure }, 0, 1, 0, 100)
+	assert.ErrorIs(t, err, failure)
+}
@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Relation is the comparison of a linear constraint.
+type Relation string
+
+const (
+	LessEqual    Relation = "<="
+	GreaterEqual Relation = ">="
+	Equal        Relation = "="
+)
+
+// Constraint is the linear constraint Coefficients·x Relation RHS.
+type Constraint struct {
+	Coefficients []float64
+	Relation     Relation
+	RHS          float64
+}
+
+// LPStatus is the outcome of a linear program.
+type LPStatus string
+
+const (
+	// Optimal means a minimum was found.
+	Optimal LPStatus = "optimal"
+	// Infeasible means no point satisfies the constraints.
+	Infeasible LPStatus = "infeasible"
+	// Unbounded means the objective decreases without bound.
+	Unbounded LPStatus = "unbounded"
+)
+
+// LPResult is the outcome of LinearProgram. X and Value are set only when
+// the status is Optimal.
+type LPResult struct {
+	Status     LPStatus
+	X          []float64
+	Value      float64
+	Iterations int
+}
+
+const (
+	// pivotTolerance is the magnitude below which entries of the scaled
+	// tableau are taken as zero.
+	pivotTolerance = 1e-9
+	// blandAfter is the number of consecutive degenerate pivots after which
+	// the simplex method switches to Bland's rule, which cannot cycle.
+	blandAfter = 50
+	// maxPivots caps the pivots of a linear program.
+	maxPivots = 100_000
+)
+
+// ErrTooManyPivots is returned when the simplex method takes more than
+// maxPivots pivots.
+var ErrTooManyPivots = errors.New("linear program needs more than 100000 pivots")
+
+// tableau is a simplex tableau in canonical form: the columns of the basic
+// variables form an identity, and the last column is the right-hand side.
+type tableau struct {
+	rows  [][]float64
+	basis []int
+	// pivots counts all pivots and degenerate the latest run of those that
+	// did not move.
+	pivots, degenerate int
+}
+
+// pivot makes column j basic in row r.
+func (t *tableau) pivot(r, j int) {
+	row := t.rows[r]
+	p := row[j]
+	for k := range row {
+		row[k] /= p
+	}
+	for i, other := range t.rows {
+		if i == r || other[j] == 0 {
+			continue
+		}
+		f := other[j]
+		for k := range other {
+			other[k] -= f * row[k]
+		}
+		other[j] = 0
+	}
+	t.basis[r] = j
+	t.pivots++
+}
+
+// minimize runs the simplex method on the costs of the columns, returning
+// false if the objective is unbounded below.
+func (t *tableau) minimize(cost []float64) (bool, error) {
+	last := len(cost)
+	// Reduced costs: cost minus the costs of the basis times the tableau
+	reduced := make([]float64, len(cost))
+	for {
+		copy(reduced, cost)
+		for i, row := range t.rows {
+			if cb := cost[t.basis[i]]; cb != 0 {
+				for j := range reduced {
+					reduced[j] -= cb * row[j]
+				}
+			}
+		}
+		entering := -1
+		for j, r := range reduced {
+			if r >= -pivotTolerance {
+				continue
+			}
+			if t.degenerate >= blandAfter {
+				entering = j
+				break
+			}
+			if entering < 0 || r < reduced[entering] {
+				entering = j
+			}
+		}
+		if entering < 0 {
+			return true, nil
+		}
+		// Ratio test, breaking ties by the lowest basic variable
+		leaving := -1
+		var best float64
+		for i, row := range t.rows {
+			if row[entering] <= pivotTolerance {
+				continue
+			}
+			ratio := row[last] / row[entering]
+			if leaving < 0 || ratio < best || (ratio == best && t.basis[i] < t.basis[leaving]) {
+				leaving, best = i, ratio
+			}
+		}
+		if leaving < 0 {
+			return false, nil
+		}
+		if t.pivots >= maxPivots {
+			return false, ErrTooManyPivots
+		}
+		if best <= pivotTolerance {
+			t.degenerate++
+		} else {
+			t.degenerate = 0
+		}
+		t.pivot(leaving, entering)
+	}
+}
+
+// LinearProgram minimises objective·x subject to the constraints, and to
+// x ≥ 0 if nonnegative, by the two-phase simplex method. Infeasible and
+// unbounded programs are reported by the status rather than as errors.
+func LinearProgram(objective []float64, constraints []Constraint, nonnegative bool) (LPResult, error) {
+	n := len(objective)
+	if n == 0 {
+		return LPResult{}, errors.New("there must be at least one variable")
+	}
+	if !allFinite(objective) {
+		return LPResult{}, errors.New("objective coefficients must be finite")
+	}
+	for i, c := range constraints {
+		if len(c.Coefficients) != n {
+			return LPResult{}, fmt.Errorf("constraint %d has %d coefficients but there are %d variables", i+1, len(c.Coefficients), n)
+		}
+		if !allFinite(c.Coefficients) || !isFinite(c.RHS) {
+			return LPResult{}, fmt.Errorf("constraint %d must have finite coefficients", i+1)
+		}
+		switch c.Relation {
+		case LessEqual, GreaterEqual, Equal:
+		default:
+			return LPResult{}, fmt.Errorf("constraint %d has unknown relation %q", i+1, c.Relation)
+		}
+	}
+
+	// Free variables are split into the difference of two nonnegative ones
+	columns := n
+	if !nonnegative {
+		columns = 2 * n
+	}
+	expand := func(a []float64) []float64 {
+		row := make([]float64, columns)
+		copy(row, a)
+		if !nonnegative {
+			for j, v := range a {
+				row[n+j] = -v
+			}
+		}
+		return row
+	}
+
+	// Normalise each constraint to a nonnegative right-hand side and unit
+	// largest coefficient, dropping those without coefficients
+	type normalized struct {
+		a        []float64
+		relation Relation
+		b        float64
+	}
+	var rows []normalized
+	for _, c := range constraints {
+		a, relation, b := expand(c.Coefficients), c.Relation, c.RHS
+		scale := normInf(a)
+		if scale == 0 {
+			if (relation == LessEqual && b < 0) || (relation == GreaterEqual && b > 0) || (relation == Equal && b != 0) {
+				return LPResult{Status: Infeasible}, nil
+			}
+			continue
+		}
+		for j := range a {
+			a[j] /= scale
+		}
+		b /= scale
+		if b < 0 {
+			for j := range a {
+				a[j] = -a[j]
+			}
+			b = -b
+			switch relation {
+			case LessEqual:
+				relation = GreaterEqual
+			case GreaterEqual:
+				relation = LessEqual
+			}
+		}
+		rows = append(rows, normalized{a, relation, b})
+	}
+
+	// Columns: the variables, then a slack or surplus for each inequality,
+	// then an artificial for each row without a slack to start the basis
+	slacks, artificials := 0, 0
+	for _, r := range rows {
+		if r.relation != Equal {
+			slacks++
+		}
+		if r.relation != LessEqual {
+			artificials++
+		}
+	}
+	width := columns + slacks + artificials
+	t := &tableau{rows: make([][]float64, len(rows)), basis: make([]int, len(rows))}
+	slack, artificial := columns, columns+slacks
+	for i, r := range rows {
+		row := make([]float64, width+1)
+		copy(row, r.a)
+		row[width] = r.b
+		switch r.relation {
+		case LessEqual:
+			row[slack] = 1
+			t.basis[i] = slack
+			slack++
+		case GreaterEqual:
+			row[slack] = -1
+			slack++
+			fallthrough
+		case Equal:
+			row[artificial] = 1
+			t.basis[i] = artificial
+			artificial++
+		}
+		t.rows[i] = row
+	}
+
+	// Phase one minimises the sum of the artificials to find a feasible basis
+	if artificials > 0 {
+		cost := make([]float64, width)
+		for j := columns + slacks; j < width; j++ {
+			cost[j] = 1
+		}
+		if _, err := t.minimize(cost); err != nil {
+			return LPResult{Iterations: t.pivots}, err
+		}
+		var infeasibility float64
+		for i, row := range t.rows {
+			if t.basis[i] >= columns+slacks {
+				infeasibility += row[width]
+			}
+		}
+		if infeasibility > pivotTolerance*math.Max(1, float64(len(rows))) {
+			return LPResult{Status: Infeasible, Iterations: t.pivots}, nil
+		}
+		// Pivot artificials at zero out of the basis, dropping the rows
+		// where that is impossible, which are redundant
+		for i := 0; i < len(t.rows); i++ {
+			if t.basis[i] < columns+slacks {
+				continue
+			}
+			entering := -1
+			for j := range columns + slacks {
+				if math.Abs(t.rows[i][j]) > pivotTolerance {
+					entering = j
+					break
+				}
+			}
+			if entering < 0 {
+				t.rows = append(t.rows[:i], t.rows[i+1:]...)
+				t.basis = append(t.basis[:i], t.basis[i+1:]...)
+				i--
+				continue
+			}
+			t.pivot(i, entering)
+		}
+		width -= artificials
+		for i, row := range t.rows {
+			row[width] = row[len(row)-1]
+			t.rows[i] = row[:width+1]
+		}
+	}
+
+	// Phase two minimises the objective over the variables and slacks
+	cost := make([]float64, width)
+	copy(cost, expand(objective))
+	t.degenerate = 0
+	bounded, err := t.minimize(cost)
+	if err != nil {
+		return LPResult{Iterations: t.pivots}, err
+	}
+	if !bounded {
+		return LPResult{Status: Unbounded, Iterations: t.pivots}, nil
+	}
+	values := make([]float64, width)
+	for i, row := range t.rows {
+		values[t.basis[i]] = math.Max(row[width], 0)
+	}
+	x := make([]float64, n)
+	for j := range x {
+		x[j] = values[j]
+		if !nonnegative {
+			x[j] -= values[n+j]
+		}
+	}
+	return LPResult{Status: Optimal, X: x, Value: dot(objective, x), Iterations: t.pivots}, nil
+}
+
+// allFinite reports whether every value is finite.
+func allFinite(values []float64) bool {
+	for _, v := range values {
+		if !isFinite(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package optimize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinearProgram(t *testing.T) {
+	tests := []struct {
+		name        string
+		objective   []float64
+		constraints []Constraint
+		nonnegative bool
+		want        []float64
+		value       float64
+	}{
+		{
+			// Maximise 3x + 5y, the classic product mix
+			"inequalities",
+			[]float64{-3, -5},
+			[]Constraint{
+				{[]float64{1, 0}, LessEqual, 4},
+				{[]float64{0, 2}, LessEqual, 12},
+				{[]float64{3, 2}, LessEqual, 18},
+			},
+			true, []float64{2, 6}, -36,
+		},
+		{
+			"equality and lower bound",
+			[]float64{2, 3},
+			[]Constraint{
+				{[]float64{1, 1}, Equal, 10},
+				{[]float64{1, 0}, GreaterEqual, 3},
+				{[]float64{1, 0}, LessEqual, 8},
+			},
+			true, []float64{8, 2}, 22,
+		},
+		{
+			"negative right-hand side",
+			[]float64{1, 1},
+			[]Constraint{{[]float64{-1, -2}, LessEqual, -4}},
+			true, []float64{0, 2}, 2,
+		},
+		{
+			"free variables",
+			[]float64{1, 0},
+			[]Constraint{
+				{[]float64{1, -1}, GreaterEqual, -3},
+				{[]float64{1, 1}, GreaterEqual, -1},
+			},
+			false, []float64{-2, 1}, -2,
+		},
+		{
+			"redundant equalities",
+			[]float64{1, 2},
+			[]Constraint{
+				{[]float64{1, 1}, Equal, 2},
+				{[]float64{2, 2}, Equal, 4},
+			},
+			true, []float64{2, 0}, 2,
+		},
+		{
+			"degenerate",
+			[]float64{-10, 57, 9, 24},
+			[]Constraint{
+				{[]float64{0.5, -5.5, -2.5, 9}, LessEqual, 0},
+				{[]float64{0.5, -1.5, -0.5, 1}, LessEqual, 0},
+				{[]float64{1, 0, 0, 0}, LessEqual, 1},
+			},
+			true, []float64{1, 0, 1, 0}, -1,
+		},
+		{"no constraints", []float64{1, 2}, nil, true, []float64{0, 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := LinearProgram(tt.objective, tt.constraints, tt.nonnegative)
+			require.NoError(t, err)
+			require.Equal(t, Optimal, r.Status)
+			assert.InDeltaSlice(t, tt.want, r.X, 1e-9)
+			assert.InDelta(t, tt.value, r.Value, 1e-9)
+		})
+	}
+}
+
+func TestLinearProgramStatus(t *testing.T) {
+	r, err := LinearProgram([]float64{1, 1}, []Constraint{
+		{[]float64{1, 1}, LessEqual, 1},
+		{[]float64{1, 1}, GreaterEqual, 2},
+	}, true)
+	require.NoError(t, err)
+	assert.Equal(t, Infeasible, r.Status)
+	assert.Nil(t, r.X)
+
+	r, err = LinearProgram([]float64{1}, []Constraint{{[]float64{0}, Equal, 1}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, Infeasible, r.Status)
+
+	r, err = LinearProgram([]float64{-1, 0}, []Constraint{{[]float64{1, -1}, LessEqual, 1}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, Unbounded, r.Status)
+	assert.Nil(t, r.X)
+
+	r, err = LinearProgram([]float64{1}, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, Unbounded, r.Status)
+}
+
+func TestLinearProgramErrors(t *testing.T) {
+	_, err := LinearProgram(nil, nil, true)
+	assert.EqualError(t, err, "there must be at least one variable")
+	_, err = LinearProgram([]float64{1, 1}, []Constraint{{[]float64{1}, LessEqual, 1}}, true)
+	assert.EqualError(t, err, "constraint 1 has 1 coefficients but there are 2 variables")
+	_, err = LinearProgram([]float64{1}, []Constraint{{[]float64{1}, "<", 1}}, true)
+	assert.EqualError(t, err, `constraint 1 has unknown relation "<"`)
+}
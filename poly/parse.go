@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package poly
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sagacient/math-mcp-server/expr"
+)
+
+// Parse reads a polynomial written as an expression, such as "3x^3 - 2x + 1"
+// or "(x + 1)^2 (x - 2)/2", and expands it. The expression may use one
+// variable besides the built-in constants, which is returned, or none for a
+// constant polynomial. Built-in functions may appear only in constant terms.
+func Parse(s string) (Poly, string, error) {
+	n, err := expr.Parse(s)
+	if err != nil {
+		return nil, "", err
+	}
+	var vars []string
+	for _, name := range expr.FreeVars(n) {
+		if !expr.IsConstant(name) {
+			vars = append(vars, name)
+		}
+	}
+	if len(vars) > 1 {
+		return nil, "", fmt.Errorf("a polynomial must have a single variable, found %s", strings.Join(vars, ", "))
+	}
+	variable := ""
+	if len(vars) == 1 {
+		variable = vars[0]
+	}
+	p, err := fromNode(n, variable)
+	if err != nil {
+		return nil, "", err
+	}
+	return p, variable, nil
+}
+
+// fromNode expands n as a polynomial in variable.
+func fromNode(n expr.Node, variable string) (Poly, error) {
+	switch n := n.(type) {
+	case *expr.Num:
+		return Poly{n.Value}.Trim(), nil
+	case *expr.Var:
+		if n.Name == variable {
+			return Poly{0, 1}, nil
+		}
+		return constant(n)
+	case *expr.Unary:
+		x, err := fromNode(n.X, variable)
+		if err != nil {
+			return nil, err
+		}
+		return x.Scale(-1), nil
+	case *expr.Binary:
+		l, err := fromNode(n.L, variable)
+		if err != nil {
+			return nil, err
+		}
+		r, err := fromNode(n.R, variable)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case '+':
+			return l.Add(r), nil
+		case '-':
+			return l.Sub(r), nil
+		case '*':
+			if _, ok := MulDegree(l.Degree(), r.Degree()); !ok {
+				return nil, fmt.Errorf("polynomial degree exceeds %d", MaxDegree)
+			}
+			return l.Mul(r), nil
+		case '/':
+			if r.Degree() > 0 {
+				return nil, fmt.Errorf("%s divides by a non-constant, so it is not a polynomial", n)
+			}
+			if len(r) == 0 {
+				return nil, fmt.Errorf("%s divides by zero", n)
+			}
+			return l.Scale(1 / r[0]), nil
+		case '^':
+			return power(n, l, r)
+		}
+		return nil, fmt.Errorf("unknown operator %q", n.Op)
+	case *expr.Call:
+		if variable != "" && containsVar(n, variable) {
+			return nil, fmt.Errorf("%s appears inside %s, so the expression is not a polynomial", variable, n.Func)
+		}
+		return constant(n)
+	}
+	return nil, fmt.Errorf("unsupported expression node %T", n)
+}
+
+// constant evaluates a term that does not involve the variable.
+func constant(n expr.Node) (Poly, error) {
+	v, err := expr.Eval(n, nil)
+	if err != nil {
+		return nil, err
+	}
+	return Poly{v}.Trim(), nil
+}
+
+// power expands base^exp for a constant exponent, which must be a
+// non-negative integer unless the base is constant too.
+func power(n *expr.Binary, base, exp Poly) (Poly, error) {
+	if exp.Degree() > 0 {
+		return nil, fmt.Errorf("%s has a variable exponent, so it is not a polynomial", n)
+	}
+	var e float64
+	if len(exp) > 0 {
+		e = exp[0]
+	}
+	if base.Degree() <= 0 {
+		var b float64
+		if len(base) > 0 {
+			b = base[0]
+		}
+		return Poly{math.Pow(b, e)}.Trim(), nil
+	}
+	if e < 0 || e != math.Trunc(e) {
+		return nil, fmt.Errorf("%s needs a non-negative integer exponent to be a polynomial", n)
+	}
+	if e*float64(base.Degree()) > MaxDegree {
+		return nil, fmt.Errorf("polynomial degree exceeds %d", MaxDegree)
+	}
+	out := Poly{1}
+	for k := int(e); k > 0; k >>= 1 {
+		if k&1 == 1 {
+			out = out.Mul(base)
+		}
+		if k > 1 {
+			base = base.Mul(base)
+		}
+	}
+	return out, nil
+}
+
+// containsVar reports whether n refers to variable.
+func containsVar(n expr.Node, variable string) bool {
+	found := false
+	expr.Walk(n, func(n expr.Node) {
+		if v, ok := n.(*expr.Var); ok && v.Name == variable {
+			found = true
+		}
+	})
+	return found
+}
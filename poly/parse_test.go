@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package poly
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     []float64
+		variable string
+	}{
+		{"3x^3 - 2x + 1", []float64{3, 0, -2, 1}, "x"},
+		{"(x + 1)^3", []float64{1, 3, 3, 1}, "x"},
+		{"(t - 1)(t + 1)/2", []float64{0.5, 0, -0.5}, "t"},
+		{"-x^2", []float64{-1, 0, 0}, "x"},
+		{"x**2 + 2*x", []float64{1, 2, 0}, "x"},
+		{"pi x + sqrt(4)", []float64{math.Pi, 2}, "x"},
+		{"2^3 x", []float64{8, 0}, "x"},
+		{"x^0", []float64{1}, "x"},
+		{"7", []float64{7}, ""},
+		{"x - x", []float64{0}, "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p, variable, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p.Descending())
+			assert.Equal(t, tt.variable, variable)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"x + y", "single variable, found x, y"},
+		{"1/x", "divides by a non-constant"},
+		{"x/0", "divides by zero"},
+		{"x^-1", "non-negative integer exponent"},
+		{"x^0.5", "non-negative integer exponent"},
+		{"2^x", "variable exponent"},
+		{"sin(x)", "x appears inside sin"},
+		{"x^20000", "degree exceeds"},
+		{"(x^5000)(x^5001)", "degree exceeds"},
+		{"3x +", "unexpected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, _, err := Parse(tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
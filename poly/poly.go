@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package poly implements arithmetic on real polynomials in one variable.
+package poly
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// MaxDegree caps the degree of polynomials built by Parse and of products
+// checked with MulDegree, so that a short input cannot demand unbounded work.
+const MaxDegree = 10000
+
+// ErrDivisionByZero is returned when dividing by the zero polynomial.
+var ErrDivisionByZero = errors.New("division by the zero polynomial")
+
+// Poly is a real polynomial with coefficients in increasing order of degree:
+// p[i] multiplies xⁱ. Results are trimmed, so the last coefficient is nonzero
+// and the zero polynomial has no coefficients.
+type Poly []float64
+
+// FromDescending builds a polynomial from coefficients listed highest degree
+// first, as people write them.
+func FromDescending(coef []float64) Poly {
+	p := Poly(slices.Clone(coef))
+	slices.Reverse(p)
+	return p.Trim()
+}
+
+// Descending returns the coefficients highest degree first. The zero
+// polynomial gives [0].
+func (p Poly) Descending() []float64 {
+	p = p.Trim()
+	if len(p) == 0 {
+		return []float64{0}
+	}
+	coef := slices.Clone(p)
+	slices.Reverse(coef)
+	return coef
+}
+
+// Trim drops zero leading coefficients.
+func (p Poly) Trim() Poly {
+	n := len(p)
+	for n > 0 && p[n-1] == 0 {
+		n--
+	}
+	return p[:n:n]
+}
+
+// Degree returns the degree of p, or -1 for the zero polynomial.
+func (p Poly) Degree() int {
+	return len(p.Trim()) - 1
+}
+
+// Eval evaluates p at x by Horner's method.
+func (p Poly) Eval(x float64) float64 {
+	var y float64
+	for i := len(p) - 1; i >= 0; i-- {
+		y = math.FMA(y, x, p[i])
+	}
+	return y
+}
+
+// EvalComplex evaluates p at the complex point z by Horner's method.
+func (p Poly) EvalComplex(z complex128) complex128 {
+	var y complex128
+	for i := len(p) - 1; i >= 0; i-- {
+		y = y*z + complex(p[i], 0)
+	}
+	return y
+}
+
+// Add returns p + q.
+func (p Poly) Add(q Poly) Poly {
+	sum := make(Poly, max(len(p), len(q)))
+	copy(sum, p)
+	for i, c := range q {
+		sum[i] += c
+	}
+	return sum.Trim()
+}
+
+// Sub returns p − q.
+func (p Poly) Sub(q Poly) Poly {
+	diff := make(Poly, max(len(p), len(q)))
+	copy(diff, p)
+	for i, c := range q {
+		diff[i] -= c
+	}
+	return diff.Trim()
+}
+
+// Scale returns c·p.
+func (p Poly) Scale(c float64) Poly {
+	out := make(Poly, len(p))
+	for i, v := range p {
+		out[i] = c * v
+	}
+	return out.Trim()
+}
+
+// Mul returns p·q.
+func (p Poly) Mul(q Poly) Poly {
+	p, q = p.Trim(), q.Trim()
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	prod := make(Poly, len(p)+len(q)-1)
+	for i, a := range p {
+		for j, b := range q {
+			prod[i+j] += a * b
+		}
+	}
+	return prod.Trim()
+}
+
+// MulDegree returns the degree of a product of polynomials of degrees a and
+// b, and whether it is within MaxDegree.
+func MulDegree(a, b int) (int, bool) {
+	if a < 0 || b < 0 {
+		return -1, true
+	}
+	return a + b, a+b <= MaxDegree
+}
+
+// DivMod divides p by d, returning the quotient and a remainder of lower
+// degree than d.
+func (p Poly) DivMod(d Poly) (quot, rem Poly, err error) {
+	p, d = p.Trim(), d.Trim()
+	if len(d) == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+	if len(p) < len(d) {
+		return nil, slices.Clone(p), nil
+	}
+	rem = slices.Clone(p)
+	quot = make(Poly, len(p)-len(d)+1)
+	lead := d[len(d)-1]
+	for i := len(quot) - 1; i >= 0; i-- {
+		c := rem[i+len(d)-1] / lead
+		quot[i] = c
+		for j, v := range d[:len(d)-1] {
+			rem[i+j] -= c * v
+		}
+		// The leading term cancels exactly by construction
+		rem[i+len(d)-1] = 0
+	}
+	return quot.Trim(), rem[:len(d)-1].Trim(), nil
+}
+
+// Monic returns p divided by its leading coefficient.
+func (p Poly) Monic() Poly {
+	p = p.Trim()
+	if len(p) == 0 {
+		return nil
+	}
+	out := p.Scale(1 / p[len(p)-1])
+	out[len(out)-1] = 1
+	return out
+}
+
+// chop zeroes coefficients no larger than tol in magnitude.
+func (p Poly) chop(tol float64) Poly {
+	out := slices.Clone(p)
+	for i, c := range out {
+		if math.Abs(c) <= tol {
+			out[i] = 0
+		}
+	}
+	return out.Trim()
+}
+
+// maxAbs returns the largest coefficient magnitude of p.
+func (p Poly) maxAbs() float64 {
+	var m float64
+	for _, c := range p {
+		m = math.Max(m, math.Abs(c))
+	}
+	return m
+}
+
+// GCD returns the monic greatest common divisor of p and q by Euclid's
+// algorithm. Remainder coefficients within tol of zero, relative to the
+// coefficients being divided, are treated as zero, so that common factors
+// are found despite rounding. The GCD of two zero polynomials is zero.
+func GCD(p, q Poly, tol float64) Poly {
+	a, b := p.Monic(), q.Monic()
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	for len(b) > 0 {
+		_, r, _ := a.DivMod(b)
+		r = r.chop(tol * math.Max(a.maxAbs(), b.maxAbs()))
+		a, b = b, r.Monic()
+	}
+	return a
+}
+
+// Compose returns p(q(x)).
+func (p Poly) Compose(q Poly) Poly {
+	var out Poly
+	for i := len(p) - 1; i >= 0; i-- {
+		out = out.Mul(q).Add(Poly{p[i]})
+	}
+	return out.Trim()
+}
+
+// Derivative returns dp/dx.
+func (p Poly) Derivative() Poly {
+	p = p.Trim()
+	if len(p) <= 1 {
+		return nil
+	}
+	out := make(Poly, len(p)-1)
+	for i := 1; i < len(p); i++ {
+		out[i-1] = float64(i) * p[i]
+	}
+	return out.Trim()
+}
+
+// Antiderivative returns the antiderivative of p with constant term c.
+func (p Poly) Antiderivative(c float64) Poly {
+	p = p.Trim()
+	out := make(Poly, len(p)+1)
+	out[0] = c
+	for i, v := range p {
+		out[i+1] = v / float64(i+1)
+	}
+	return out.Trim()
+}
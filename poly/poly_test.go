@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package poly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescending(t *testing.T) {
+	p := FromDescending([]float64{0, 0, 3, 0, -2})
+	assert.Equal(t, Poly{-2, 0, 3}, p)
+	assert.Equal(t, 2, p.Degree())
+	assert.Equal(t, []float64{3, 0, -2}, p.Descending())
+
+	assert.Equal(t, -1, FromDescending([]float64{0, 0}).Degree())
+	assert.Equal(t, []float64{0}, Poly(nil).Descending())
+}
+
+func TestEval(t *testing.T) {
+	p := FromDescending([]float64{3, 0, -2, 1})
+	assert.Equal(t, 21.0, p.Eval(2))
+	assert.Equal(t, 1.0, p.Eval(0))
+	assert.Zero(t, Poly(nil).Eval(5))
+
+	// x² + 1 vanishes at ±i
+	q := FromDescending([]float64{1, 0, 1})
+	assert.Equal(t, complex(0, 0), q.EvalComplex(1i))
+	assert.Equal(t, complex(-1, 4), FromDescending([]float64{1, 0, 2}).EvalComplex(complex(1, 2)))
+}
+
+func TestArithmetic(t *testing.T) {
+	p := FromDescending([]float64{1, 2, 3})
+	q := FromDescending([]float64{-1, 0, 1})
+
+	assert.Equal(t, []float64{2, 4}, p.Add(q).Descending())
+	assert.Equal(t, []float64{2, 2, 2}, p.Sub(q).Descending())
+	assert.Equal(t, []float64{0}, p.Sub(p).Descending())
+	assert.Equal(t, []float64{-1, -2, -2, 2, 3}, p.Mul(q).Descending())
+	assert.Nil(t, p.Mul(nil))
+
+	deg, ok := MulDegree(MaxDegree, 1)
+	assert.Equal(t, MaxDegree+1, deg)
+	assert.False(t, ok)
+	_, ok = MulDegree(-1, MaxDegree+5)
+	assert.True(t, ok)
+}
+
+func TestDivMod(t *testing.T) {
+	tests := []struct {
+		name      string
+		p, d      []float64
+		quot, rem []float64
+	}{
+		{"exact", []float64{1, -3, 2}, []float64{1, -1}, []float64{1, -2}, []float64{0}},
+		{"with remainder", []float64{1, 0, 0, 1}, []float64{1, 1, 0}, []float64{1, -1}, []float64{1, 1}},
+		{"lower degree", []float64{2, 1}, []float64{1, 0, 0}, []float64{0}, []float64{2, 1}},
+		{"by constant", []float64{4, 2}, []float64{2}, []float64{2, 1}, []float64{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, r, err := FromDescending(tt.p).DivMod(FromDescending(tt.d))
+			require.NoError(t, err)
+			assert.Equal(t, tt.quot, q.Descending())
+			assert.Equal(t, tt.rem, r.Descending())
+		})
+	}
+
+	_, _, err := FromDescending([]float64{1, 2}).DivMod(nil)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		name string
+		p, q []float64
+		want []float64
+	}{
+		{"common root", []float64{1, -3, 2}, []float64{1, -4, 3}, []float64{1, -1}},
+		{"coprime", []float64{1, 0, 1}, []float64{1, -1}, []float64{1}},
+		{"divides", []float64{2, -4, 2}, []float64{3, -3}, []float64{1, -1}},
+		{"with zero", []float64{2, 4}, []float64{0}, []float64{1, 2}},
+		{"both zero", []float64{0}, []float64{0}, []float64{0}},
+		// (x − 0.1)(x − 0.3) and (x − 0.1)(x + 0.7) share x − 0.1 up to rounding
+		{"inexact", []float64{1, -0.4, 0.03}, []float64{1, 0.6, -0.07}, []float64{1, -0.1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GCD(FromDescending(tt.p), FromDescending(tt.q), 1e-10).Descending()
+			assert.InDeltaSlice(t, tt.want, got, 1e-12)
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	p := FromDescending([]float64{1, 0, 1})
+	q := FromDescending([]float64{2, 1})
+	assert.Equal(t, []float64{4, 4, 2}, p.Compose(q).Descending())
+	assert.Equal(t, []float64{2, 0, 3}, q.Compose(p).Descending())
+	assert.Equal(t, []float64{0}, Poly(nil).Compose(q).Descending())
+}
+
+func TestCalculus(t *testing.T) {
+	p := FromDescending([]float64{3, 0, -2, 1})
+	assert.Equal(t, []float64{9, 0, -2}, p.Derivative().Descending())
+	assert.Equal(t, []float64{0}, Poly{5}.Derivative().Descending())
+	assert.Equal(t, []float64{0.75, 0, -1, 1, 4}, p.Antiderivative(4).Descending())
+	assert.Equal(t, []float64{0}, Poly(nil).Antiderivative(0).Descending())
+}
@@ -0,0 +1,470 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package poly
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"math/cmplx"
+	"slices"
+)
+
+const (
+	// epsilon is the unit roundoff of float64.
+	epsilon = 0x1p-52
+	// maxAberthIterations bounds the simultaneous iteration for degree 5 and
+	// above; it converges in far fewer steps in practice.
+	maxAberthIterations = 1000
+	// maxPolishIterations bounds the Newton steps that refine each root.
+	maxPolishIterations = 10
+	// squareFreeTolerance is the GCD tolerance used to detect repeated roots.
+	squareFreeTolerance = 1e-10
+	// coefficientRounding is the relative error of rounding a coefficient to
+	// float64, the most by which p may miss having a repeated root exactly.
+	coefficientRounding = epsilon / 2
+)
+
+// ErrNoConvergence is returned when the roots could not be computed to full
+// accuracy.
+var ErrNoConvergence = errors.New("root finding did not converge")
+
+// Root is a root of a polynomial with its multiplicity.
+type Root struct {
+	Value        complex128
+	Multiplicity int
+}
+
+// Roots returns the distinct roots of p, real or complex, sorted by real and
+// then imaginary part. Repeated roots are found by square-free factorization,
+// so a root of multiplicity m is reported once and as accurately as a simple
+// one; this relies on the repeated factor being exact up to rounding. Since
+// the factorization cannot tell a repeated root from distinct roots closer
+// than its tolerance, each repeated root is checked against the coefficients
+// of p and the roots of p found directly, and those are reported instead
+// unless rounding alone would explain both.
+// Polynomials of degree at most 4 are solved in closed form and higher
+// degrees by the Aberth–Ehrlich iteration, after which each root is refined
+// by Newton's method. Constant polynomials have no roots listed.
+func (p Poly) Roots() ([]Root, error) {
+	p = p.Trim()
+	var roots []Root
+	// Factor out x^k so that zero roots are exact
+	k := 0
+	for k < len(p)-1 && p[k] == 0 {
+		k++
+	}
+	if k > 0 {
+		roots = append(roots, Root{Multiplicity: k})
+		p = p[k:]
+	}
+
+	found, failed := p.factorRoots(squareFree(p))
+	if slices.ContainsFunc(found, func(r Root) bool { return r.Multiplicity > 1 }) {
+		direct, directFailed := p.factorRoots([]Poly{p})
+		if !p.clustered(found, direct) {
+			found, failed = direct, directFailed
+		}
+	}
+	roots = append(roots, found...)
+	slices.SortFunc(roots, func(a, b Root) int {
+		if c := cmp.Compare(real(a.Value), real(b.Value)); c != 0 {
+			return c
+		}
+		return cmp.Compare(imag(a.Value), imag(b.Value))
+	})
+	if failed {
+		return roots, ErrNoConvergence
+	}
+	return roots, nil
+}
+
+// factorRoots returns the roots of the factors of p from squareFree, where
+// the roots of factors[m] have multiplicity m+1, and reports whether any
+// factor failed to converge.
+func (p Poly) factorRoots(factors []Poly) ([]Root, bool) {
+	var roots []Root
+	var failed bool
+	for m, f := range factors {
+		values, err := simpleRoots(f)
+		if err != nil {
+			failed = true
+		}
+		for _, z := range values {
+			// Refining against p itself avoids the rounding errors of the
+			// factor
+			z = p.snapReal(p.polish(z, m+1))
+			if imag(z) == 0 {
+				z = complex(p.polishReal(real(z), m+1), 0)
+			}
+			roots = append(roots, Root{Value: z, Multiplicity: m + 1})
+		}
+	}
+	return roots, failed
+}
+
+// clustered reports whether each repeated root in found is confirmed: a root
+// z of multiplicity m needs p to be within rounding of its coefficients of
+// having an m-fold root there, and m simple roots in direct within the
+// distance (m!·δ/|p⁽ᵐ⁾(z)|)^(1/m) by which rounding errors δ in evaluating p
+// near z can move an m-fold root.
+func (p Poly) clustered(found, direct []Root) bool {
+	for _, r := range found {
+		m := r.Multiplicity
+		if m == 1 {
+			continue
+		}
+		if len(direct) < m || p.multipleError(r.Value, m) > coefficientRounding {
+			return false
+		}
+		// Horner's rounding error bound 2nε·Σ|cᵢ||z|ⁱ, as in snapReal
+		var bound float64
+		for i := len(p) - 1; i >= 0; i-- {
+			bound = bound*cmplx.Abs(r.Value) + math.Abs(p[i])
+		}
+		bound *= 2 * float64(len(p)) * epsilon
+		// The Taylor coefficient p⁽ᵐ⁾(z)/m!
+		d := p
+		factorial := 1.0
+		for k := 1; k <= m; k++ {
+			d = d.Derivative()
+			factorial *= float64(k)
+		}
+		coef := cmplx.Abs(d.EvalComplex(r.Value)) / factorial
+		if coef == 0 {
+			continue
+		}
+		radius := math.Pow(bound/coef, 1/float64(m))
+
+		distances := make([]float64, len(direct))
+		for i, s := range direct {
+			distances[i] = cmplx.Abs(s.Value - r.Value)
+		}
+		slices.Sort(distances)
+		if distances[m-1] > radius {
+			return false
+		}
+	}
+	return true
+}
+
+// multipleError estimates the smallest relative change to the coefficients
+// of p that makes a point near z a root of multiplicity m. The point is the
+// root of p⁽ᵐ⁻¹⁾ found by Newton's method from z, and p⁽ᵏ⁾ for k < m−1 must
+// vanish there too, which each coefficient moving by a fraction η of itself
+// can achieve only if |p⁽ᵏ⁾| is at most η times the same derivative of the
+// polynomial with coefficients |cᵢ|.
+func (p Poly) multipleError(z complex128, m int) float64 {
+	d := p
+	for range m - 1 {
+		d = d.Derivative()
+	}
+	slope := d.Derivative()
+	for range maxPolishIterations {
+		s := slope.EvalComplex(z)
+		if s == 0 {
+			break
+		}
+		step := d.EvalComplex(z) / s
+		if step == 0 {
+			break
+		}
+		z -= step
+	}
+	if cmplx.IsNaN(z) || cmplx.IsInf(z) {
+		return math.Inf(1)
+	}
+
+	abs := make(Poly, len(p))
+	for i, c := range p {
+		abs[i] = math.Abs(c)
+	}
+	var worst float64
+	for range m - 1 {
+		var v float64
+		if imag(z) == 0 {
+			v = math.Abs(p.evalCompensated(real(z)))
+		} else {
+			v = cmplx.Abs(p.EvalComplex(z))
+		}
+		worst = math.Max(worst, v/abs.Eval(cmplx.Abs(z)))
+		p, abs = p.Derivative(), abs.Derivative()
+	}
+	return worst
+}
+
+// squareFree splits p by Yun's algorithm into factors f[0], f[1], … with no
+// repeated roots such that p is a constant times ∏ f[i]^(i+1). If rounding
+// keeps the factor degrees from adding up, p itself is returned as a single
+// factor.
+func squareFree(p Poly) []Poly {
+	if p.Degree() < 2 {
+		return []Poly{p}
+	}
+	d := p.Derivative()
+	a := GCD(p, d, squareFreeTolerance)
+	if a.Degree() == 0 {
+		return []Poly{p}
+	}
+	b, _, _ := p.DivMod(a)
+	c, _, _ := d.DivMod(a)
+	var factors []Poly
+	degree := 0
+	for b.Degree() > 0 {
+		d = c.Sub(b.Derivative())
+		a = GCD(b, d, squareFreeTolerance)
+		factors = append(factors, a)
+		degree += (len(factors)) * max(a.Degree(), 0)
+		b, _, _ = b.DivMod(a)
+		c, _, _ = d.DivMod(a)
+		if len(factors) > p.Degree() {
+			break
+		}
+	}
+	if degree != p.Degree() {
+		return []Poly{p}
+	}
+	return factors
+}
+
+// simpleRoots returns the roots of p, which should have no repeated roots.
+func simpleRoots(p Poly) ([]complex128, error) {
+	var (
+		roots []complex128
+		err   error
+	)
+	switch p.Degree() {
+	case -1, 0:
+		return nil, nil
+	case 1:
+		return []complex128{complex(-p[0]/p[1], 0)}, nil
+	case 2:
+		// The closed form is already as accurate as the coefficients allow
+		return quadraticRoots(p[2], p[1], p[0]), nil
+	case 3:
+		roots = cubicRoots(p[2]/p[3], p[1]/p[3], p[0]/p[3])
+	case 4:
+		roots = quarticRoots(p[3]/p[4], p[2]/p[4], p[1]/p[4], p[0]/p[4])
+		// Ferrari's method can break down when the depressed quartic is
+		// nearly biquadratic
+		if slices.ContainsFunc(roots, func(z complex128) bool { return cmplx.IsNaN(z) || cmplx.IsInf(z) }) {
+			roots, err = aberth(p)
+		}
+	default:
+		roots, err = aberth(p)
+	}
+	return roots, err
+}
+
+// quadraticRoots solves ax² + bx + c = 0 without cancellation.
+func quadraticRoots(a, b, c float64) []complex128 {
+	// b² − 4ac with a single rounding
+	w := 4 * a * c
+	disc := math.FMA(b, b, -w) - math.FMA(4*a, c, -w)
+	if disc < 0 {
+		re := -b / (2 * a)
+		im := math.Sqrt(-disc) / (2 * math.Abs(a))
+		return []complex128{complex(re, -im), complex(re, im)}
+	}
+	q := -(b + math.Copysign(math.Sqrt(disc), b)) / 2
+	if q == 0 {
+		return []complex128{0, 0}
+	}
+	return []complex128{complex(q/a, 0), complex(c/q, 0)}
+}
+
+// cubicRoots solves x³ + ax² + bx + c = 0 by Cardano's formula, or by the
+// trigonometric method when there are three real roots.
+func cubicRoots(a, b, c float64) []complex128 {
+	// Depressed cubic t³ + pt + q = 0 with x = t − a/3
+	shift := a / 3
+	p := b - a*shift
+	q := 2*shift*shift*shift - shift*b + c
+	disc := q*q/4 + p*p*p/27
+	if disc < 0 {
+		r := 2 * math.Sqrt(-p/3)
+		theta := math.Acos(math.Max(-1, math.Min(1, 3*q/(p*r)))) / 3
+		roots := make([]complex128, 3)
+		for k := range roots {
+			roots[k] = complex(r*math.Cos(theta-2*math.Pi*float64(k)/3)-shift, 0)
+		}
+		return roots
+	}
+	u := -math.Copysign(math.Cbrt(math.Abs(q)/2+math.Sqrt(disc)), q)
+	var v float64
+	if u != 0 {
+		v = -p / (3 * u)
+	}
+	re := -(u+v)/2 - shift
+	im := math.Sqrt(3) / 2 * (u - v)
+	return []complex128{complex(u+v-shift, 0), complex(re, im), complex(re, -im)}
+}
+
+// quarticRoots solves x⁴ + ax³ + bx² + cx + d = 0 by Ferrari's method.
+func quarticRoots(a, b, c, d float64) []complex128 {
+	// Depressed quartic y⁴ + py² + qy + r = 0 with x = y − a/4
+	shift := a / 4
+	a2 := a * a
+	p := b - 3*a2/8
+	q := c - a*b/2 + a2*a/8
+	r := d - a*c/4 + a2*b/16 - 3*a2*a2/256
+
+	var ys []complex128
+	if q == 0 {
+		// Biquadratic: y² is a root of z² + pz + r
+		for _, z := range quadraticRoots(1, p, r) {
+			s := cmplx.Sqrt(z)
+			ys = append(ys, s, -s)
+		}
+	} else {
+		// A positive root m of the resolvent 8m³ + 8pm² + (2p² − 8r)m − q²,
+		// which exists because the cubic is negative at 0
+		var m float64
+		for _, z := range cubicRoots(p, p*p/4-r, -q*q/8) {
+			if imag(z) == 0 {
+				m = math.Max(m, real(z))
+			}
+		}
+		s := math.Sqrt(2 * m)
+		for _, sign := range []float64{1, -1} {
+			t := cmplx.Sqrt(complex(-(2*p + 2*m + sign*math.Sqrt2*q/math.Sqrt(m)), 0))
+			ys = append(ys, (complex(sign*s, 0)+t)/2, (complex(sign*s, 0)-t)/2)
+		}
+	}
+	for i := range ys {
+		ys[i] -= complex(shift, 0)
+	}
+	return ys
+}
+
+// aberth finds all roots of p simultaneously by the Aberth–Ehrlich method.
+func aberth(p Poly) ([]complex128, error) {
+	n := p.Degree()
+	d := p.Derivative()
+	// Start on a circle whose radius is the geometric mean of the root
+	// magnitudes, rotated off the real axis
+	radius := math.Pow(math.Abs(p[0]/p[n]), 1/float64(n))
+	if radius == 0 || math.IsInf(radius, 0) || math.IsNaN(radius) {
+		radius = 1
+	}
+	z := make([]complex128, n)
+	for k := range z {
+		z[k] = cmplx.Rect(radius, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+
+	for range maxAberthIterations {
+		converged := true
+		for k := range z {
+			ratio := p.EvalComplex(z[k]) / d.EvalComplex(z[k])
+			if ratio == 0 {
+				continue
+			}
+			var sum complex128
+			for j := range z {
+				if j != k {
+					sum += 1 / (z[k] - z[j])
+				}
+			}
+			w := ratio / (1 - ratio*sum)
+			if cmplx.IsNaN(w) || cmplx.IsInf(w) {
+				continue
+			}
+			z[k] -= w
+			if cmplx.Abs(w) > 4*epsilon*cmplx.Abs(z[k]) {
+				converged = false
+			}
+		}
+		if converged {
+			return z, nil
+		}
+	}
+	return z, ErrNoConvergence
+}
+
+// polish refines a root z of p of multiplicity m by Newton's method with
+// steps scaled by m, which converges quadratically at multiple roots too,
+// keeping each step only while it reduces the residual.
+func (p Poly) polish(z complex128, m int) complex128 {
+	d := p.Derivative()
+	residual := cmplx.Abs(p.EvalComplex(z))
+	for range maxPolishIterations {
+		if residual == 0 {
+			break
+		}
+		slope := d.EvalComplex(z)
+		if slope == 0 {
+			break
+		}
+		next := z - complex(float64(m), 0)*p.EvalComplex(z)/slope
+		r := cmplx.Abs(p.EvalComplex(next))
+		if !(r < residual) {
+			break
+		}
+		z, residual = next, r
+	}
+	return z
+}
+
+// polishReal refines a real root x of p of multiplicity m like polish, but
+// with residuals evaluated in twice the working precision, so that roots of
+// well-conditioned polynomials come out correctly rounded.
+func (p Poly) polishReal(x float64, m int) float64 {
+	d := p.Derivative()
+	residual := math.Abs(p.evalCompensated(x))
+	for range maxPolishIterations {
+		if residual == 0 {
+			break
+		}
+		slope := d.Eval(x)
+		if slope == 0 {
+			break
+		}
+		next := x - float64(m)*p.evalCompensated(x)/slope
+		r := math.Abs(p.evalCompensated(next))
+		if !(r < residual) {
+			break
+		}
+		x, residual = next, r
+	}
+	return x
+}
+
+// evalCompensated evaluates p at x by Horner's method, carrying the rounding
+// error of each step in a second accumulator (Graillat, Langlois and Louvet's
+// compensated Horner scheme).
+func (p Poly) evalCompensated(x float64) float64 {
+	var s, c float64
+	for i := len(p) - 1; i >= 0; i-- {
+		// Error-free product and sum: prod + perr = s·x, sum + serr = prod + p[i]
+		prod := s * x
+		perr := math.FMA(s, x, -prod)
+		sum := prod + p[i]
+		t := sum - prod
+		serr := (prod - (sum - t)) + (p[i] - t)
+		s = sum
+		c = math.FMA(c, x, perr+serr)
+	}
+	return s + c
+}
+
+// snapReal drops a tiny imaginary part of z when its real part is a root of
+// p to within the rounding error of evaluating p there.
+func (p Poly) snapReal(z complex128) complex128 {
+	re, im := real(z), imag(z)
+	if im == 0 || math.Abs(im) > math.Sqrt(epsilon)*math.Abs(re) {
+		return z
+	}
+	// Horner's rounding error bound 2nε·Σ|cᵢ||x|ⁱ
+	var bound float64
+	for i := len(p) - 1; i >= 0; i-- {
+		bound = bound*math.Abs(re) + math.Abs(p[i])
+	}
+	bound *= 2 * float64(len(p)) * epsilon
+	if math.Abs(p.Eval(re)) <= bound {
+		return complex(re, 0)
+	}
+	return z
+}
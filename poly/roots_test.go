@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fromRoots expands ∏ (x − rᵢ) for real roots.
+func fromRoots(roots ...float64) Poly {
+	p := Poly{1}
+	for _, r := range roots {
+		p = p.Mul(Poly{-r, 1})
+	}
+	return p
+}
+
+// assertRoots checks roots against want, which is sorted the same way.
+func assertRoots(t *testing.T, want []Root, got []Root, delta float64) {
+	t.Helper()
+	require.Len(t, got, len(want), "%v", got)
+	for i := range want {
+		assert.Equal(t, want[i].Multiplicity, got[i].Multiplicity, "multiplicity of root %d", i)
+		assert.InDelta(t, real(want[i].Value), real(got[i].Value), delta, "root %d", i)
+		assert.InDelta(t, imag(want[i].Value), imag(got[i].Value), delta, "root %d", i)
+	}
+}
+
+func TestRoots(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Poly
+		want []Root
+	}{
+		{"linear", FromDescending([]float64{2, -1}), []Root{{0.5, 1}}},
+		{"quadratic", fromRoots(1, 2), []Root{{1, 1}, {2, 1}}},
+		{"complex pair", FromDescending([]float64{1, -2, 5}), []Root{{complex(1, -2), 1}, {complex(1, 2), 1}}},
+		{"double", fromRoots(3, 3), []Root{{3, 2}}},
+		{"zero roots", fromRoots(0, 0, 0, 2), []Root{{0, 3}, {2, 1}}},
+		{"cubic three real", fromRoots(-1, 2, 5), []Root{{-1, 1}, {2, 1}, {5, 1}}},
+		{"cubic one real", FromDescending([]float64{1, 0, 0, -8}), []Root{{complex(-1, -math.Sqrt(3)), 1}, {complex(-1, math.Sqrt(3)), 1}, {2, 1}}},
+		{"cubic triple", fromRoots(-2, -2, -2), []Root{{-2, 3}}},
+		{"cubic double", fromRoots(1, 1, 4), []Root{{1, 2}, {4, 1}}},
+		{"quartic", fromRoots(-3, -1, 2, 7), []Root{{-3, 1}, {-1, 1}, {2, 1}, {7, 1}}},
+		{"biquadratic", FromDescending([]float64{1, 0, -5, 0, 4}), []Root{{-2, 1}, {-1, 1}, {1, 1}, {2, 1}}},
+		{"quartic complex", FromDescending([]float64{1, 0, 0, 0, 1}), []Root{
+			{complex(-math.Sqrt2/2, -math.Sqrt2/2), 1}, {complex(-math.Sqrt2/2, math.Sqrt2/2), 1},
+			{complex(math.Sqrt2/2, -math.Sqrt2/2), 1}, {complex(math.Sqrt2/2, math.Sqrt2/2), 1},
+		}},
+		{"quintic", fromRoots(-2, -1, 0.5, 3, 4), []Root{{-2, 1}, {-1, 1}, {0.5, 1}, {3, 1}, {4, 1}}},
+		{"small imaginary", FromDescending([]float64{1, 0, 1e-6}), []Root{{complex(0, -1e-3), 1}, {complex(0, 1e-3), 1}}},
+		{"constant", Poly{4}, nil},
+		// Distinct roots closer than the factorization's tolerance stay apart
+		{"close pair at zero", FromDescending([]float64{1, 0, -1e-11}), []Root{{complex(-math.Sqrt(1e-11), 0), 1}, {complex(math.Sqrt(1e-11), 0), 1}}},
+		{"close pair", FromDescending([]float64{1, -2.00001, 1.00001}), []Root{{1, 1}, {1.00001, 1}}},
+		{"small sextic", FromDescending([]float64{1, 0, 0, 0, 0, 0, -1e-12}), []Root{
+			{-0.01, 1}, {complex(-0.005, -0.005*math.Sqrt(3)), 1}, {complex(-0.005, 0.005*math.Sqrt(3)), 1},
+			{complex(0.005, -0.005*math.Sqrt(3)), 1}, {complex(0.005, 0.005*math.Sqrt(3)), 1}, {0.01, 1},
+		}},
+		{"rounded double", FromDescending([]float64{1, -0.2, 0.01}), []Root{{0.1, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Roots()
+			require.NoError(t, err)
+			assertRoots(t, tt.want, got, 1e-12)
+		})
+	}
+}
+
+func TestRootsRepeated(t *testing.T) {
+	// Repeated roots are ill-conditioned, so the factors found for them are
+	// less accurate than simple roots
+	got, err := fromRoots(1, 1, 1, 2, 2, -3).Roots()
+	require.NoError(t, err)
+	assertRoots(t, []Root{{-3, 1}, {1, 3}, {2, 2}}, got, 1e-10)
+
+	got, err = fromRoots(0.5, 0.5, 0.5, 0.5, -1, 2, 2).Roots()
+	require.NoError(t, err)
+	assertRoots(t, []Root{{-1, 1}, {0.5, 4}, {2, 2}}, got, 1e-10)
+}
+
+func TestRootsCloseDistinct(t *testing.T) {
+	// Distinct roots too close for the factorization's tolerance are not
+	// merged into a repeated root, since the coefficients are further from
+	// having one than rounding explains
+	tests := []struct {
+		name string
+		p    Poly
+		want []Root
+	}{
+		{"1e-6 apart", FromDescending([]float64{1, -2.000001, 1.000001}), []Root{{1, 1}, {1.000001, 1}}},
+		{"1e-7 apart", FromDescending([]float64{1, -2.0000001, 1.0000001}), []Root{{1, 1}, {1.0000001, 1}}},
+		{"1e-5 apart at 100", FromDescending([]float64{1, -200.00001, 10000.001}), []Root{{100, 1}, {100.00001, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Roots()
+			require.NoError(t, err)
+			// Rounding the coefficients moves these ill-conditioned roots
+			// by up to about 1e-8
+			assertRoots(t, tt.want, got, 1e-7)
+		})
+	}
+
+	// (x − 1)²(x − 1.0000001) rounded to float64 has three simple roots
+	got, err := FromDescending([]float64{1, -3.0000001, 3.0000002, -1.0000001}).Roots()
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for _, r := range got {
+		assert.Equal(t, 1, r.Multiplicity)
+		assert.InDelta(t, 1, cmplx.Abs(r.Value), 1e-4)
+	}
+}
+
+func TestRootsRealSnap(t *testing.T) {
+	// Real roots found by the iteration have no stray imaginary parts
+	roots, err := fromRoots(-4, -2, 1, 3, 5, 6).Roots()
+	require.NoError(t, err)
+	for _, r := range roots {
+		assert.Zero(t, imag(r.Value), "%v", r.Value)
+	}
+}
+
+func TestRootsRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for n := 1; n <= 20; n++ {
+		coef := make([]float64, n+1)
+		for i := range coef {
+			coef[i] = rng.NormFloat64()
+		}
+		p := FromDescending(coef)
+		roots, err := p.Roots()
+		require.NoError(t, err, "degree %d", n)
+
+		count := 0
+		for _, r := range roots {
+			count += r.Multiplicity
+			// Backward error: the residual is small relative to the scale
+			// of the terms
+			var scale float64
+			for i := len(p) - 1; i >= 0; i-- {
+				scale = scale*cmplx.Abs(r.Value) + math.Abs(p[i])
+			}
+			assert.LessOrEqual(t, cmplx.Abs(p.EvalComplex(r.Value)), 1e-12*scale, "degree %d root %v", n, r.Value)
+		}
+		assert.Equal(t, n, count, "degree %d", n)
+	}
+}
+
+func TestEvalCompensated(t *testing.T) {
+	// Near the 7-fold root of (x − 1)⁷ plain Horner's method returns rounding
+	// noise far larger than the true value 1e-28
+	p := fromRoots(1, 1, 1, 1, 1, 1, 1)
+	x := 1.0001
+	want := math.Pow(x-1, 7)
+	assert.InDelta(t, want, p.evalCompensated(x), 1e-3*want)
+	assert.Greater(t, math.Abs(p.Eval(x)-want), 1e3*want)
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/sagacient/math-mcp-server/expr"
+)
+
+// maxInlineDepth bounds the nesting of user-defined functions.
+const maxInlineDepth = 32
+
+// Definition is a user-defined function that is inlined where it is called.
+type Definition struct {
+	Params []string
+	Body   expr.Node
+}
+
+// ErrDivisionByZero is returned for expressions that divide by zero.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// Parse parses s into a symbolic expression. Calls to the functions in defs
+// are replaced by their bodies.
+func Parse(s string, defs map[string]Definition) (Expr, error) {
+	n, err := expr.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return FromNode(n, defs)
+}
+
+// FromNode converts a parsed expression. Decimal literals become the exact
+// fractions they denote, so 0.1 is 1/10, and functions that are powers in
+// disguise are rewritten: sqrt(x) is x^(1/2) and ln is log.
+func FromNode(n expr.Node, defs map[string]Definition) (Expr, error) {
+	c := converter{defs: defs}
+	return c.convert(n, 0)
+}
+
+type converter struct {
+	defs map[string]Definition
+}
+
+func (c converter) convert(n expr.Node, depth int) (Expr, error) {
+	switch n := n.(type) {
+	case *expr.Num:
+		return FromFloat(n.Value)
+	case *expr.Var:
+		return Sym(n.Name), nil
+	case *expr.Unary:
+		x, err := c.convert(n.X, depth)
+		if err != nil {
+			return nil, err
+		}
+		return Neg(x), nil
+	case *expr.Binary:
+		l, err := c.convert(n.L, depth)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.convert(n.R, depth)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case '+':
+			return Add(l, r), nil
+		case '-':
+			return Sub(l, r), nil
+		case '*':
+			return Mul(l, r), nil
+		case '/':
+			if isNumber(r, zero) {
+				return nil, ErrDivisionByZero
+			}
+			return Div(l, r), nil
+		case '^':
+			return Pow(l, r), nil
+		}
+		return nil, fmt.Errorf("unknown operator %q", n.Op)
+	case *expr.Call:
+		args := make([]Expr, len(n.Args))
+		for i, a := range n.Args {
+			x, err := c.convert(a, depth)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = x
+		}
+		if expr.IsBuiltin(n.Func) {
+			return builtin(n.Func, args)
+		}
+		def, ok := c.defs[n.Func]
+		if !ok {
+			return nil, fmt.Errorf("unknown function %q", n.Func)
+		}
+		if len(args) != len(def.Params) {
+			return nil, fmt.Errorf("%s expects %d argument(s), got %d", n.Func, len(def.Params), len(args))
+		}
+		if depth >= maxInlineDepth {
+			return nil, fmt.Errorf("functions are nested more than %d deep", maxInlineDepth)
+		}
+		body, err := c.convert(def.Body, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]Expr, len(args))
+		for i, p := range def.Params {
+			values[p] = args[i]
+		}
+		return Substitute(body, values), nil
+	}
+	return nil, fmt.Errorf("unsupported expression node %T", n)
+}
+
+// FromFloat returns v as an exact number: the fraction with the shortest
+// decimal expansion that rounds to v, so 0.1 is 1/10.
+func FromFloat(v float64) (Expr, error) {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return nil, fmt.Errorf("number %g is not finite", v)
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	if !ok {
+		return nil, fmt.Errorf("invalid number %g", v)
+	}
+	return Number{r}, nil
+}
+
+// builtin applies a built-in function, rewriting the ones with an algebraic
+// equivalent.
+func builtin(fn string, args []Expr) (Expr, error) {
+	arity := 1
+	switch fn {
+	case "atan2", "pow", "hypot", "mod", "remainder", "dim", "copysign":
+		arity = 2
+	case "min", "max":
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%s expects at least one argument", fn)
+		}
+		arity = len(args)
+	}
+	if len(args) != arity {
+		return nil, fmt.Errorf("%s expects %d argument(s), got %d", fn, arity, len(args))
+	}
+	switch fn {
+	case "pow":
+		return Pow(args[0], args[1]), nil
+	case "sqrt":
+		return Pow(args[0], half), nil
+	case "hypot":
+		return Pow(Add(Pow(args[0], Int(2)), Pow(args[1], Int(2))), half), nil
+	case "ln":
+		return Apply("log", args[0]), nil
+	case "exp2":
+		return Pow(Int(2), args[0]), nil
+	case "expm1":
+		return Sub(Apply("exp", args[0]), one), nil
+	case "log1p":
+		return Apply("log", Add(one, args[0])), nil
+	}
+	return Apply(fn, args...), nil
+}
+
+// ToNode converts e back to an expression tree, for example to evaluate it.
+func ToNode(e Expr) expr.Node {
+	switch e := e.(type) {
+	case Number:
+		// The nearest float64
+		f, _ := e.v.Float64()
+		return &expr.Num{Value: f}
+	case Symbol:
+		return &expr.Var{Name: e.Name}
+	case *Sum:
+		n := ToNode(e.Terms[0])
+		for _, t := range e.Terms[1:] {
+			n = &expr.Binary{Op: '+', L: n, R: ToNode(t)}
+		}
+		return n
+	case *Product:
+		n := ToNode(e.Factors[0])
+		for _, f := range e.Factors[1:] {
+			n = &expr.Binary{Op: '*', L: n, R: ToNode(f)}
+		}
+		return n
+	case *Power:
+		if isNumber(e.Exp, half) {
+			return &expr.Call{Func: "sqrt", Args: []expr.Node{ToNode(e.Base)}}
+		}
+		return &expr.Binary{Op: '^', L: ToNode(e.Base), R: ToNode(e.Exp)}
+	}
+	c := e.(*Call)
+	args := make([]expr.Node, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = ToNode(a)
+	}
+	return &expr.Call{Func: c.Func, Args: args}
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// MaxSize bounds the number of nodes in a result, since repeated
+// differentiation and expansion can grow expressions exponentially.
+const MaxSize = 20000
+
+// ErrTooLarge is returned when a result would exceed MaxSize nodes.
+var ErrTooLarge = errors.New("the result is too large")
+
+// piecewiseConstant are functions whose derivative is zero wherever it
+// exists.
+var piecewiseConstant = []string{"sign", "floor", "ceil", "round", "trunc"}
+
+// Diff returns the derivative of e with respect to x.
+func Diff(e Expr, x string) (Expr, error) {
+	d, err := diff(e, x)
+	if err != nil {
+		return nil, err
+	}
+	if Size(d) > MaxSize {
+		return nil, ErrTooLarge
+	}
+	return d, nil
+}
+
+func diff(e Expr, x string) (Expr, error) {
+	if Free(e, x) {
+		return zero, nil
+	}
+	switch e := e.(type) {
+	case Symbol:
+		return one, nil
+	case *Sum:
+		terms := make([]Expr, len(e.Terms))
+		for i, t := range e.Terms {
+			d, err := diff(t, x)
+			if err != nil {
+				return nil, err
+			}
+			terms[i] = d
+		}
+		return Add(terms...), nil
+	case *Product:
+		// (fg)' = f'g + fg'
+		terms := make([]Expr, 0, len(e.Factors))
+		for i, f := range e.Factors {
+			if Free(f, x) {
+				continue
+			}
+			d, err := diff(f, x)
+			if err != nil {
+				return nil, err
+			}
+			factors := append([]Expr{d}, e.Factors[:i]...)
+			terms = append(terms, Mul(append(factors, e.Factors[i+1:]...)...))
+		}
+		return Add(terms...), nil
+	case *Power:
+		return diffPower(e, x)
+	case *Call:
+		return diffCall(e, x)
+	}
+	return nil, fmt.Errorf("cannot differentiate %s", e)
+}
+
+// diffPower differentiates b^n by the power rule, a^u by the exponential
+// rule and u^v in general by logarithmic differentiation.
+func diffPower(p *Power, x string) (Expr, error) {
+	b, n := p.Base, p.Exp
+	db, err := diff(b, x)
+	if err != nil {
+		return nil, err
+	}
+	if Free(n, x) {
+		return Mul(n, Pow(b, Sub(n, one)), db), nil
+	}
+	dn, err := diff(n, x)
+	if err != nil {
+		return nil, err
+	}
+	if Free(b, x) {
+		return Mul(p, Apply("log", b), dn), nil
+	}
+	return Mul(p, Add(Mul(dn, Apply("log", b)), Mul(n, db, Pow(b, Int(-1))))), nil
+}
+
+// diffCall differentiates a function application by the chain rule.
+func diffCall(c *Call, x string) (Expr, error) {
+	if c.Func == "atan2" {
+		// d atan2(y, u) = (u dy − y du) / (y² + u²)
+		y, u := c.Args[0], c.Args[1]
+		dy, err := diff(y, x)
+		if err != nil {
+			return nil, err
+		}
+		du, err := diff(u, x)
+		if err != nil {
+			return nil, err
+		}
+		return Div(Sub(Mul(u, dy), Mul(y, du)), Add(Pow(y, Int(2)), Pow(u, Int(2)))), nil
+	}
+	if len(c.Args) != 1 {
+		return nil, fmt.Errorf("cannot differentiate %s symbolically", c.Func)
+	}
+	u := c.Args[0]
+	outer, err := derivativeOf(c.Func, u)
+	if err != nil {
+		return nil, err
+	}
+	du, err := diff(u, x)
+	if err != nil {
+		return nil, err
+	}
+	return Mul(outer, du), nil
+}
+
+// derivativeOf returns f'(u) for a built-in function f.
+func derivativeOf(fn string, u Expr) (Expr, error) {
+	minusOne := Int(-1)
+	minusHalf := Rat(-1, 2)
+	square := Pow(u, Int(2))
+	switch fn {
+	case "sin":
+		return Apply("cos", u), nil
+	case "cos":
+		return Neg(Apply("sin", u)), nil
+	case "tan":
+		return Pow(Apply("cos", u), Int(-2)), nil
+	case "asin":
+		return Pow(Sub(one, square), minusHalf), nil
+	case "acos":
+		return Neg(Pow(Sub(one, square), minusHalf)), nil
+	case "atan":
+		return Pow(Add(one, square), minusOne), nil
+	case "sinh":
+		return Apply("cosh", u), nil
+	case "cosh":
+		return Apply("sinh", u), nil
+	case "tanh":
+		return Pow(Apply("cosh", u), Int(-2)), nil
+	case "asinh":
+		return Pow(Add(square, one), minusHalf), nil
+	case "acosh":
+		return Pow(Sub(square, one), minusHalf), nil
+	case "atanh":
+		return Pow(Sub(one, square), minusOne), nil
+	case "exp":
+		return Apply("exp", u), nil
+	case "log":
+		return Pow(u, minusOne), nil
+	case "log10", "log2":
+		base := Int(10)
+		if fn == "log2" {
+			base = Int(2)
+		}
+		return Pow(Mul(u, Apply("log", base)), minusOne), nil
+	case "cbrt":
+		return Mul(Rat(1, 3), Pow(Apply("cbrt", u), Int(-2))), nil
+	case "abs":
+		return Apply("sign", u), nil
+	case "erf", "erfc":
+		// 2/√π e^(−u²)
+		d := Mul(Int(2), Pow(Sym("pi"), minusHalf), Apply("exp", Neg(square)))
+		if fn == "erfc" {
+			return Neg(d), nil
+		}
+		return d, nil
+	case "erfinv", "erfcinv":
+		// √π/2 e^(erfinv(u)²)
+		d := Mul(half, Pow(Sym("pi"), half), Apply("exp", Pow(Apply(fn, u), Int(2))))
+		if fn == "erfcinv" {
+			return Neg(d), nil
+		}
+		return d, nil
+	case "j0":
+		return Neg(Apply("j1", u)), nil
+	case "y0":
+		return Neg(Apply("y1", u)), nil
+	case "j1", "y1":
+		// J1' = J0 − J1/u, and likewise for Y
+		zeroth := "j0"
+		if fn == "y1" {
+			zeroth = "y0"
+		}
+		return Sub(Apply(zeroth, u), Div(Apply(fn, u), u)), nil
+	}
+	if slices.Contains(piecewiseConstant, fn) {
+		return zero, nil
+	}
+	return nil, fmt.Errorf("cannot differentiate %s symbolically", fn)
+}
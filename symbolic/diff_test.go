@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/calculus"
+	"github.com/sagacient/math-mcp-server/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"sin(x^2)", "2x cos(x^2)"},
+		{"x^3 - 3x + 1/2", "3x^2 - 3"},
+		{"5", "0"},
+		{"y^2", "0"},
+		{"a x^2 + b x + c", "2a x + b"},
+		{"exp(3x)", "3 exp(3x)"},
+		{"log(x)", "1 / x"},
+		{"sqrt(x)", "1 / (2 sqrt(x))"},
+		{"1/x", "-1 / x^2"},
+		{"2^x", "2^x log(2)"},
+		{"x^x", "x^x * (log(x) + 1)"},
+		{"tan(x)", "1 / cos(x)^2"},
+		{"atan(x)", "1 / (x^2 + 1)"},
+		{"x/(x+1)", "1 / (x + 1)^2"},
+		{"sin(x) cos(x)", "cos(x)^2 - sin(x)^2"},
+		{"abs(x)", "sign(x)"},
+		{"floor(x)", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := Diff(mustParse(t, tt.input), "x")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, Simplify(d).String())
+		})
+	}
+}
+
+// TestDiffNumerically checks every differentiable built-in against a
+// numerical derivative.
+func TestDiffNumerically(t *testing.T) {
+	inputs := []string{
+		"sin(2x)", "cos(x^2)", "tan(x)", "asin(x/2)", "acos(x/2)", "atan(x)",
+		"sinh(x)", "cosh(x)", "tanh(x)", "asinh(x)", "acosh(x + 2)", "atanh(x/2)",
+		"exp(-x^2)", "log(x + 2)", "log10(x + 2)", "log2(x + 2)", "cbrt(x + 3)",
+		"erf(x)", "erfc(x)", "erfinv(x/2)", "erfcinv(x/2 + 1)", "j0(x)", "j1(x)",
+		"y0(x + 2)", "y1(x + 2)", "atan2(x, 2)", "atan2(1, x)", "x^x", "expm1(x)",
+		"exp2(x)", "hypot(x, 3)", "pow(x + 2, x)", "sqrt(1 + x^2) / (x + 3)",
+	}
+	const x0 = 0.7
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			e := mustParse(t, input)
+			d, err := Diff(e, "x")
+			require.NoError(t, err)
+			got, err := expr.Eval(ToNode(d), &expr.Env{Vars: map[string]float64{"x": x0}})
+			require.NoError(t, err)
+
+			f := func(x float64) (float64, error) {
+				return expr.Eval(ToNode(e), &expr.Env{Vars: map[string]float64{"x": x}})
+			}
+			want, err := calculus.Derivative(f, x0, 1, 0)
+			require.NoError(t, err)
+			assert.InDelta(t, want.Value, got, 1e-8*math.Max(1, math.Abs(got)), "d/dx %s = %s", input, d)
+		})
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	_, err := Diff(mustParse(t, "gamma(x)"), "x")
+	assert.EqualError(t, err, "cannot differentiate gamma symbolically")
+	_, err = Diff(mustParse(t, "max(x, 1)"), "x")
+	assert.EqualError(t, err, "cannot differentiate max symbolically")
+
+	// Functions of other variables only are constants
+	d, err := Diff(mustParse(t, "gamma(y) x"), "x")
+	require.NoError(t, err)
+	assert.Equal(t, "gamma(y)", d.String())
+}
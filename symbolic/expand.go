@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math"
+	"math/big"
+	"slices"
+
+	"github.com/sagacient/math-mcp-server/poly"
+)
+
+const (
+	// maxFactorDegree bounds the polynomials Factor looks for roots of.
+	maxFactorDegree = 100
+	// maxTerms bounds the number of products of terms one multiplication
+	// in Expand may form.
+	maxTerms = 5000
+)
+
+// Expand multiplies out products and integer powers of sums, including
+// those in denominators and function arguments, so that
+// (x + 1)^2 becomes x^2 + 2x + 1.
+func Expand(e Expr) (Expr, error) {
+	var err error
+	var expand func(Expr) Expr
+	expand = func(e Expr) Expr {
+		if err != nil {
+			return e
+		}
+		switch e := e.(type) {
+		case *Product:
+			product := Expr(one)
+			for _, f := range e.Factors {
+				f = expand(f)
+				if len(addends(product))*len(addends(f)) > maxTerms {
+					err = ErrTooLarge
+					return e
+				}
+				product = multiply(product, f)
+				if Size(product) > MaxSize {
+					err = ErrTooLarge
+					return e
+				}
+			}
+			return product
+		case *Power:
+			base, exp := expand(e.Base), expand(e.Exp)
+			n, ok := exp.(Number)
+			if _, isSum := base.(*Sum); !ok || !isSum || !n.v.IsInt() || !n.v.Num().IsInt64() {
+				return Pow(base, exp)
+			}
+			k := n.v.Num().Int64()
+			power := Expr(one)
+			for range min(max(k, -k), MaxSize) {
+				if len(addends(power))*len(addends(base)) > maxTerms {
+					err = ErrTooLarge
+					return e
+				}
+				power = multiply(power, base)
+				if Size(power) > MaxSize {
+					err = ErrTooLarge
+					return e
+				}
+			}
+			if k < 0 {
+				return Pow(power, Int(-1))
+			}
+			return power
+		}
+		return rebuild(e, expand)
+	}
+	result := expand(e)
+	if err == nil && Size(result) > MaxSize {
+		err = ErrTooLarge
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// multiply returns the product of a and b with sums distributed, except in
+// factors with negative exponents.
+func multiply(a, b Expr) Expr {
+	_, aSum := a.(*Sum)
+	_, bSum := b.(*Sum)
+	if !aSum && !bSum {
+		return Mul(a, b)
+	}
+	var terms []Expr
+	for _, s := range addends(a) {
+		for _, t := range addends(b) {
+			terms = append(terms, Mul(s, t))
+		}
+	}
+	return Add(terms...)
+}
+
+// addends returns the terms of e if it is a sum, or e itself.
+func addends(e Expr) []Expr {
+	if s, ok := e.(*Sum); ok {
+		return s.Terms
+	}
+	return []Expr{e}
+}
+
+// Factor writes e as a product. A polynomial in one variable with rational
+// coefficients is split into its content, a power of the variable and
+// linear factors for its rational roots, leaving any factor without rational
+// roots as it is: x^3 − x becomes x (x − 1)(x + 1). Other sums have the
+// common numeric and power factors of their terms taken out, and the factors
+// of products and powers are factored in turn, which cancels common factors
+// of a fraction. Sums of fractions are put over a common denominator first.
+func Factor(e Expr) (Expr, error) {
+	switch e := e.(type) {
+	case *Product:
+		factors := make([]Expr, len(e.Factors))
+		for i, f := range e.Factors {
+			g, err := Factor(f)
+			if err != nil {
+				return nil, err
+			}
+			factors[i] = g
+		}
+		return Mul(factors...), nil
+	case *Power:
+		base, err := Factor(e.Base)
+		if err != nil {
+			return nil, err
+		}
+		return Pow(base, e.Exp), nil
+	case *Sum:
+		for _, t := range e.Terms {
+			if len(asQuotient(t).lower) > 0 {
+				return together(e)
+			}
+		}
+		return factorSum(e)
+	}
+	return e, nil
+}
+
+// factorSum factors a sum without fractions.
+func factorSum(s *Sum) (Expr, error) {
+	expanded, err := Expand(s)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := expanded.(*Sum); !ok {
+		return expanded, nil
+	}
+	if vars := Symbols(expanded); len(vars) == 1 {
+		if coef, ok := coefficients(expanded, vars[0]); ok && len(coef) <= maxFactorDegree+1 {
+			return factorPolynomial(coef, Sym(vars[0])), nil
+		}
+	}
+	return commonFactor(expanded.(*Sum)), nil
+}
+
+// coefficients returns the coefficients of e as a polynomial in x, lowest
+// degree first, if it is one.
+func coefficients(e Expr, x string) ([]*big.Rat, bool) {
+	var coef []*big.Rat
+	for _, t := range addends(e) {
+		c, rest := split(t)
+		var k int
+		switch r := rest.(type) {
+		case Number:
+		case Symbol:
+			if r.Name != x {
+				return nil, false
+			}
+			k = 1
+		case *Power:
+			n, ok := r.Exp.(Number)
+			if !Equal(r.Base, Sym(x)) || !ok || !n.v.IsInt() || !n.v.Num().IsInt64() || n.v.Sign() < 0 || n.v.Num().Int64() > maxFactorDegree {
+				return nil, false
+			}
+			k = int(n.v.Num().Int64())
+		default:
+			return nil, false
+		}
+		for len(coef) <= k {
+			coef = append(coef, new(big.Rat))
+		}
+		coef[k].Add(coef[k], c)
+	}
+	return coef, true
+}
+
+// factorPolynomial factors the polynomial with the given coefficients in x.
+func factorPolynomial(coef []*big.Rat, x Expr) Expr {
+	// Content: the coefficients over it are coprime integers with a
+	// positive leading one
+	num, den := new(big.Int), big.NewInt(1)
+	for _, c := range coef {
+		num.GCD(nil, nil, num, c.Num())
+		den.Div(new(big.Int).Mul(den, c.Denom()), new(big.Int).GCD(nil, nil, den, c.Denom()))
+	}
+	content := new(big.Rat).SetFrac(num, den)
+	if coef[len(coef)-1].Sign() < 0 {
+		content.Neg(content)
+	}
+	p := make([]*big.Rat, len(coef))
+	for i, c := range coef {
+		p[i] = new(big.Rat).Quo(c, content)
+	}
+	factors := []Expr{Number{content}}
+
+	// x^k
+	k := 0
+	for p[k].Sign() == 0 {
+		k++
+	}
+	p = p[k:]
+	factors = append(factors, Pow(x, Int(int64(k))))
+
+	// Rational roots, found numerically and confirmed exactly
+	for len(p) > 2 {
+		root, ok := rationalRoot(p)
+		if !ok {
+			break
+		}
+		m := 0
+		for {
+			q, rem := divideLinear(p, root)
+			if rem.Sign() != 0 {
+				break
+			}
+			p = q
+			m++
+		}
+		// The root p/q gives the factor (q x − p)
+		factors = append(factors, Pow(Add(Mul(Number{new(big.Rat).SetInt(root.Denom())}, x), Number{new(big.Rat).Neg(new(big.Rat).SetInt(root.Num()))}), Int(int64(m))))
+		// The quotient is monic in the leading coefficient of q x − p
+		scale := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(root.Denom(), big.NewInt(int64(m)), nil))
+		for i := range p {
+			p[i].Mul(p[i], scale)
+		}
+	}
+	terms := make([]Expr, len(p))
+	for i, c := range p {
+		terms[i] = Mul(Number{c}, Pow(x, Int(int64(i))))
+	}
+	factors = append(factors, Add(terms...))
+	return Mul(factors...)
+}
+
+// rationalRoot returns a rational root of the polynomial p with integer
+// coefficients, if it has one.
+func rationalRoot(p []*big.Rat) (*big.Rat, bool) {
+	values := make(poly.Poly, len(p))
+	for i, c := range p {
+		f, _ := c.Float64()
+		if math.IsInf(f, 0) {
+			return nil, false
+		}
+		values[i] = f
+	}
+	roots, _ := values.Roots()
+	// A root p/q has q dividing the leading coefficient
+	lead := p[len(p)-1].Num()
+	var dens []*big.Int
+	for d := int64(1); d <= 10000; d++ {
+		if new(big.Int).Rem(lead, big.NewInt(d)).Sign() == 0 {
+			dens = append(dens, big.NewInt(d))
+		}
+	}
+	for _, r := range roots {
+		if math.IsNaN(real(r.Value)) || math.Abs(imag(r.Value)) > 1e-6*math.Max(1, math.Abs(real(r.Value))) {
+			continue
+		}
+		for _, d := range dens {
+			n, _ := new(big.Float).SetFloat64(math.Round(real(r.Value) * float64(d.Int64()))).Int(nil)
+			candidate := new(big.Rat).SetFrac(n, d)
+			if _, rem := divideLinear(p, candidate); rem.Sign() == 0 {
+				return candidate, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// divideLinear divides p by x − r, returning the quotient and remainder.
+func divideLinear(p []*big.Rat, r *big.Rat) ([]*big.Rat, *big.Rat) {
+	q := make([]*big.Rat, len(p)-1)
+	acc := new(big.Rat)
+	for i := len(p) - 1; i > 0; i-- {
+		acc = new(big.Rat).Add(new(big.Rat).Mul(acc, r), p[i])
+		q[i-1] = acc
+	}
+	return q, new(big.Rat).Add(new(big.Rat).Mul(acc, r), p[0])
+}
+
+// commonFactor takes the common numeric factor and the common powers out of
+// the terms of s.
+func commonFactor(s *Sum) Expr {
+	num, den := new(big.Int), big.NewInt(1)
+	// Exponents of the bases every term has
+	common := make(map[string]*Power)
+	var order []string
+	for i, t := range s.Terms {
+		c, rest := split(t)
+		num.GCD(nil, nil, num, c.Num())
+		den.Div(new(big.Int).Mul(den, c.Denom()), new(big.Int).GCD(nil, nil, den, c.Denom()))
+		seen := make(map[string]bool)
+		factors := []Expr{rest}
+		if p, ok := rest.(*Product); ok {
+			factors = p.Factors
+		}
+		for _, f := range factors {
+			base, exp := asPower(f)
+			n, ok := exp.(Number)
+			if !ok || isNumber(f, one) {
+				continue
+			}
+			k := base.key()
+			seen[k] = true
+			switch p, found := common[k]; {
+			case i == 0:
+				common[k] = &Power{Base: base, Exp: n}
+				order = append(order, k)
+			case found && n.v.Cmp(p.Exp.(Number).v) < 0:
+				p.Exp = n
+			}
+		}
+		for k := range common {
+			if !seen[k] {
+				delete(common, k)
+			}
+		}
+	}
+	factor := []Expr{Number{new(big.Rat).SetFrac(num, den)}}
+	if c, _ := split(s.Terms[0]); c.Sign() < 0 {
+		factor[0] = Neg(factor[0])
+	}
+	for _, k := range order {
+		if p, ok := common[k]; ok && p.Exp.(Number).v.Sign() > 0 {
+			factor = append(factor, Pow(p.Base, p.Exp))
+		}
+	}
+	f := Mul(factor...)
+	if isNumber(f, one) {
+		return s
+	}
+	inverse := Pow(f, Int(-1))
+	terms := slices.Clone(s.Terms)
+	for i, t := range terms {
+		terms[i] = Mul(t, inverse)
+	}
+	return Mul(f, Add(terms...))
+}
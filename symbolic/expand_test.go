@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"(x + 1)^2", "x^2 + 2x + 1"},
+		{"(x - y)^3", "x^3 - 3x^2 y + 3x y^2 - y^3"},
+		{"(a + b)(a - b)", "a^2 - b^2"},
+		{"2x*(x + 3) - x", "2x^2 + 5x"},
+		{"1 / (x + 1)^2", "1 / (x^2 + 2x + 1)"},
+		{"sin((x + 1)^2)", "sin(x^2 + 2x + 1)"},
+		{"(x + 1)^(1/2)", "sqrt(x + 1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Expand(mustParse(t, tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+
+	_, err := Expand(mustParse(t, "(x + y + z + 1)^40"))
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestFactor(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"x^2 + 2x + 1", "(x + 1)^2"},
+		{"x^3 - x", "x * (x - 1) (x + 1)"},
+		{"6x^2 + 5x + 1", "(2x + 1) (3x + 1)"},
+		{"4x^2 - 9", "(2x - 3) (2x + 3)"},
+		{"x^4 - 1", "(x^2 + 1) (x - 1) (x + 1)"},
+		{"2x^2 - 4", "2 (x^2 - 2)"},
+		{"x^3/2 - x/2", "x * (x - 1) (x + 1) / 2"},
+		{"-x^2 + 1", "-(x - 1) (x + 1)"},
+		{"x^2 y + x y^2", "x y * (x + y)"},
+		{"6a b + 9a^2", "3a * (3a + 2b)"},
+		{"sin(x)^2 + sin(x)", "sin(x) (sin(x) + 1)"},
+		{"(x^2 - 1) / (x + 1)", "x - 1"},
+		{"1/x + 1/x^2", "(x + 1) / x^2"},
+		{"x + 1", "x + 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Factor(mustParse(t, tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,643 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+// Package symbolic manipulates expressions exactly: it differentiates,
+// simplifies, expands and factors them, and formats the results as text and
+// LaTeX. Numbers are exact rationals, so 1/3 stays 1/3.
+package symbolic
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// Expr is a symbolic expression. Expressions are immutable and are built by
+// Add, Mul, Pow and Apply, which keep them in a canonical form: sums and
+// products are flattened and sorted, like terms and equal bases are
+// collected, and numbers are folded.
+type Expr interface {
+	// String formats the expression as plain text; see Format.
+	String() string
+	// key identifies the expression; equal expressions have equal keys.
+	key() string
+}
+
+// Number is an exact rational number.
+type Number struct {
+	v *big.Rat
+}
+
+// Symbol is a variable or a named constant such as pi.
+type Symbol struct {
+	Name string
+}
+
+// Sum is a sum of two or more terms, the constant term last.
+type Sum struct {
+	Terms []Expr
+	k     string
+}
+
+// Product is a product of two or more factors, the numeric coefficient
+// first.
+type Product struct {
+	Factors []Expr
+	k       string
+}
+
+// Power is Base raised to Exp.
+type Power struct {
+	Base, Exp Expr
+	k         string
+}
+
+// Call is a function applied to arguments.
+type Call struct {
+	Func string
+	Args []Expr
+	k    string
+}
+
+func (n Number) key() string   { return n.v.RatString() }
+func (s Symbol) key() string   { return s.Name }
+func (s *Sum) key() string     { return s.k }
+func (p *Product) key() string { return p.k }
+func (p *Power) key() string   { return p.k }
+func (c *Call) key() string    { return c.k }
+
+// Equal reports whether a and b are the same expression.
+func Equal(a, b Expr) bool {
+	return a.key() == b.key()
+}
+
+// compound builds the key of an operator applied to operands.
+func compound(op string, operands ...Expr) string {
+	var b strings.Builder
+	b.WriteString("(")
+	b.WriteString(op)
+	for _, x := range operands {
+		b.WriteString(" ")
+		b.WriteString(x.key())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// Rat returns the number p/q.
+func Rat(p, q int64) Number {
+	return Number{big.NewRat(p, q)}
+}
+
+// Int returns the integer n.
+func Int(n int64) Number {
+	return Rat(n, 1)
+}
+
+// NewNumber wraps a rational number.
+func NewNumber(r *big.Rat) Number {
+	return Number{new(big.Rat).Set(r)}
+}
+
+// Rat returns the value of n.
+func (n Number) Rat() *big.Rat {
+	return new(big.Rat).Set(n.v)
+}
+
+// Sym returns the symbol called name.
+func Sym(name string) Symbol {
+	return Symbol{Name: name}
+}
+
+var (
+	zero = Int(0)
+	one  = Int(1)
+	half = Rat(1, 2)
+)
+
+// isNumber reports whether e is the number r.
+func isNumber(e Expr, r Number) bool {
+	n, ok := e.(Number)
+	return ok && n.v.Cmp(r.v) == 0
+}
+
+// isInteger reports whether e is an integer number.
+func isInteger(e Expr) bool {
+	n, ok := e.(Number)
+	return ok && n.v.IsInt()
+}
+
+// Add returns the sum of terms in canonical form.
+func Add(terms ...Expr) Expr {
+	constant := new(big.Rat)
+	type group struct {
+		coef *big.Rat
+		rest Expr
+	}
+	groups := make(map[string]*group)
+	var order []string
+	var add func(e Expr, c *big.Rat)
+	add = func(e Expr, c *big.Rat) {
+		switch e := e.(type) {
+		case Number:
+			constant.Add(constant, new(big.Rat).Mul(c, e.v))
+			return
+		case *Sum:
+			for _, t := range e.Terms {
+				add(t, c)
+			}
+			return
+		}
+		coef, rest := split(e)
+		coef.Mul(coef, c)
+		// A multiple of a sum is distributed so that its terms can combine
+		if s, ok := rest.(*Sum); ok {
+			add(s, coef)
+			return
+		}
+		k := rest.key()
+		if g, ok := groups[k]; ok {
+			g.coef.Add(g.coef, coef)
+			return
+		}
+		groups[k] = &group{coef: coef, rest: rest}
+		order = append(order, k)
+	}
+	for _, t := range terms {
+		add(t, big.NewRat(1, 1))
+	}
+
+	var out []Expr
+	for _, k := range order {
+		g := groups[k]
+		if g.coef.Sign() != 0 {
+			out = append(out, Mul(Number{g.coef}, g.rest))
+		}
+	}
+	slices.SortFunc(out, compareTerms)
+	if constant.Sign() != 0 {
+		out = append(out, Number{constant})
+	}
+	switch len(out) {
+	case 0:
+		return zero
+	case 1:
+		return out[0]
+	}
+	return &Sum{Terms: out, k: compound("+", out...)}
+}
+
+// split separates the numeric coefficient of e from the rest.
+func split(e Expr) (*big.Rat, Expr) {
+	switch e := e.(type) {
+	case Number:
+		return new(big.Rat).Set(e.v), one
+	case *Product:
+		if n, ok := e.Factors[0].(Number); ok {
+			rest := e.Factors[1:]
+			if len(rest) == 1 {
+				return new(big.Rat).Set(n.v), rest[0]
+			}
+			return new(big.Rat).Set(n.v), &Product{Factors: rest, k: compound("*", rest...)}
+		}
+	}
+	return big.NewRat(1, 1), e
+}
+
+// Neg returns −e.
+func Neg(e Expr) Expr {
+	return Mul(Int(-1), e)
+}
+
+// Sub returns a − b.
+func Sub(a, b Expr) Expr {
+	return Add(a, Neg(b))
+}
+
+// Div returns a / b.
+func Div(a, b Expr) Expr {
+	return Mul(a, Pow(b, Int(-1)))
+}
+
+// Mul returns the product of factors in canonical form.
+func Mul(factors ...Expr) Expr {
+	coef := big.NewRat(1, 1)
+	exps := make(map[string]*Power)
+	var order []string
+	var expArgs []Expr
+	var mul func(e Expr)
+	mul = func(e Expr) {
+		switch e := e.(type) {
+		case Number:
+			coef.Mul(coef, e.v)
+			return
+		case *Product:
+			for _, f := range e.Factors {
+				mul(f)
+			}
+			return
+		case *Call:
+			// exp(a) exp(b) = exp(a + b)
+			if e.Func == "exp" {
+				expArgs = append(expArgs, e.Args[0])
+				return
+			}
+		}
+		base, exp := asPower(e)
+		k := base.key()
+		if p, ok := exps[k]; ok {
+			p.Exp = Add(p.Exp, exp)
+			return
+		}
+		exps[k] = &Power{Base: base, Exp: exp}
+		order = append(order, k)
+	}
+	for _, f := range factors {
+		mul(f)
+	}
+	if coef.Sign() == 0 {
+		return zero
+	}
+
+	var out []Expr
+	collect := func(f Expr) {
+		switch f := f.(type) {
+		case Number:
+			coef.Mul(coef, f.v)
+		case *Product:
+			// A power of a product that came apart
+			for _, g := range f.Factors {
+				if n, ok := g.(Number); ok {
+					coef.Mul(coef, n.v)
+				} else {
+					out = append(out, g)
+				}
+			}
+		default:
+			out = append(out, f)
+		}
+	}
+	for _, k := range order {
+		f := Pow(exps[k].Base, exps[k].Exp)
+		if c, ok := f.(*Call); ok && c.Func == "exp" {
+			expArgs = append(expArgs, c.Args[0])
+			continue
+		}
+		collect(f)
+	}
+	if len(expArgs) > 0 {
+		collect(Apply("exp", Add(expArgs...)))
+	}
+	if coef.Sign() == 0 {
+		return zero
+	}
+	slices.SortFunc(out, compareFactors)
+	if coef.Cmp(one.v) != 0 {
+		out = append([]Expr{Number{coef}}, out...)
+	}
+	switch len(out) {
+	case 0:
+		return Number{coef}
+	case 1:
+		return out[0]
+	}
+	return &Product{Factors: out, k: compound("*", out...)}
+}
+
+// asPower returns e as a base and an exponent.
+func asPower(e Expr) (Expr, Expr) {
+	if p, ok := e.(*Power); ok {
+		return p.Base, p.Exp
+	}
+	return e, one
+}
+
+// maxExactBits bounds the size of exact powers of numbers.
+const maxExactBits = 4096
+
+// Pow returns base^exp in canonical form.
+func Pow(base, exp Expr) Expr {
+	if e, ok := exp.(Number); ok {
+		switch {
+		case e.v.Sign() == 0:
+			return one
+		case e.v.Cmp(one.v) == 0:
+			return base
+		}
+		switch b := base.(type) {
+		case Number:
+			if r, ok := powNumber(b.v, e.v); ok {
+				return r
+			}
+		case *Power:
+			// (b^m)^n = b^(mn) for integer n
+			if e.v.IsInt() {
+				return Pow(b.Base, Mul(b.Exp, e))
+			}
+		case *Product:
+			if e.v.IsInt() {
+				factors := make([]Expr, len(b.Factors))
+				for i, f := range b.Factors {
+					factors[i] = Pow(f, e)
+				}
+				return Mul(factors...)
+			}
+		case *Call:
+			// exp(u)^n = exp(nu)
+			if b.Func == "exp" {
+				return Apply("exp", Mul(e, b.Args[0]))
+			}
+		}
+	}
+	if b, ok := base.(Number); ok {
+		switch {
+		case b.v.Cmp(one.v) == 0:
+			return one
+		case b.v.Sign() == 0:
+			if e, ok := exp.(Number); ok && e.v.Sign() > 0 {
+				return zero
+			}
+		}
+	}
+	if s, ok := base.(Symbol); ok && s.Name == "e" {
+		return Apply("exp", exp)
+	}
+	return &Power{Base: base, Exp: exp, k: compound("^", base, exp)}
+}
+
+// powNumber computes b^e exactly when the result is rational and not too
+// large.
+func powNumber(b, e *big.Rat) (Number, bool) {
+	num, den := e.Num(), e.Denom()
+	if !num.IsInt64() || !den.IsInt64() || den.Int64() > 64 {
+		return Number{}, false
+	}
+	n, d := num.Int64(), den.Int64()
+	if b.Sign() == 0 {
+		if n < 0 {
+			return Number{}, false
+		}
+		return zero, true
+	}
+	if b.Sign() < 0 && d%2 == 0 {
+		return Number{}, false
+	}
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	// Powers of ±1 are cheap however large the exponent
+	if bits := max(b.Num().BitLen(), b.Denom().BitLen()); bits > 1 && (abs > maxExactBits*d || int64(bits)*abs/d > maxExactBits) {
+		return Number{}, false
+	}
+	// The d-th root, which must be exact
+	p, q := new(big.Int).Abs(b.Num()), new(big.Int).Set(b.Denom())
+	if d > 1 {
+		var ok bool
+		if p, ok = exactRoot(p, d); !ok {
+			return Number{}, false
+		}
+		if q, ok = exactRoot(q, d); !ok {
+			return Number{}, false
+		}
+	}
+	if b.Sign() < 0 {
+		p.Neg(p)
+	}
+	p.Exp(p, big.NewInt(abs), nil)
+	q.Exp(q, big.NewInt(abs), nil)
+	if n < 0 {
+		p, q = q, p
+	}
+	return Number{new(big.Rat).SetFrac(p, q)}, true
+}
+
+// exactRoot returns the d-th root of x ≥ 0 if it is an integer.
+func exactRoot(x *big.Int, d int64) (*big.Int, bool) {
+	if x.Sign() == 0 || x.Cmp(big.NewInt(1)) == 0 {
+		return x, true
+	}
+	// Newton's method from above
+	dd := big.NewInt(d)
+	r := new(big.Int).Lsh(big.NewInt(1), uint(x.BitLen()/int(d)+1))
+	for {
+		// r' = ((d − 1) r + x / r^(d−1)) / d
+		t := new(big.Int).Exp(r, big.NewInt(d-1), nil)
+		t.Quo(x, t)
+		t.Add(t, new(big.Int).Mul(r, big.NewInt(d-1)))
+		t.Quo(t, dd)
+		if t.Cmp(r) >= 0 {
+			break
+		}
+		r = t
+	}
+	if new(big.Int).Exp(r, dd, nil).Cmp(x) != 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// factorClass orders the kinds of factor in a product: powers of numbers,
+// then symbols, then functions, then sums.
+func factorClass(e Expr) int {
+	base, _ := asPower(e)
+	switch base.(type) {
+	case Number:
+		return 0
+	case Symbol:
+		return 1
+	case *Call:
+		return 2
+	}
+	return 3
+}
+
+// compareFactors orders the factors of a product.
+func compareFactors(a, b Expr) int {
+	if c := cmp.Compare(factorClass(a), factorClass(b)); c != 0 {
+		return c
+	}
+	ba, ea := asPower(a)
+	bb, eb := asPower(b)
+	if c := cmp.Compare(ba.key(), bb.key()); c != 0 {
+		return c
+	}
+	return cmp.Compare(ea.key(), eb.key())
+}
+
+// degree returns the total degree of a term in its symbols, counting numeric
+// exponents only.
+func degree(e Expr) float64 {
+	switch e := e.(type) {
+	case Symbol:
+		return 1
+	case *Power:
+		if _, ok := e.Base.(Symbol); ok {
+			if n, ok := e.Exp.(Number); ok {
+				f, _ := n.v.Float64()
+				return f
+			}
+		}
+	case *Product:
+		var d float64
+		for _, f := range e.Factors {
+			d += degree(f)
+		}
+		return d
+	}
+	return 0
+}
+
+// monomial returns the numeric exponents of the symbols in a term.
+func monomial(e Expr) map[string]float64 {
+	m := make(map[string]float64)
+	_, rest := split(e)
+	factors := []Expr{rest}
+	if p, ok := rest.(*Product); ok {
+		factors = p.Factors
+	}
+	for _, f := range factors {
+		if s, ok := f.(Symbol); ok {
+			m[s.Name] = 1
+		} else if d := degree(f); d != 0 {
+			m[f.(*Power).Base.(Symbol).Name] = d
+		}
+	}
+	return m
+}
+
+// compareTerms orders the terms of a sum by decreasing degree, then
+// lexicographically, so that x^3 comes before x^2 y.
+func compareTerms(a, b Expr) int {
+	if c := cmp.Compare(degree(b), degree(a)); c != 0 {
+		return c
+	}
+	ma, mb := monomial(a), monomial(b)
+	names := make([]string, 0, len(ma)+len(mb))
+	for name := range ma {
+		names = append(names, name)
+	}
+	for name := range mb {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if c := cmp.Compare(mb[name], ma[name]); c != 0 {
+			return c
+		}
+	}
+	_, ra := split(a)
+	_, rb := split(b)
+	return cmp.Compare(ra.key(), rb.key())
+}
+
+// Size returns the number of nodes of e.
+func Size(e Expr) int {
+	n := 1
+	for _, c := range children(e) {
+		n += Size(c)
+	}
+	return n
+}
+
+// children returns the operands of e.
+func children(e Expr) []Expr {
+	switch e := e.(type) {
+	case *Sum:
+		return e.Terms
+	case *Product:
+		return e.Factors
+	case *Power:
+		return []Expr{e.Base, e.Exp}
+	case *Call:
+		return e.Args
+	}
+	return nil
+}
+
+// rebuild applies f to the operands of e and rebuilds it with the
+// canonicalizing constructors.
+func rebuild(e Expr, f func(Expr) Expr) Expr {
+	switch e := e.(type) {
+	case *Sum:
+		terms := make([]Expr, len(e.Terms))
+		for i, t := range e.Terms {
+			terms[i] = f(t)
+		}
+		return Add(terms...)
+	case *Product:
+		factors := make([]Expr, len(e.Factors))
+		for i, t := range e.Factors {
+			factors[i] = f(t)
+		}
+		return Mul(factors...)
+	case *Power:
+		return Pow(f(e.Base), f(e.Exp))
+	case *Call:
+		args := make([]Expr, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = f(a)
+		}
+		return Apply(e.Func, args...)
+	}
+	return e
+}
+
+// Free reports whether e does not depend on the symbol x.
+func Free(e Expr, x string) bool {
+	if s, ok := e.(Symbol); ok {
+		return s.Name != x
+	}
+	for _, c := range children(e) {
+		if !Free(c, x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Symbols returns the symbols of e other than named constants, sorted.
+func Symbols(e Expr) []string {
+	seen := make(map[string]bool)
+	var walk func(Expr)
+	walk = func(e Expr) {
+		if s, ok := e.(Symbol); ok && !isConstant(s.Name) {
+			seen[s.Name] = true
+		}
+		for _, c := range children(e) {
+			walk(c)
+		}
+	}
+	walk(e)
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// DividesByZero reports whether e raises 0 to a negative power, which is
+// how a division by zero such as 1/0 is represented.
+func DividesByZero(e Expr) bool {
+	if p, ok := e.(*Power); ok && isNumber(p.Base, zero) {
+		if n, ok := p.Exp.(Number); ok && n.v.Sign() < 0 {
+			return true
+		}
+	}
+	return slices.ContainsFunc(children(e), DividesByZero)
+}
+
+// Substitute replaces symbols of e by the expressions in values.
+func Substitute(e Expr, values map[string]Expr) Expr {
+	if s, ok := e.(Symbol); ok {
+		if v, ok := values[s.Name]; ok {
+			return v
+		}
+		return s
+	}
+	return rebuild(e, func(c Expr) Expr { return Substitute(c, values) })
+}
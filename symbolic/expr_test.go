@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sagacient/math-mcp-server/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mustParse parses s without user-defined functions.
+func mustParse(t *testing.T, s string) Expr {
+	t.Helper()
+	e, err := Parse(s, nil)
+	require.NoError(t, err, s)
+	return e
+}
+
+func TestCanonicalForm(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"x + x", "2x"},
+		{"x*x", "x^2"},
+		{"x - x", "0"},
+		{"0.1 + 0.2", "3/10"},
+		{"1/3 + 1/6", "1/2"},
+		{"2x + 3y - x + 1", "x + 3y + 1"},
+		{"x^2 x^3", "x^5"},
+		{"(x^2)^3", "x^6"},
+		{"(2x)^2", "4x^2"},
+		{"x / x", "1"},
+		{"3(x + 1) - 3", "3x"},
+		{"exp(x) exp(y)", "exp(x + y)"},
+		{"e^x", "exp(x)"},
+		{"sqrt(4)", "2"},
+		{"8^(1/3)", "2"},
+		{"sqrt(8)", "sqrt(8)"},
+		{"sqrt(2) sqrt(2)", "2"},
+		{"(4/9)^(-1/2)", "3/2"},
+		{"2^100", "1267650600228229401496703205376"},
+		{"ln(e)", "1"},
+		{"sin(pi/6)", "1/2"},
+		{"cos(pi)", "-1"},
+		{"sin(-x)", "-sin(x)"},
+		{"cos(-x)", "cos(x)"},
+		{"abs(-3)", "3"},
+		{"x^0", "1"},
+		{"0 x", "0"},
+		{"exp(log(x))", "x"},
+		{"hypot(x, y)", "sqrt(x^2 + y^2)"},
+		{"log1p(x)", "log(x + 1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, mustParse(t, tt.input).String())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"x +", "parse error at position 4: unexpected end of expression"},
+		{"foo(x)", `unknown function "foo"`},
+		{"sin(x, y)", "sin expects 1 argument(s), got 2"},
+		{"x / 0", "division by zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input, nil)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseDefinitions(t *testing.T) {
+	body, err := expr.Parse("a x^2")
+	require.NoError(t, err)
+	defs := map[string]Definition{"f": {Params: []string{"x"}, Body: body}}
+
+	e, err := Parse("f(x + 1)", defs)
+	require.NoError(t, err)
+	assert.Equal(t, "a * (x + 1)^2", e.String())
+
+	// A function may call itself only finitely often
+	loop, err := expr.Parse("g(x)")
+	require.NoError(t, err)
+	_, err = Parse("g(1)", map[string]Definition{"g": {Params: []string{"x"}, Body: loop}})
+	assert.EqualError(t, err, "functions are nested more than 32 deep")
+
+	_, err = Parse("f(1, 2)", defs)
+	assert.EqualError(t, err, "f expects 1 argument(s), got 2")
+}
+
+func TestSubstitute(t *testing.T) {
+	e := mustParse(t, "x^2 + y")
+	got := Substitute(e, map[string]Expr{"x": Rat(1, 2), "y": mustParse(t, "z - 1/4")})
+	assert.Equal(t, "z", got.String())
+	assert.Equal(t, []string{"x", "y"}, Symbols(e))
+	assert.Equal(t, []string{"x"}, Symbols(mustParse(t, "pi x + e")))
+}
+
+func TestToNode(t *testing.T) {
+	e := mustParse(t, "sqrt(x^2 + 1) / 3 - sin(pi/5)")
+	v, err := expr.Eval(ToNode(e), &expr.Env{Vars: map[string]float64{"x": 2}})
+	require.NoError(t, err)
+	assert.InDelta(t, math.Sqrt(5)/3-math.Sin(math.Pi/5), v, 1e-15)
+}
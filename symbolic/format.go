@@ -0,0 +1,401 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// Precedences used when formatting.
+const (
+	precSum = iota + 1
+	precProduct
+	precPower
+	precAtom
+)
+
+func (n Number) String() string   { return Format(n) }
+func (s Symbol) String() string   { return Format(s) }
+func (s *Sum) String() string     { return Format(s) }
+func (p *Product) String() string { return Format(p) }
+func (p *Power) String() string   { return Format(p) }
+func (c *Call) String() string    { return Format(c) }
+
+// quotient is a term written as a fraction: sign·coef·num / den.
+type quotient struct {
+	negative bool
+	num, den *big.Int
+	upper    []Expr
+	lower    []Expr
+}
+
+// asQuotient splits e into a numerator and a denominator, moving factors
+// with negative exponents below the line.
+func asQuotient(e Expr) quotient {
+	c, rest := split(e)
+	q := quotient{negative: c.Sign() < 0, num: new(big.Int).Abs(c.Num()), den: c.Denom()}
+	factors := []Expr{rest}
+	if p, ok := rest.(*Product); ok {
+		factors = p.Factors
+	}
+	for _, f := range factors {
+		if isNumber(f, one) {
+			continue
+		}
+		if p, ok := f.(*Power); ok {
+			if c, _ := split(p.Exp); c.Sign() < 0 {
+				q.lower = append(q.lower, Pow(p.Base, Neg(p.Exp)))
+				continue
+			}
+		}
+		q.upper = append(q.upper, f)
+	}
+	return q
+}
+
+// isFraction reports whether e is written with a sign or a fraction bar.
+func isFraction(e Expr) bool {
+	q := asQuotient(e)
+	return q.negative || q.den.Cmp(big.NewInt(1)) != 0 || len(q.lower) > 0
+}
+
+// precedence returns the binding strength of e as formatted.
+func precedence(e Expr) int {
+	switch e := e.(type) {
+	case *Sum:
+		return precSum
+	case *Product:
+		return precProduct
+	case Number, *Power:
+		if isFraction(e) {
+			return precProduct
+		}
+		if p, ok := e.(*Power); ok && !isNumber(p.Exp, half) {
+			return precPower
+		}
+	}
+	return precAtom
+}
+
+// Format writes e as plain text in the syntax the expression tools accept,
+// for example "2x cos(x^2)".
+func Format(e Expr) string {
+	switch e := e.(type) {
+	case Number:
+		return e.v.RatString()
+	case Symbol:
+		return e.Name
+	case *Sum:
+		return formatSum(e, Format)
+	case *Call:
+		args := make([]string, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = Format(a)
+		}
+		return e.Func + "(" + strings.Join(args, ", ") + ")"
+	}
+	q := asQuotient(e)
+	var b strings.Builder
+	if q.negative {
+		b.WriteString("-")
+	}
+	var upper []string
+	if q.num.Cmp(big.NewInt(1)) != 0 || len(q.upper) == 0 {
+		upper = append(upper, q.num.String())
+	}
+	for _, f := range q.upper {
+		upper = append(upper, formatFactor(f))
+	}
+	b.WriteString(joinFactors(upper))
+	var lower []string
+	if q.den.Cmp(big.NewInt(1)) != 0 {
+		lower = append(lower, q.den.String())
+	}
+	for _, f := range q.lower {
+		lower = append(lower, formatFactor(f))
+	}
+	switch {
+	case len(lower) == 1:
+		b.WriteString(" / " + lower[0])
+	case len(lower) > 0:
+		b.WriteString(" / (" + joinFactors(lower) + ")")
+	}
+	return b.String()
+}
+
+// formatFactor writes a factor of a product, which is never a product or a
+// fraction.
+func formatFactor(e Expr) string {
+	if p, ok := e.(*Power); ok {
+		if isNumber(p.Exp, half) {
+			return "sqrt(" + Format(p.Base) + ")"
+		}
+		exp := Format(p.Exp)
+		if precedence(p.Exp) < precAtom {
+			exp = "(" + exp + ")"
+		}
+		return wrap(p.Base, precAtom) + "^" + exp
+	}
+	return wrap(e, precPower)
+}
+
+// wrap formats e, in parentheses if it binds less tightly than min.
+func wrap(e Expr, min int) string {
+	if precedence(e) < min {
+		return "(" + Format(e) + ")"
+	}
+	return Format(e)
+}
+
+// joinFactors writes factors side by side. A number is written against a
+// following name, as in "2x", and an explicit * keeps a name followed by a
+// parenthesis from reading as a function call.
+func joinFactors(factors []string) string {
+	var b strings.Builder
+	for i, f := range factors {
+		if i > 0 {
+			prev := factors[i-1]
+			switch {
+			case strings.HasPrefix(f, "("):
+				if endsWithName(prev) {
+					b.WriteString(" * ")
+				} else {
+					b.WriteString(" ")
+				}
+			case i == 1 && isDigits(prev) && startsWithName(f) && f[0] != 'e' && f[0] != 'E':
+			default:
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(f)
+	}
+	return b.String()
+}
+
+// endsWithName reports whether s ends with an identifier.
+func endsWithName(s string) bool {
+	i := len(s)
+	for i > 0 && (s[i-1] == '_' || isAlnum(rune(s[i-1]))) {
+		i--
+	}
+	return strings.ContainsFunc(s[i:], func(r rune) bool { return r == '_' || unicode.IsLetter(r) })
+}
+
+// startsWithName reports whether s starts with a plain identifier, not a
+// call.
+func startsWithName(s string) bool {
+	i := 0
+	for i < len(s) && (s[i] == '_' || isAlnum(rune(s[i]))) {
+		i++
+	}
+	return i > 0 && !unicode.IsDigit(rune(s[0])) && (i == len(s) || s[i] != '(')
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) }) < 0
+}
+
+func isAlnum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// formatSum writes the terms of a sum, subtracting negative ones.
+func formatSum(s *Sum, format func(Expr) string) string {
+	var b strings.Builder
+	for i, t := range s.Terms {
+		if c, _ := split(t); i > 0 && c.Sign() < 0 {
+			b.WriteString(" - ")
+			t = Neg(t)
+		} else if i > 0 {
+			b.WriteString(" + ")
+		}
+		b.WriteString(format(t))
+	}
+	return b.String()
+}
+
+// LaTeX writes e as a LaTeX math expression, for example
+// "2 x \cos\left(x^{2}\right)".
+func LaTeX(e Expr) string {
+	switch e := e.(type) {
+	case Symbol:
+		return latexSymbol(e.Name)
+	case *Sum:
+		return formatSum(e, LaTeX)
+	case *Call:
+		return latexCall(e)
+	}
+	q := asQuotient(e)
+	var b strings.Builder
+	if q.negative {
+		b.WriteString("-")
+	}
+	var upper []string
+	if q.num.Cmp(big.NewInt(1)) != 0 || len(q.upper) == 0 {
+		upper = append(upper, q.num.String())
+	}
+	for _, f := range q.upper {
+		upper = append(upper, latexFactor(f))
+	}
+	var lower []string
+	if q.den.Cmp(big.NewInt(1)) != 0 {
+		lower = append(lower, q.den.String())
+	}
+	for _, f := range q.lower {
+		lower = append(lower, latexFactor(f))
+	}
+	if len(lower) == 0 {
+		b.WriteString(latexJoin(upper))
+		return b.String()
+	}
+	// A lone sum above or below the bar needs no parentheses
+	if len(upper) == 1 && len(q.upper) == 1 {
+		upper[0] = LaTeX(q.upper[0])
+	}
+	if len(lower) == 1 && len(q.lower) == 1 {
+		lower[0] = LaTeX(q.lower[0])
+	}
+	b.WriteString(`\frac{` + latexJoin(upper) + `}{` + latexJoin(lower) + `}`)
+	return b.String()
+}
+
+// latexJoin writes factors side by side, with a \cdot between numbers.
+func latexJoin(factors []string) string {
+	var b strings.Builder
+	for i, f := range factors {
+		if i > 0 {
+			if f != "" && unicode.IsDigit(rune(f[0])) {
+				b.WriteString(` \cdot `)
+			} else {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(f)
+	}
+	return b.String()
+}
+
+// latexFactor writes a factor of a product.
+func latexFactor(e Expr) string {
+	p, ok := e.(*Power)
+	if !ok {
+		if _, ok := e.(*Sum); ok {
+			return `\left(` + LaTeX(e) + `\right)`
+		}
+		return LaTeX(e)
+	}
+	if n, ok := p.Exp.(Number); ok && n.v.Num().Cmp(big.NewInt(1)) == 0 {
+		if n.v.Denom().Cmp(big.NewInt(2)) == 0 {
+			return `\sqrt{` + LaTeX(p.Base) + `}`
+		}
+		return `\sqrt[` + n.v.Denom().String() + `]{` + LaTeX(p.Base) + `}`
+	}
+	exp := LaTeX(p.Exp)
+	// sin^2(x) rather than (sin(x))^2
+	if c, ok := p.Base.(*Call); ok && isInteger(p.Exp) && len(c.Args) == 1 {
+		if name, ok := latexOperators[c.Func]; ok {
+			return name + `^{` + exp + `}` + latexParens(LaTeX(c.Args[0]))
+		}
+	}
+	base := LaTeX(p.Base)
+	if precedence(p.Base) < precAtom || isExp(p.Base) {
+		base = latexParens(base)
+	}
+	return base + `^{` + exp + `}`
+}
+
+func isExp(e Expr) bool {
+	c, ok := e.(*Call)
+	return ok && c.Func == "exp"
+}
+
+func latexParens(s string) string {
+	return `\left(` + s + `\right)`
+}
+
+// latexOperators are the functions LaTeX has operator names for.
+var latexOperators = map[string]string{
+	"sin":   `\sin`,
+	"cos":   `\cos`,
+	"tan":   `\tan`,
+	"asin":  `\arcsin`,
+	"acos":  `\arccos`,
+	"atan":  `\arctan`,
+	"sinh":  `\sinh`,
+	"cosh":  `\cosh`,
+	"tanh":  `\tanh`,
+	"log":   `\ln`,
+	"log10": `\log_{10}`,
+	"log2":  `\log_{2}`,
+}
+
+// latexCall writes a function application.
+func latexCall(c *Call) string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = LaTeX(a)
+	}
+	if len(args) == 1 {
+		switch c.Func {
+		case "exp":
+			return `e^{` + args[0] + `}`
+		case "abs":
+			return `\left|` + args[0] + `\right|`
+		case "floor":
+			return `\left\lfloor ` + args[0] + ` \right\rfloor`
+		case "ceil":
+			return `\left\lceil ` + args[0] + ` \right\rceil`
+		case "cbrt":
+			return `\sqrt[3]{` + args[0] + `}`
+		case "gamma":
+			return `\Gamma` + latexParens(args[0])
+		case "lgamma":
+			return `\ln\Gamma` + latexParens(args[0])
+		}
+	}
+	name, ok := latexOperators[c.Func]
+	if !ok {
+		name = `\operatorname{` + strings.ReplaceAll(c.Func, "_", `\_`) + `}`
+	}
+	return name + latexParens(strings.Join(args, ", "))
+}
+
+// greek are the Greek letters LaTeX names; phi is the golden ratio.
+var greek = map[string]string{
+	"alpha": `\alpha`, "beta": `\beta`, "gamma": `\gamma`, "delta": `\delta`,
+	"epsilon": `\epsilon`, "zeta": `\zeta`, "eta": `\eta`, "theta": `\theta`,
+	"iota": `\iota`, "kappa": `\kappa`, "lambda": `\lambda`, "mu": `\mu`,
+	"nu": `\nu`, "xi": `\xi`, "pi": `\pi`, "rho": `\rho`, "sigma": `\sigma`,
+	"tau": `\tau`, "upsilon": `\upsilon`, "phi": `\varphi`, "chi": `\chi`,
+	"psi": `\psi`, "omega": `\omega`, "Gamma": `\Gamma`, "Delta": `\Delta`,
+	"Theta": `\Theta`, "Lambda": `\Lambda`, "Xi": `\Xi`, "Pi": `\Pi`,
+	"Sigma": `\Sigma`, "Phi": `\Phi`, "Psi": `\Psi`, "Omega": `\Omega`,
+}
+
+// latexSymbol writes a name, with a trailing number or an underscore suffix
+// as a subscript: x1 and x_1 are both x_{1}.
+func latexSymbol(name string) string {
+	base, sub := name, ""
+	if i := strings.Index(name, "_"); i > 0 {
+		base, sub = name[:i], name[i+1:]
+	} else if i := strings.IndexFunc(name, unicode.IsDigit); i > 0 {
+		base, sub = name[:i], name[i:]
+	}
+	s, ok := greek[base]
+	switch {
+	case ok:
+	case len(base) == 1:
+		s = base
+	default:
+		s = `\mathrm{` + strings.ReplaceAll(base, "_", `\_`) + `}`
+	}
+	if sub != "" {
+		s += `_{` + strings.ReplaceAll(sub, "_", `\_`) + `}`
+	}
+	return s
+}
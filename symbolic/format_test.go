@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input string
+		text  string
+		latex string
+	}{
+		{"2x cos(x^2)", "2x cos(x^2)", `2 x \cos\left(x^{2}\right)`},
+		{"x^2 - 3x + 1/2", "x^2 - 3x + 1/2", `x^{2} - 3 x + \frac{1}{2}`},
+		{"-x/2", "-x / 2", `-\frac{x}{2}`},
+		{"2x/(3y)", "2x / (3y)", `\frac{2 x}{3 y}`},
+		{"1/(x + 1)", "1 / (x + 1)", `\frac{1}{x + 1}`},
+		{"x * (x + 1)", "x * (x + 1)", `x \left(x + 1\right)`},
+		{"sqrt(x^2 + 1)", "sqrt(x^2 + 1)", `\sqrt{x^{2} + 1}`},
+		{"x^(1/3)", "x^(1/3)", `\sqrt[3]{x}`},
+		{"x^(2/3)", "x^(2/3)", `x^{\frac{2}{3}}`},
+		{"(x + 1)^n", "(x + 1)^n", `\left(x + 1\right)^{n}`},
+		{"x^(n - 1)", "x^(n - 1)", `x^{n - 1}`},
+		{"sin(x)^2", "sin(x)^2", `\sin^{2}\left(x\right)`},
+		{"exp(x)^2", "exp(2x)", `e^{2 x}`},
+		{"2 e x", "2 e x", `2 e x`},
+		{"3 2^x", "3 2^x", `3 \cdot 2^{x}`},
+		{"theta + alpha_1 + x2 + rate", "alpha_1 + rate + theta + x2", `\alpha_{1} + \mathrm{rate} + \theta + x_{2}`},
+		{"pi r^2", "pi r^2", `\pi r^{2}`},
+		{"abs(x) + log(x) + atan2(y, x)", "abs(x) + atan2(y, x) + log(x)", `\left|x\right| + \operatorname{atan2}\left(y, x\right) + \ln\left(x\right)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			e := mustParse(t, tt.input)
+			assert.Equal(t, tt.text, Format(e))
+			assert.Equal(t, tt.latex, LaTeX(e))
+			// The text parses back to the same expression
+			assert.True(t, Equal(e, mustParse(t, Format(e))), "%s reparsed as %s", Format(e), mustParse(t, Format(e)))
+		})
+	}
+}
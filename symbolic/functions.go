@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math/big"
+	"slices"
+)
+
+// constants are the symbols that name numbers rather than variables.
+var constants = []string{"pi", "e", "phi", "tau"}
+
+// isConstant reports whether name is a named constant.
+func isConstant(name string) bool {
+	return slices.Contains(constants, name)
+}
+
+var (
+	// oddFunctions satisfy f(−x) = −f(x).
+	oddFunctions = []string{"sin", "tan", "asin", "atan", "sinh", "tanh", "asinh", "atanh", "erf", "erfinv", "cbrt"}
+	// evenFunctions satisfy f(−x) = f(x).
+	evenFunctions = []string{"cos", "cosh", "abs"}
+)
+
+// Apply returns the function fn applied to args, evaluated exactly where
+// that is possible: exp(0) = 1, log(e) = 1, sin(pi/6) = 1/2, and so on.
+func Apply(fn string, args ...Expr) Expr {
+	if len(args) == 1 {
+		if v, ok := special(fn, args[0]); ok {
+			return v
+		}
+		arg := args[0]
+		// Pull the sign out of odd and even functions
+		if c, _ := split(arg); c.Sign() < 0 {
+			switch {
+			case slices.Contains(oddFunctions, fn):
+				return Neg(Apply(fn, Neg(arg)))
+			case slices.Contains(evenFunctions, fn):
+				return Apply(fn, Neg(arg))
+			}
+		}
+	}
+	return &Call{Func: fn, Args: args, k: compound(fn, args...)}
+}
+
+// special evaluates fn(x) at the points where it has a simple exact value.
+func special(fn string, x Expr) (Expr, bool) {
+	n, isNum := x.(Number)
+	switch fn {
+	case "exp":
+		if isNum && n.v.Sign() == 0 {
+			return one, true
+		}
+		// exp(log u) = u
+		if c, ok := x.(*Call); ok && c.Func == "log" {
+			return c.Args[0], true
+		}
+	case "log":
+		switch {
+		case isNum && n.v.Cmp(one.v) == 0:
+			return zero, true
+		case Equal(x, Sym("e")):
+			return one, true
+		}
+		// log(exp u) = u for real u
+		if c, ok := x.(*Call); ok && c.Func == "exp" {
+			return c.Args[0], true
+		}
+	case "sin", "cos", "tan":
+		if k, ok := piMultiple(x); ok {
+			return trigAt(fn, k)
+		}
+	case "sinh", "tanh", "asin", "atan", "asinh", "atanh", "erf", "erfinv":
+		if isNum && n.v.Sign() == 0 {
+			return zero, true
+		}
+	case "cosh":
+		if isNum && n.v.Sign() == 0 {
+			return one, true
+		}
+	case "acos":
+		if isNum && n.v.Cmp(one.v) == 0 {
+			return zero, true
+		}
+	case "abs":
+		if isNum {
+			return Number{new(big.Rat).Abs(n.v)}, true
+		}
+	case "sign":
+		if isNum {
+			return Int(int64(n.v.Sign())), true
+		}
+	case "cbrt":
+		if isNum {
+			if r, ok := powNumber(n.v, big.NewRat(1, 3)); ok {
+				return r, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// piMultiple returns k when x is the rational multiple kπ.
+func piMultiple(x Expr) (*big.Rat, bool) {
+	if n, ok := x.(Number); ok && n.v.Sign() == 0 {
+		return new(big.Rat), true
+	}
+	c, rest := split(x)
+	if s, ok := rest.(Symbol); ok {
+		switch s.Name {
+		case "pi":
+			return c, true
+		case "tau":
+			return c.Mul(c, big.NewRat(2, 1)), true
+		}
+	}
+	return nil, false
+}
+
+// trigAt evaluates sin, cos or tan at kπ when k is a multiple of 1/4 or 1/6.
+func trigAt(fn string, k *big.Rat) (Expr, bool) {
+	switch fn {
+	case "cos":
+		// cos(kπ) = sin((k + 1/2)π)
+		return sinPi(new(big.Rat).Add(k, half.v))
+	case "tan":
+		s, ok := sinPi(k)
+		if !ok {
+			return nil, false
+		}
+		c, _ := sinPi(new(big.Rat).Add(k, half.v))
+		if isNumber(c, zero) {
+			return nil, false
+		}
+		return Div(s, c), true
+	}
+	return sinPi(k)
+}
+
+// sinPi returns sin(kπ) exactly when k is a multiple of 1/4 or 1/6.
+func sinPi(k *big.Rat) (Expr, bool) {
+	if d := k.Denom().Int64(); !k.Denom().IsInt64() || (12%d != 0 || d == 12) {
+		return nil, false
+	}
+	// Reduce k to [0, 2), then to [0, 1/2] by symmetry
+	two := big.NewRat(2, 1)
+	k = new(big.Rat).Set(k)
+	q := new(big.Int).Quo(k.Num(), k.Denom())
+	k.Sub(k, new(big.Rat).Mul(two, new(big.Rat).SetFrac(new(big.Int).Quo(q, big.NewInt(2)), big.NewInt(1))))
+	if k.Sign() < 0 {
+		k.Add(k, two)
+	}
+	sign := int64(1)
+	if k.Cmp(one.v) >= 0 {
+		k.Sub(k, one.v)
+		sign = -1
+	}
+	if k.Cmp(half.v) > 0 {
+		k.Sub(one.v, k)
+	}
+	var v Expr
+	switch k.RatString() {
+	case "0":
+		v = zero
+	case "1/6":
+		v = half
+	case "1/4":
+		v = Mul(half, Pow(Int(2), half))
+	case "1/3":
+		v = Mul(half, Pow(Int(3), half))
+	case "1/2":
+		v = one
+	default:
+		return nil, false
+	}
+	return Mul(Int(sign), v), true
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"math/big"
+)
+
+// Simplify rewrites e into a smaller equivalent form. Besides the constant
+// folding and collection of like terms that every expression gets, it
+// applies the identities sin² + cos² = 1, cosh² − sinh² = 1,
+// sin/cos = tan, exp(n log u) = u^n and log(u^n) = n log u, tries the
+// expanded form, and puts fractions over a common denominator to cancel
+// common polynomial factors. The smallest of the candidates is returned.
+func Simplify(e Expr) Expr {
+	best := identities(e)
+	if expanded, err := Expand(e); err == nil {
+		if s := identities(expanded); cost(s) < cost(best) {
+			best = s
+		}
+	}
+	if hasDenominator(e) {
+		if combined, err := together(e); err == nil {
+			if s := identities(combined); cost(s) < cost(best) {
+				best = s
+			}
+		}
+	}
+	return best
+}
+
+// cost measures how complicated e looks: its size, plus one for each
+// fraction bar so that x / (x + 1) beats 1 / (1/x + 1).
+func cost(e Expr) int {
+	n := 1
+	if p, ok := e.(*Power); ok {
+		if c, _ := split(p.Exp); c.Sign() < 0 {
+			n++
+		}
+	}
+	for _, c := range children(e) {
+		n += cost(c)
+	}
+	return n
+}
+
+// together writes sums of fractions over a common denominator and factors
+// the result, so that common factors cancel.
+func together(e Expr) (Expr, error) {
+	var err error
+	e = rebuild(e, func(c Expr) Expr {
+		if err != nil {
+			return c
+		}
+		var t Expr
+		t, err = together(c)
+		return t
+	})
+	if err != nil {
+		return nil, err
+	}
+	s, ok := e.(*Sum)
+	if !ok {
+		return Factor(e)
+	}
+	// The least common multiple of the denominators: the highest power of
+	// each base, or the whole factor when its exponent is not a number
+	dens := make(map[string]*Power)
+	var order []string
+	for _, t := range s.Terms {
+		for _, f := range asQuotient(t).lower {
+			base, exp := asPower(f)
+			k := base.key()
+			n, ok := exp.(Number)
+			if !ok {
+				base, n, k = f, one, f.key()
+			}
+			if p, found := dens[k]; !found {
+				dens[k] = &Power{Base: base, Exp: n}
+				order = append(order, k)
+			} else if n.v.Cmp(p.Exp.(Number).v) > 0 {
+				p.Exp = n
+			}
+		}
+	}
+	if len(order) == 0 {
+		return factorSum(s)
+	}
+	factors := make([]Expr, len(order))
+	for i, k := range order {
+		factors[i] = Pow(dens[k].Base, dens[k].Exp)
+	}
+	den := Mul(factors...)
+	terms := make([]Expr, len(s.Terms))
+	for i, t := range s.Terms {
+		terms[i] = Mul(t, den)
+	}
+	num, err := Expand(Add(terms...))
+	if err != nil {
+		return nil, err
+	}
+	return Factor(Mul(num, Pow(den, Int(-1))))
+}
+
+// hasDenominator reports whether e divides by a sum.
+func hasDenominator(e Expr) bool {
+	if p, ok := e.(*Power); ok {
+		if _, isSum := p.Base.(*Sum); isSum {
+			if c, _ := split(p.Exp); c.Sign() < 0 {
+				return true
+			}
+		}
+	}
+	for _, c := range children(e) {
+		if hasDenominator(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// identities applies the simplifying identities bottom up.
+func identities(e Expr) Expr {
+	e = rebuild(e, identities)
+	switch e := e.(type) {
+	case *Sum:
+		return pythagorean(e.Terms)
+	case *Product:
+		return quotients(e)
+	case *Call:
+		return logExp(e)
+	}
+	return e
+}
+
+// pythagoreanPairs are the functions f, g with f² + s·g² = 1.
+var pythagoreanPairs = []struct {
+	f, g string
+	sign int
+}{
+	{"sin", "cos", 1},
+	{"cosh", "sinh", -1},
+}
+
+// pythagorean replaces c·R·sin(u)² + c·R·cos(u)² by c·R, and likewise
+// c·R·cosh(u)² − c·R·sinh(u)² by c·R.
+func pythagorean(terms []Expr) Expr {
+	for _, pair := range pythagoreanPairs {
+		for i := 0; i < len(terms); i++ {
+			coef, rest, u, ok := squared(terms[i], pair.f)
+			if !ok {
+				continue
+			}
+			for j := range terms {
+				c, r, v, ok := squared(terms[j], pair.g)
+				if !ok || !Equal(u, v) || !Equal(rest, r) {
+					continue
+				}
+				if c.Mul(c, big.NewRat(int64(pair.sign), 1)).Cmp(coef) != 0 {
+					continue
+				}
+				next := []Expr{Mul(Number{coef}, rest)}
+				for k, t := range terms {
+					if k != i && k != j {
+						next = append(next, t)
+					}
+				}
+				return pythagorean(addends(Add(next...)))
+			}
+		}
+	}
+	return Add(terms...)
+}
+
+// squared matches a term c·R·fn(u)², returning c, R and u.
+func squared(t Expr, fn string) (*big.Rat, Expr, Expr, bool) {
+	c, rest := split(t)
+	factors := []Expr{rest}
+	if p, ok := rest.(*Product); ok {
+		factors = p.Factors
+	}
+	for i, f := range factors {
+		p, ok := f.(*Power)
+		if !ok || !isNumber(p.Exp, Int(2)) {
+			continue
+		}
+		if call, ok := p.Base.(*Call); ok && call.Func == fn {
+			others := append(append([]Expr{}, factors[:i]...), factors[i+1:]...)
+			return c, Mul(others...), call.Args[0], true
+		}
+	}
+	return nil, nil, nil, false
+}
+
+// quotients replaces sin(u)^n cos(u)^−n by tan(u)^n.
+func quotients(p *Product) Expr {
+	for _, f := range p.Factors {
+		base, exp := asPower(f)
+		call, ok := base.(*Call)
+		if !ok || call.Func != "sin" {
+			continue
+		}
+		cos := Pow(Apply("cos", call.Args[0]), Neg(exp))
+		for _, g := range p.Factors {
+			if Equal(g, cos) {
+				return Mul(p, Pow(f, Int(-1)), Pow(g, Int(-1)), Pow(Apply("tan", call.Args[0]), exp))
+			}
+		}
+	}
+	return p
+}
+
+// logExp applies exp(a + n log u) = u^n exp(a) and log(u^n) = n log u,
+// taking the absolute value of u for even n since u^n is then defined for
+// negative u too.
+func logExp(c *Call) Expr {
+	switch c.Func {
+	case "exp":
+		var factors, rest []Expr
+		for _, t := range addends(c.Args[0]) {
+			n, r := split(t)
+			if call, ok := r.(*Call); ok && call.Func == "log" {
+				factors = append(factors, Pow(call.Args[0], Number{n}))
+			} else {
+				rest = append(rest, t)
+			}
+		}
+		if len(factors) > 0 {
+			return Mul(append(factors, Apply("exp", Add(rest...)))...)
+		}
+	case "log":
+		p, ok := c.Args[0].(*Power)
+		if !ok {
+			break
+		}
+		n, ok := p.Exp.(Number)
+		if !ok {
+			break
+		}
+		base := p.Base
+		if n.v.IsInt() && n.v.Num().Bit(0) == 0 {
+			base = Apply("abs", base)
+		}
+		return Mul(n, Apply("log", base))
+	}
+	return c
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MPL-2.0
+// Copyright 2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// See CONTRIBUTORS.md for full contributor list.
+
+package symbolic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"sin(x)^2 + cos(x)^2", "1"},
+		{"3 sin(2y)^2 + 3cos(2y)^2 + x", "x + 3"},
+		{"x sin(x)^2 + x cos(x)^2", "x"},
+		{"cosh(x)^2 - sinh(x)^2", "1"},
+		{"sin(x) / cos(x)", "tan(x)"},
+		{"cos(x)^2 / sin(x)^2", "1 / tan(x)^2"},
+		{"exp(2 log(x))", "x^2"},
+		{"exp(x + log(y))", "y exp(x)"},
+		{"log(x^3)", "3 log(x)"},
+		{"log(x^2)", "2 log(abs(x))"},
+		{"log(sqrt(x))", "log(x) / 2"},
+		{"(x + 1)^2 - x^2", "2x + 1"},
+		{"(x^2 - 1) / (x - 1)", "x + 1"},
+		{"1/(x + 1) - 1/x", "-1 / (x * (x + 1))"},
+		{"1 / (1/x + 1)", "x / (x + 1)"},
+		// Already simplest
+		{"x^2 + 2x + 1", "x^2 + 2x + 1"},
+		{"sin(x)^2", "sin(x)^2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, Simplify(mustParse(t, tt.input)).String())
+		})
+	}
+}